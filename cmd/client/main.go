@@ -1,9 +1,12 @@
 package main
 
 import (
+	"errors"
 	"log"
+	"os"
 
 	"github.com/casnerano/seckeep/internal/client"
+	"github.com/casnerano/seckeep/internal/client/command/exitcode"
 )
 
 func main() {
@@ -14,6 +17,11 @@ func main() {
 	defer app.Shutdown()
 
 	if err = app.Run(); err != nil {
+		var exitErr *exitcode.Error
+		if errors.As(err, &exitErr) {
+			app.Shutdown()
+			os.Exit(exitErr.Code)
+		}
 		log.Fatal("Ошибка запуска приложения.", err.Error())
 	}
 }
@@ -3,12 +3,15 @@
 package client
 
 import (
+	"errors"
 	"fmt"
+	"os"
+	"path/filepath"
 
 	"github.com/casnerano/seckeep/internal/client/command"
+	"github.com/casnerano/seckeep/internal/client/command/exitcode"
 	"github.com/casnerano/seckeep/internal/client/config"
 	"github.com/casnerano/seckeep/internal/client/service/storage"
-	"github.com/casnerano/seckeep/pkg/config/yaml"
 	"github.com/casnerano/seckeep/pkg/log"
 	"github.com/casnerano/seckeep/pkg/log/handler"
 	"github.com/casnerano/seckeep/pkg/log/handler/formatter"
@@ -16,7 +19,7 @@ import (
 
 // App структура приложения.
 type App struct {
-	config      *config.Config
+	config      *config.Manager
 	logger      *log.Logger
 	dataStorage *storage.Storage
 	rootCmd     *command.Root
@@ -38,15 +41,36 @@ func NewApp() (*App, error) {
 		),
 	)
 
+	// Глобальные флаги разбираются до инициализации команд,
+	// т.к. от них зависит выбор конфигурации и профиля.
+	flags := command.ParseGlobalFlags(os.Args[1:])
+
 	// Инициализация конфигурации.
-	app.config = &config.Config{}
-	if err = yaml.LoadFromFile(config.FileName, app.config); err != nil {
+	app.config, err = config.Load(flags.ConfigFile)
+	if err != nil {
 		app.logger.Emergency("Не удалось прочитать файл конфигурации.", err)
 		return nil, err
 	}
 
-	// Инициализация локального хранилища.
-	app.dataStorage, err = storage.New(storage.DefaultFileName)
+	profileName := flags.Profile
+	if profileName == "" {
+		profileName = app.config.CurrentProfile()
+	}
+
+	profile, err := app.config.Profile(profileName)
+	if err != nil {
+		app.logger.Emergency("Не удалось загрузить профиль.", err)
+		return nil, err
+	}
+
+	// Инициализация локального хранилища и каталога данных профиля.
+	for _, dir := range []string{filepath.Dir(profile.StoreFile), config.DataDir(profileName)} {
+		if err = os.MkdirAll(dir, 0700); err != nil {
+			return nil, err
+		}
+	}
+
+	app.dataStorage, err = storage.New(profile.StoreFile)
 	if err != nil {
 		return nil, err
 	}
@@ -54,6 +78,9 @@ func NewApp() (*App, error) {
 	// Инициализация рутовой команды.
 	app.rootCmd = command.NewRoot(&command.RootCommandContext{
 		Config:      app.config,
+		Flags:       flags,
+		ProfileName: profileName,
+		Profile:     profile,
 		Logger:      app.logger,
 		DataStorage: app.dataStorage,
 	})
@@ -62,9 +89,13 @@ func NewApp() (*App, error) {
 }
 
 // Run метод запуска приложения.
+// Ошибка exitcode.Error возвращается без записи в лог: команда уже вывела результат.
 func (a *App) Run() error {
 	if err := a.rootCmd.Execute(); err != nil {
-		a.logger.Emergency("Ошибка запуска клиента.", err)
+		var exitErr *exitcode.Error
+		if !errors.As(err, &exitErr) {
+			a.logger.Emergency("Ошибка запуска клиента.", err)
+		}
 		return err
 	}
 
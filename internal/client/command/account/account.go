@@ -4,6 +4,7 @@ package account
 
 import (
 	"github.com/casnerano/seckeep/internal/client/service/account"
+	"github.com/casnerano/seckeep/internal/server/model"
 	"github.com/go-resty/resty/v2"
 	"github.com/spf13/cobra"
 )
@@ -12,20 +13,50 @@ import (
 type Service interface {
 	SignUp(login, password, fullName string) error
 	SignIn(login, password string) error
+	ChangePassword(oldPassword, newPassword string) error
+	Delete(password string) error
+	Export() (*model.AccountExport, error)
+	SignOut() error
+}
+
+// FingerprintService интерфейс получения отпечатков ключей.
+type FingerprintService interface {
+	Fingerprint(login string) (string, error)
+}
+
+// RecoveryService интерфейс работы с ключом восстановления.
+type RecoveryService interface {
+	Generate() (string, error)
+	Recover(login, recoveryKey, newPassword string) error
+}
+
+// Keyring интерфейс ключей пользователя.
+type Keyring interface {
+	account.KeySetup
+	FingerprintService
 }
 
 // NewCmd конструктор базовой команды взаимодействия с аккаунтом пользователя.
 // Содердит инициализацию дочерних команд.
-func NewCmd(client *resty.Client) *cobra.Command {
+// После авторизации ключи пользователя загружаются с сервера или генерируются и публикуются (keyring).
+// Ключ восстановления (recovery) генерируется при регистрации и по отдельной команде.
+// Собственного PersistentPreRun нет: токен авторизации подключает корневая команда.
+func NewCmd(client *resty.Client, tokenStore account.TokenStore, keyring Keyring, recovery RecoveryService) *cobra.Command {
 	cmd := cobra.Command{
-		Use:              "account",
-		Short:            "Взаимодействие с аккаунтом пользователя",
-		PersistentPreRun: func(cmd *cobra.Command, args []string) {},
+		Use:   "account",
+		Short: "Взаимодействие с аккаунтом пользователя",
 	}
 
-	accountService := account.New(client, account.NewTokenJar())
+	accountService := account.New(client, tokenStore, keyring)
 	cmd.AddCommand(NewSignInCmd(accountService))
-	cmd.AddCommand(NewSignUpCmd(accountService))
+	cmd.AddCommand(NewSignUpCmd(accountService, recovery))
+	cmd.AddCommand(NewSignOutCmd(accountService))
+	cmd.AddCommand(NewPasswdCmd(accountService))
+	cmd.AddCommand(NewDeleteCmd(accountService))
+	cmd.AddCommand(NewExportCmd(accountService))
+	cmd.AddCommand(NewFingerprintCmd(keyring))
+	cmd.AddCommand(NewRecoveryKeyCmd(recovery))
+	cmd.AddCommand(NewRecoverCmd(recovery))
 
 	return &cmd
 }
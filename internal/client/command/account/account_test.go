@@ -3,10 +3,17 @@ package account
 import (
 	"bytes"
 	"io"
+	"os"
+	"path/filepath"
+	"strings"
 	"testing"
+	"time"
 
 	mock_account "github.com/casnerano/seckeep/internal/client/command/account/mock"
 	"github.com/casnerano/seckeep/internal/client/service/account"
+	"github.com/casnerano/seckeep/internal/client/service/recovery"
+	"github.com/casnerano/seckeep/internal/server/model"
+	"github.com/casnerano/seckeep/pkg/cipher"
 	"github.com/go-resty/resty/v2"
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/suite"
@@ -15,6 +22,8 @@ import (
 type AccountTestSuite struct {
 	suite.Suite
 	accountService *mock_account.MockService
+	keyring        *mock_account.MockFingerprintService
+	recovery       *mock_account.MockRecoveryService
 }
 
 func (s *AccountTestSuite) SetupSuite() {
@@ -22,13 +31,48 @@ func (s *AccountTestSuite) SetupSuite() {
 	defer ctrl.Finish()
 
 	s.accountService = mock_account.NewMockService(ctrl)
+	s.keyring = mock_account.NewMockFingerprintService(ctrl)
+	s.recovery = mock_account.NewMockRecoveryService(ctrl)
 }
 
 func (s *AccountTestSuite) TestAccountCmd() {
-	cmd := NewCmd(resty.New())
+	cmd := NewCmd(resty.New(), account.NewTokenJar(filepath.Join(s.T().TempDir(), "token.jar"), cipher.New([]byte("example key"))), nil, nil)
 	s.True(cmd.HasSubCommands())
 }
 
+func (s *AccountTestSuite) TestFingerprint() {
+	cmd := NewFingerprintCmd(s.keyring)
+	cmdBuf := bytes.NewBufferString("")
+	cmd.SetOut(cmdBuf)
+
+	fingerprint := "3F2A 9C01 77B4 E5D2 0A6C 41F8 9B3E 2D70 C4A1 58E6 0F93 B27D 6E15 A8C4 3092 DB7F"
+
+	s.Run("Own keys", func() {
+		s.keyring.EXPECT().Fingerprint("").Return(fingerprint, nil)
+
+		cmd.SetArgs([]string{})
+		s.Require().NoError(cmd.Execute())
+
+		out, err := io.ReadAll(cmdBuf)
+		s.Require().NoError(err)
+
+		s.Contains(string(out), "Отпечаток ваших ключей")
+		s.Contains(string(out), fingerprint)
+	})
+
+	s.Run("Other user keys", func() {
+		s.keyring.EXPECT().Fingerprint("anna").Return(fingerprint, nil)
+
+		cmd.SetArgs([]string{"--login", "anna"})
+		s.Require().NoError(cmd.Execute())
+
+		out, err := io.ReadAll(cmdBuf)
+		s.Require().NoError(err)
+
+		s.Contains(string(out), "Отпечаток ключей пользователя «anna»")
+	})
+}
+
 func (s *AccountTestSuite) TestSignIn() {
 	cmd := NewSignInCmd(s.accountService)
 	cmdBuf := bytes.NewBufferString("")
@@ -66,6 +110,22 @@ func (s *AccountTestSuite) TestSignIn() {
 		s.Contains(string(out), account.ErrIncorrectCredentials.Error())
 	})
 
+	s.Run("Too many attempts", func() {
+		login := "ivan"
+		password := "example"
+
+		s.accountService.EXPECT().SignIn(login, password).Return(&account.TooManyRequestsError{RetryAfter: 2 * time.Minute})
+
+		cmd.SetArgs([]string{"-l", login, "-p", password})
+		err := cmd.Execute()
+		s.Require().NoError(err)
+
+		out, err := io.ReadAll(cmdBuf)
+		s.Require().NoError(err)
+
+		s.Contains(string(out), "повторите через 2m0s")
+	})
+
 	s.Run("Incorrect args", func() {
 		login := "ivan"
 		password := "example"
@@ -77,10 +137,42 @@ func (s *AccountTestSuite) TestSignIn() {
 
 		s.Error(err)
 	})
+
+	s.Run("Password from stdin", func() {
+		cmd := NewSignInCmd(s.accountService)
+		cmdBuf := bytes.NewBufferString("")
+		cmd.SetOut(cmdBuf)
+		cmd.SetIn(strings.NewReader("secret with spaces\n"))
+
+		s.accountService.EXPECT().SignIn("ivan", "secret with spaces").Return(nil)
+
+		cmd.SetArgs([]string{"-l", "ivan", "-p", "-"})
+		s.Require().NoError(cmd.Execute())
+
+		out, err := io.ReadAll(cmdBuf)
+		s.Require().NoError(err)
+
+		s.Contains(string(out), "Успешная авторизация")
+	})
+
+	s.Run("Without password", func() {
+		cmd := NewSignInCmd(s.accountService)
+		cmdBuf := bytes.NewBufferString("")
+		cmd.SetOut(cmdBuf)
+		cmd.SetIn(strings.NewReader(""))
+
+		cmd.SetArgs([]string{"-l", "ivan"})
+		s.Require().NoError(cmd.Execute())
+
+		out, err := io.ReadAll(cmdBuf)
+		s.Require().NoError(err)
+
+		s.Contains(string(out), "Значение --password не задано.")
+	})
 }
 
 func (s *AccountTestSuite) TestSignUp() {
-	cmd := NewSignUpCmd(s.accountService)
+	cmd := NewSignUpCmd(s.accountService, s.recovery)
 	cmdBuf := bytes.NewBufferString("")
 	cmd.SetOut(cmdBuf)
 
@@ -90,6 +182,7 @@ func (s *AccountTestSuite) TestSignUp() {
 		fullName := "Ivan Ivanov"
 
 		s.accountService.EXPECT().SignUp(login, password, fullName).Return(nil)
+		s.recovery.EXPECT().Generate().Return("ABCD-EFGH-IJKL", nil)
 
 		cmd.SetArgs([]string{"-l", login, "-p", password, "-n", fullName})
 		err := cmd.Execute()
@@ -99,6 +192,7 @@ func (s *AccountTestSuite) TestSignUp() {
 		s.Require().NoError(err)
 
 		s.Contains(string(out), "Успешная регистрация")
+		s.Contains(string(out), "ABCD-EFGH-IJKL")
 	})
 
 	s.Run("Existing user", func() {
@@ -132,6 +226,266 @@ func (s *AccountTestSuite) TestSignUp() {
 	})
 }
 
+func (s *AccountTestSuite) TestRecover() {
+	cmd := NewRecoverCmd(s.recovery)
+	cmdBuf := bytes.NewBufferString("")
+	cmd.SetOut(cmdBuf)
+
+	s.Run("Correct recovery key", func() {
+		s.recovery.EXPECT().Recover("ivan", "ABCD-EFGH", "n3w-Pa$$word").Return(nil)
+
+		cmd.SetArgs([]string{"-l", "ivan", "-k", "ABCD-EFGH", "-p", "n3w-Pa$$word", "-y"})
+		s.Require().NoError(cmd.Execute())
+
+		out, err := io.ReadAll(cmdBuf)
+		s.Require().NoError(err)
+
+		s.Contains(string(out), "Доступ восстановлен")
+	})
+
+	s.Run("Incorrect recovery key", func() {
+		s.recovery.EXPECT().Recover("ivan", "ABCD-EFGH", "n3w-Pa$$word").Return(recovery.ErrIncorrectKey)
+
+		cmd.SetArgs([]string{"-l", "ivan", "-k", "ABCD-EFGH", "-p", "n3w-Pa$$word", "-y"})
+		s.Require().NoError(cmd.Execute())
+
+		out, err := io.ReadAll(cmdBuf)
+		s.Require().NoError(err)
+
+		s.Contains(string(out), "Неверный логин или ключ восстановления")
+	})
+
+	s.Run("Cancelled", func() {
+		cmd.SetIn(strings.NewReader("n\n"))
+		cmd.SetArgs([]string{"-l", "ivan", "-k", "ABCD-EFGH", "-p", "n3w-Pa$$word", "--yes=false"})
+		s.Require().NoError(cmd.Execute())
+
+		out, err := io.ReadAll(cmdBuf)
+		s.Require().NoError(err)
+
+		s.Contains(string(out), "Восстановление отменено")
+	})
+
+	s.Run("Secrets from stdin", func() {
+		cmd := NewRecoverCmd(s.recovery)
+		cmdBuf := bytes.NewBufferString("")
+		cmd.SetOut(cmdBuf)
+		cmd.SetIn(strings.NewReader("ABCD-EFGH\nn3w-Pa$$word\n"))
+
+		s.recovery.EXPECT().Recover("ivan", "ABCD-EFGH", "n3w-Pa$$word").Return(nil)
+
+		cmd.SetArgs([]string{"-l", "ivan", "-k", "-", "-y"})
+		s.Require().NoError(cmd.Execute())
+
+		out, err := io.ReadAll(cmdBuf)
+		s.Require().NoError(err)
+
+		s.Contains(string(out), "Доступ восстановлен")
+	})
+}
+
+func (s *AccountTestSuite) TestSignOut() {
+	cmd := NewSignOutCmd(s.accountService)
+	cmdBuf := bytes.NewBufferString("")
+	cmd.SetOut(cmdBuf)
+
+	s.Run("Success sign-out", func() {
+		s.accountService.EXPECT().SignOut().Return(nil)
+		s.Require().NoError(cmd.Execute())
+
+		out, err := io.ReadAll(cmdBuf)
+		s.Require().NoError(err)
+
+		s.Contains(string(out), "Выход выполнен")
+	})
+
+	s.Run("Server is unavailable", func() {
+		s.accountService.EXPECT().SignOut().Return(account.ErrSessionNotRevoked)
+		s.Require().NoError(cmd.Execute())
+
+		out, err := io.ReadAll(cmdBuf)
+		s.Require().NoError(err)
+
+		s.Contains(string(out), "сессию на сервере отозвать не удалось")
+	})
+}
+
+func (s *AccountTestSuite) TestPasswd() {
+	cmd := NewPasswdCmd(s.accountService)
+	cmdBuf := bytes.NewBufferString("")
+	cmd.SetOut(cmdBuf)
+
+	s.Run("Confirmed", func() {
+		s.accountService.EXPECT().ChangePassword("old", "new").Return(nil)
+
+		cmd.SetIn(strings.NewReader("y\n"))
+		cmd.SetArgs([]string{"-o", "old", "-p", "new"})
+		s.Require().NoError(cmd.Execute())
+
+		out, err := io.ReadAll(cmdBuf)
+		s.Require().NoError(err)
+
+		s.Contains(string(out), "Пароль успешно изменен")
+	})
+
+	s.Run("Declined", func() {
+		cmd.SetIn(strings.NewReader("n\n"))
+		cmd.SetArgs([]string{"-o", "old", "-p", "new"})
+		s.Require().NoError(cmd.Execute())
+
+		out, err := io.ReadAll(cmdBuf)
+		s.Require().NoError(err)
+
+		s.Contains(string(out), "Смена пароля отменена")
+	})
+
+	s.Run("Incorrect old password", func() {
+		s.accountService.EXPECT().ChangePassword("old", "new").Return(account.ErrIncorrectCredentials)
+
+		cmd.SetArgs([]string{"-o", "old", "-p", "new", "--yes"})
+		s.Require().NoError(cmd.Execute())
+
+		out, err := io.ReadAll(cmdBuf)
+		s.Require().NoError(err)
+
+		s.Contains(string(out), account.ErrIncorrectCredentials.Error())
+	})
+
+	s.Run("Passwords from stdin", func() {
+		cmd := NewPasswdCmd(s.accountService)
+		cmdBuf := bytes.NewBufferString("")
+		cmd.SetOut(cmdBuf)
+		errBuf := bytes.NewBufferString("")
+		cmd.SetErr(errBuf)
+		cmd.SetIn(strings.NewReader("old secret\nnew secret\n"))
+
+		s.accountService.EXPECT().ChangePassword("old secret", "new secret").Return(nil)
+
+		cmd.SetArgs([]string{"-o", "-", "--yes"})
+		s.Require().NoError(cmd.Execute())
+
+		out, err := io.ReadAll(cmdBuf)
+		s.Require().NoError(err)
+
+		s.Contains(string(out), "Пароль успешно изменен")
+		s.Empty(errBuf.String())
+	})
+
+	s.Run("Warning for command line password", func() {
+		cmd := NewPasswdCmd(s.accountService)
+		cmd.SetOut(bytes.NewBufferString(""))
+		errBuf := bytes.NewBufferString("")
+		cmd.SetErr(errBuf)
+
+		s.accountService.EXPECT().ChangePassword("old", "new").Return(nil)
+
+		cmd.SetArgs([]string{"-o", "old", "-p", "new", "--yes"})
+		s.Require().NoError(cmd.Execute())
+
+		s.Contains(errBuf.String(), "Внимание: значение --old-password передано в командной строке")
+		s.Contains(errBuf.String(), "Внимание: значение --new-password передано в командной строке")
+	})
+}
+
+func (s *AccountTestSuite) TestDelete() {
+	cmd := NewDeleteCmd(s.accountService)
+	cmdBuf := bytes.NewBufferString("")
+	cmd.SetOut(cmdBuf)
+
+	s.Run("Confirmed", func() {
+		s.accountService.EXPECT().Delete("example").Return(nil)
+
+		cmd.SetIn(strings.NewReader("да\n"))
+		cmd.SetArgs([]string{"-p", "example"})
+		s.Require().NoError(cmd.Execute())
+
+		out, err := io.ReadAll(cmdBuf)
+		s.Require().NoError(err)
+
+		s.Contains(string(out), "Аккаунт успешно удален")
+	})
+
+	s.Run("Declined by empty answer", func() {
+		cmd.SetIn(strings.NewReader("\n"))
+		cmd.SetArgs([]string{"-p", "example"})
+		s.Require().NoError(cmd.Execute())
+
+		out, err := io.ReadAll(cmdBuf)
+		s.Require().NoError(err)
+
+		s.Contains(string(out), "Удаление аккаунта отменено")
+	})
+
+	s.Run("Password from stdin", func() {
+		cmd := NewDeleteCmd(s.accountService)
+		cmdBuf := bytes.NewBufferString("")
+		cmd.SetOut(cmdBuf)
+		cmd.SetIn(strings.NewReader("example\n"))
+
+		s.accountService.EXPECT().Delete("example").Return(nil)
+
+		cmd.SetArgs([]string{"-p", "-", "--yes"})
+		s.Require().NoError(cmd.Execute())
+
+		out, err := io.ReadAll(cmdBuf)
+		s.Require().NoError(err)
+
+		s.Contains(string(out), "Аккаунт успешно удален")
+	})
+
+	s.Run("Without password", func() {
+		cmd := NewDeleteCmd(s.accountService)
+		cmdBuf := bytes.NewBufferString("")
+		cmd.SetOut(cmdBuf)
+		cmd.SetIn(strings.NewReader(""))
+
+		cmd.SetArgs([]string{"--yes"})
+		s.Require().NoError(cmd.Execute())
+
+		out, err := io.ReadAll(cmdBuf)
+		s.Require().NoError(err)
+
+		s.Contains(string(out), "Значение --password не задано.")
+	})
+}
+
+func (s *AccountTestSuite) TestExport() {
+	cmd := NewExportCmd(s.accountService)
+	cmdBuf := bytes.NewBufferString("")
+	cmd.SetOut(cmdBuf)
+
+	file := filepath.Join(s.T().TempDir(), "export.json")
+
+	s.Run("New file", func() {
+		s.accountService.EXPECT().Export().Return(&model.AccountExport{User: model.UserProfile{Login: "ivan"}}, nil)
+
+		cmd.SetArgs([]string{"-f", file})
+		s.Require().NoError(cmd.Execute())
+
+		out, err := io.ReadAll(cmdBuf)
+		s.Require().NoError(err)
+		s.Contains(string(out), "Данные аккаунта выгружены")
+
+		content, err := os.ReadFile(file)
+		s.Require().NoError(err)
+		s.Contains(string(content), `"login": "ivan"`)
+
+		info, err := os.Stat(file)
+		s.Require().NoError(err)
+		s.Equal(os.FileMode(0600), info.Mode().Perm())
+	})
+
+	s.Run("Existing file declined", func() {
+		cmd.SetIn(strings.NewReader("n\n"))
+		cmd.SetArgs([]string{"-f", file})
+		s.Require().NoError(cmd.Execute())
+
+		out, err := io.ReadAll(cmdBuf)
+		s.Require().NoError(err)
+		s.Contains(string(out), "Выгрузка отменена")
+	})
+}
+
 func TestAccountTestSuite(t *testing.T) {
 	suite.Run(t, new(AccountTestSuite))
 }
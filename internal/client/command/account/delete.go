@@ -0,0 +1,40 @@
+package account
+
+import (
+	"github.com/casnerano/seckeep/internal/client/command/prompt"
+	"github.com/spf13/cobra"
+)
+
+// NewDeleteCmd конструктор команды удаления аккаунта.
+// Если пароль не задан или равен «-», он вводится без отображения.
+func NewDeleteCmd(accountService Service) *cobra.Command {
+	var yes bool
+
+	cmd := cobra.Command{
+		Use:   "delete",
+		Short: "Удаление аккаунта",
+		Run: func(cmd *cobra.Command, args []string) {
+			if !yes && !confirm(cmd, "Аккаунт и все данные на сервере будут удалены без возможности восстановления. Продолжить?") {
+				cmd.Println("Удаление аккаунта отменено.")
+				return
+			}
+
+			password, err := prompt.NewSecrets(cmd).Value("password", "Пароль", true)
+			if err != nil {
+				cmd.Println(prompt.Message(err))
+				return
+			}
+
+			if err = accountService.Delete(password); err != nil {
+				cmd.Println(err)
+				return
+			}
+			cmd.Println("Аккаунт успешно удален.")
+		},
+	}
+
+	cmd.Flags().StringP("password", "p", "", "Пароль («-» или без флага — ввести без отображения)")
+	cmd.Flags().BoolVarP(&yes, "yes", "y", false, "Не запрашивать подтверждение")
+
+	return &cmd
+}
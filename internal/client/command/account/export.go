@@ -0,0 +1,54 @@
+package account
+
+import (
+	"encoding/json"
+	"os"
+
+	"github.com/spf13/cobra"
+)
+
+// NewExportCmd конструктор команды выгрузки всех данных аккаунта с сервера в файл.
+// Секретные данные выгружаются в том виде, в котором хранятся на сервере (зашифрованными).
+func NewExportCmd(accountService Service) *cobra.Command {
+	var file string
+	var yes bool
+
+	cmd := cobra.Command{
+		Use:   "export",
+		Short: "Выгрузка всех данных аккаунта",
+		Run: func(cmd *cobra.Command, args []string) {
+			if _, err := os.Stat(file); err == nil && !yes {
+				if !confirm(cmd, "Файл уже существует и будет перезаписан. Продолжить?") {
+					cmd.Println("Выгрузка отменена.")
+					return
+				}
+			}
+
+			export, err := accountService.Export()
+			if err != nil {
+				cmd.Println(err)
+				return
+			}
+
+			bExport, err := json.MarshalIndent(export, "", "  ")
+			if err != nil {
+				cmd.Println(err)
+				return
+			}
+
+			if err = os.WriteFile(file, bExport, 0600); err != nil {
+				cmd.Println("Не удалось записать файл.")
+				return
+			}
+
+			cmd.Printf("Данные аккаунта выгружены в файл %s (записей: %d).\n", file, len(export.Data))
+		},
+	}
+
+	cmd.Flags().StringVarP(&file, "file", "f", "", "Путь к файлу выгрузки")
+	cmd.Flags().BoolVarP(&yes, "yes", "y", false, "Не запрашивать подтверждение")
+
+	_ = cmd.MarkFlagRequired("file")
+
+	return &cmd
+}
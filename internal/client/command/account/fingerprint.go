@@ -0,0 +1,40 @@
+package account
+
+import (
+	"github.com/spf13/cobra"
+)
+
+// NewFingerprintCmd конструктор команды вывода отпечатка открытых ключей.
+// Отпечатки сверяются по независимому каналу перед предоставлением доступа к общему хранилищу.
+func NewFingerprintCmd(keyring FingerprintService) *cobra.Command {
+	var login string
+
+	cmd := cobra.Command{
+		Use:   "fingerprint",
+		Short: "Отпечаток открытых ключей",
+		PersistentPreRun: func(cmd *cobra.Command, args []string) {
+			// Для запроса ключей с сервера необходима авторизация, которую выполняет корневая команда.
+			if cmd.Parent() != nil && cmd.Parent().Parent() != nil {
+				cmd.Parent().Parent().PersistentPreRun(cmd.Parent(), args)
+			}
+		},
+		Run: func(cmd *cobra.Command, args []string) {
+			fingerprint, err := keyring.Fingerprint(login)
+			if err != nil {
+				cmd.Println(err)
+				return
+			}
+
+			if login == "" {
+				cmd.Println("Отпечаток ваших ключей:")
+			} else {
+				cmd.Printf("Отпечаток ключей пользователя «%s»:\n", login)
+			}
+			cmd.Println(fingerprint)
+		},
+	}
+
+	cmd.Flags().StringVarP(&login, "login", "l", "", "Логин пользователя (по умолчанию — ваши ключи)")
+
+	return &cmd
+}
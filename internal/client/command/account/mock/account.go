@@ -7,6 +7,7 @@ package mock_account
 import (
 	reflect "reflect"
 
+	model "github.com/casnerano/seckeep/internal/server/model"
 	gomock "github.com/golang/mock/gomock"
 )
 
@@ -33,6 +34,49 @@ func (m *MockService) EXPECT() *MockServiceMockRecorder {
 	return m.recorder
 }
 
+// ChangePassword mocks base method.
+func (m *MockService) ChangePassword(oldPassword, newPassword string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ChangePassword", oldPassword, newPassword)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ChangePassword indicates an expected call of ChangePassword.
+func (mr *MockServiceMockRecorder) ChangePassword(oldPassword, newPassword interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ChangePassword", reflect.TypeOf((*MockService)(nil).ChangePassword), oldPassword, newPassword)
+}
+
+// Delete mocks base method.
+func (m *MockService) Delete(password string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", password)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockServiceMockRecorder) Delete(password interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockService)(nil).Delete), password)
+}
+
+// Export mocks base method.
+func (m *MockService) Export() (*model.AccountExport, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Export")
+	ret0, _ := ret[0].(*model.AccountExport)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Export indicates an expected call of Export.
+func (mr *MockServiceMockRecorder) Export() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Export", reflect.TypeOf((*MockService)(nil).Export))
+}
+
 // SignIn mocks base method.
 func (m *MockService) SignIn(login, password string) error {
 	m.ctrl.T.Helper()
@@ -47,6 +91,20 @@ func (mr *MockServiceMockRecorder) SignIn(login, password interface{}) *gomock.C
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SignIn", reflect.TypeOf((*MockService)(nil).SignIn), login, password)
 }
 
+// SignOut mocks base method.
+func (m *MockService) SignOut() error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SignOut")
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SignOut indicates an expected call of SignOut.
+func (mr *MockServiceMockRecorder) SignOut() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SignOut", reflect.TypeOf((*MockService)(nil).SignOut))
+}
+
 // SignUp mocks base method.
 func (m *MockService) SignUp(login, password, fullName string) error {
 	m.ctrl.T.Helper()
@@ -60,3 +118,145 @@ func (mr *MockServiceMockRecorder) SignUp(login, password, fullName interface{})
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SignUp", reflect.TypeOf((*MockService)(nil).SignUp), login, password, fullName)
 }
+
+// MockFingerprintService is a mock of FingerprintService interface.
+type MockFingerprintService struct {
+	ctrl     *gomock.Controller
+	recorder *MockFingerprintServiceMockRecorder
+}
+
+// MockFingerprintServiceMockRecorder is the mock recorder for MockFingerprintService.
+type MockFingerprintServiceMockRecorder struct {
+	mock *MockFingerprintService
+}
+
+// NewMockFingerprintService creates a new mock instance.
+func NewMockFingerprintService(ctrl *gomock.Controller) *MockFingerprintService {
+	mock := &MockFingerprintService{ctrl: ctrl}
+	mock.recorder = &MockFingerprintServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockFingerprintService) EXPECT() *MockFingerprintServiceMockRecorder {
+	return m.recorder
+}
+
+// Fingerprint mocks base method.
+func (m *MockFingerprintService) Fingerprint(login string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Fingerprint", login)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Fingerprint indicates an expected call of Fingerprint.
+func (mr *MockFingerprintServiceMockRecorder) Fingerprint(login interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Fingerprint", reflect.TypeOf((*MockFingerprintService)(nil).Fingerprint), login)
+}
+
+// MockRecoveryService is a mock of RecoveryService interface.
+type MockRecoveryService struct {
+	ctrl     *gomock.Controller
+	recorder *MockRecoveryServiceMockRecorder
+}
+
+// MockRecoveryServiceMockRecorder is the mock recorder for MockRecoveryService.
+type MockRecoveryServiceMockRecorder struct {
+	mock *MockRecoveryService
+}
+
+// NewMockRecoveryService creates a new mock instance.
+func NewMockRecoveryService(ctrl *gomock.Controller) *MockRecoveryService {
+	mock := &MockRecoveryService{ctrl: ctrl}
+	mock.recorder = &MockRecoveryServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRecoveryService) EXPECT() *MockRecoveryServiceMockRecorder {
+	return m.recorder
+}
+
+// Generate mocks base method.
+func (m *MockRecoveryService) Generate() (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Generate")
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Generate indicates an expected call of Generate.
+func (mr *MockRecoveryServiceMockRecorder) Generate() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Generate", reflect.TypeOf((*MockRecoveryService)(nil).Generate))
+}
+
+// Recover mocks base method.
+func (m *MockRecoveryService) Recover(login, recoveryKey, newPassword string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Recover", login, recoveryKey, newPassword)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Recover indicates an expected call of Recover.
+func (mr *MockRecoveryServiceMockRecorder) Recover(login, recoveryKey, newPassword interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Recover", reflect.TypeOf((*MockRecoveryService)(nil).Recover), login, recoveryKey, newPassword)
+}
+
+// MockKeyring is a mock of Keyring interface.
+type MockKeyring struct {
+	ctrl     *gomock.Controller
+	recorder *MockKeyringMockRecorder
+}
+
+// MockKeyringMockRecorder is the mock recorder for MockKeyring.
+type MockKeyringMockRecorder struct {
+	mock *MockKeyring
+}
+
+// NewMockKeyring creates a new mock instance.
+func NewMockKeyring(ctrl *gomock.Controller) *MockKeyring {
+	mock := &MockKeyring{ctrl: ctrl}
+	mock.recorder = &MockKeyringMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockKeyring) EXPECT() *MockKeyringMockRecorder {
+	return m.recorder
+}
+
+// Fingerprint mocks base method.
+func (m *MockKeyring) Fingerprint(login string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Fingerprint", login)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Fingerprint indicates an expected call of Fingerprint.
+func (mr *MockKeyringMockRecorder) Fingerprint(login interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Fingerprint", reflect.TypeOf((*MockKeyring)(nil).Fingerprint), login)
+}
+
+// Setup mocks base method.
+func (m *MockKeyring) Setup() error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Setup")
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Setup indicates an expected call of Setup.
+func (mr *MockKeyringMockRecorder) Setup() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Setup", reflect.TypeOf((*MockKeyring)(nil).Setup))
+}
@@ -0,0 +1,48 @@
+package account
+
+import (
+	"github.com/casnerano/seckeep/internal/client/command/prompt"
+	"github.com/spf13/cobra"
+)
+
+// NewPasswdCmd конструктор команды смены пароля.
+// Если текущий или новый пароль не задан или равен «-», он вводится без отображения.
+func NewPasswdCmd(accountService Service) *cobra.Command {
+	var yes bool
+
+	cmd := cobra.Command{
+		Use:   "passwd",
+		Short: "Смена пароля",
+		Run: func(cmd *cobra.Command, args []string) {
+			if !yes && !confirm(cmd, "Сессии на других устройствах будут завершены. Продолжить?") {
+				cmd.Println("Смена пароля отменена.")
+				return
+			}
+
+			secrets := prompt.NewSecrets(cmd)
+			oldPassword, err := secrets.Value("old-password", "Текущий пароль", true)
+			if err != nil {
+				cmd.Println(prompt.Message(err))
+				return
+			}
+
+			newPassword, err := secrets.Value("new-password", "Новый пароль", true)
+			if err != nil {
+				cmd.Println(prompt.Message(err))
+				return
+			}
+
+			if err = accountService.ChangePassword(oldPassword, newPassword); err != nil {
+				cmd.Println(err)
+				return
+			}
+			cmd.Println("Пароль успешно изменен.")
+		},
+	}
+
+	cmd.Flags().StringP("old-password", "o", "", "Текущий пароль («-» или без флага — ввести без отображения)")
+	cmd.Flags().StringP("new-password", "p", "", "Новый пароль («-» или без флага — ввести без отображения)")
+	cmd.Flags().BoolVarP(&yes, "yes", "y", false, "Не запрашивать подтверждение")
+
+	return &cmd
+}
@@ -0,0 +1,104 @@
+package account
+
+import (
+	"errors"
+
+	"github.com/casnerano/seckeep/internal/client/command/prompt"
+	"github.com/casnerano/seckeep/internal/client/service/recovery"
+	"github.com/spf13/cobra"
+)
+
+// NewRecoveryKeyCmd конструктор команды управления ключом восстановления.
+func NewRecoveryKeyCmd(recoveryService RecoveryService) *cobra.Command {
+	cmd := cobra.Command{
+		Use:   "recovery-key",
+		Short: "Ключ восстановления",
+	}
+
+	cmd.AddCommand(&cobra.Command{
+		Use:   "generate",
+		Short: "Сгенерировать новый ключ восстановления (предыдущий перестанет действовать)",
+		Run: func(cmd *cobra.Command, args []string) {
+			generateRecoveryKey(cmd, recoveryService)
+		},
+	})
+
+	return &cmd
+}
+
+// NewRecoverCmd конструктор команды восстановления доступа по ключу восстановления.
+// Устанавливает новый пароль и заменяет ключ шифрования текущего профиля восстановленным.
+// Если ключ восстановления или новый пароль не задан или равен «-», он вводится без отображения.
+func NewRecoverCmd(recoveryService RecoveryService) *cobra.Command {
+	var login string
+	var yes bool
+
+	cmd := cobra.Command{
+		Use:   "recover",
+		Short: "Восстановить доступ по ключу восстановления",
+		Run: func(cmd *cobra.Command, args []string) {
+			if !yes && !confirm(cmd, "Ключ шифрования профиля будет заменен, сессии на других устройствах завершены. Продолжить?") {
+				cmd.Println("Восстановление отменено.")
+				return
+			}
+
+			secrets := prompt.NewSecrets(cmd)
+			recoveryKey, err := secrets.Value("recovery-key", "Ключ восстановления", true)
+			if err != nil {
+				cmd.Println(prompt.Message(err))
+				return
+			}
+
+			newPassword, err := secrets.Value("new-password", "Новый пароль", true)
+			if err != nil {
+				cmd.Println(prompt.Message(err))
+				return
+			}
+
+			if err = recoveryService.Recover(login, recoveryKey, newPassword); err != nil {
+				switch {
+				case errors.Is(err, recovery.ErrInvalidKey):
+					cmd.Println("Некорректный формат ключа восстановления.")
+				case errors.Is(err, recovery.ErrIncorrectKey):
+					cmd.Println("Неверный логин или ключ восстановления.")
+				case errors.Is(err, recovery.ErrTooManyRequests):
+					cmd.Println("Слишком много попыток, повторите позже.")
+				default:
+					cmd.Println(err)
+				}
+				return
+			}
+
+			cmd.Println("Доступ восстановлен, ключ шифрования профиля заменен.")
+			cmd.Println("Авторизуйтесь с новым паролем: seckeep account sign-in.")
+		},
+	}
+
+	cmd.Flags().StringVarP(&login, "login", "l", "", "Логин")
+	cmd.Flags().StringP("recovery-key", "k", "", "Ключ восстановления («-» или без флага — ввести без отображения)")
+	cmd.Flags().StringP("new-password", "p", "", "Новый пароль («-» или без флага — ввести без отображения)")
+	cmd.Flags().BoolVarP(&yes, "yes", "y", false, "Не запрашивать подтверждение")
+
+	_ = cmd.MarkFlagRequired("login")
+
+	return &cmd
+}
+
+// generateRecoveryKey генерирует и выводит ключ восстановления.
+func generateRecoveryKey(cmd *cobra.Command, recoveryService RecoveryService) {
+	recoveryKey, err := recoveryService.Generate()
+	if err != nil {
+		if errors.Is(err, recovery.ErrUnauthorized) {
+			cmd.Println("Необходима авторизация.")
+			return
+		}
+		cmd.Println("Не удалось создать ключ восстановления:", err)
+		return
+	}
+
+	cmd.Println()
+	cmd.Println("Ключ восстановления:")
+	cmd.Println(recoveryKey)
+	cmd.Println("Сохраните его в надежном месте, он показывается только один раз.")
+	cmd.Println("Без него при утере пароля и ключа профиля данные восстановить невозможно.")
+}
@@ -1,16 +1,39 @@
 package account
 
-import "github.com/spf13/cobra"
+import (
+	"errors"
+
+	"github.com/casnerano/seckeep/internal/client/command/prompt"
+	"github.com/casnerano/seckeep/internal/client/service/account"
+	"github.com/spf13/cobra"
+)
 
 // NewSignInCmd конструктор команда авторизации пользователя на сервере.
-func NewSignInCmd(account Service) *cobra.Command {
-	var login, password string
+// Если пароль не задан или равен «-», он вводится без отображения.
+func NewSignInCmd(accountService Service) *cobra.Command {
+	var login string
 
 	cmd := cobra.Command{
 		Use:   "sign-in",
 		Short: "Авторизация",
 		Run: func(cmd *cobra.Command, args []string) {
-			if err := account.SignIn(login, password); err != nil {
+			password, err := prompt.NewSecrets(cmd).Value("password", "Пароль", true)
+			if err != nil {
+				cmd.Println(prompt.Message(err))
+				return
+			}
+
+			if err = accountService.SignIn(login, password); err != nil {
+				var tmrErr *account.TooManyRequestsError
+				if errors.As(err, &tmrErr) {
+					cmd.Printf("Слишком много попыток, повторите через %s.\n", tmrErr.RetryAfter)
+					return
+				}
+				if errors.Is(err, account.ErrKeysNotReady) {
+					cmd.Println("Успешная авторизация ;)")
+					cmd.Println("Не удалось настроить ключи шифрования, общие хранилища недоступны:", err)
+					return
+				}
 				cmd.Println(err)
 				return
 			}
@@ -19,10 +42,9 @@ func NewSignInCmd(account Service) *cobra.Command {
 	}
 
 	cmd.Flags().StringVarP(&login, "login", "l", "", "Логин")
-	cmd.Flags().StringVarP(&password, "password", "p", "", "Пароль")
+	cmd.Flags().StringP("password", "p", "", "Пароль («-» или без флага — ввести без отображения)")
 
 	_ = cmd.MarkFlagRequired("login")
-	_ = cmd.MarkFlagRequired("password")
 
 	return &cmd
 }
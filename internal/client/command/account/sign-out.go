@@ -0,0 +1,30 @@
+package account
+
+import (
+	"errors"
+
+	"github.com/casnerano/seckeep/internal/client/service/account"
+	"github.com/spf13/cobra"
+)
+
+// NewSignOutCmd конструктор команды выхода из аккаунта.
+// Удаляет локальный токен и отзывает сессию на сервере.
+func NewSignOutCmd(accountService Service) *cobra.Command {
+	cmd := cobra.Command{
+		Use:   "sign-out",
+		Short: "Выход",
+		Run: func(cmd *cobra.Command, args []string) {
+			if err := accountService.SignOut(); err != nil {
+				if errors.Is(err, account.ErrSessionNotRevoked) {
+					cmd.Println("Локальный токен удален, но сессию на сервере отозвать не удалось.")
+					return
+				}
+				cmd.Println(err)
+				return
+			}
+			cmd.Println("Выход выполнен, сессия завершена.")
+		},
+	}
+
+	return &cmd
+}
@@ -1,29 +1,54 @@
 package account
 
-import "github.com/spf13/cobra"
+import (
+	"errors"
+
+	"github.com/casnerano/seckeep/internal/client/command/prompt"
+	"github.com/casnerano/seckeep/internal/client/service/account"
+	"github.com/spf13/cobra"
+)
 
 // NewSignUpCmd конструктор команда регистрации пользователя на сервере.
-func NewSignUpCmd(account Service) *cobra.Command {
-	var login, password, name string
+// После регистрации генерируется и выводится ключ восстановления.
+// Если пароль не задан или равен «-», он вводится без отображения.
+func NewSignUpCmd(accountService Service, recovery RecoveryService) *cobra.Command {
+	var login, name string
 
 	cmd := cobra.Command{
 		Use:   "sign-up",
 		Short: "Регистрация",
 		Run: func(cmd *cobra.Command, args []string) {
-			if err := account.SignUp(login, password, name); err != nil {
-				cmd.Println(err)
+			password, err := prompt.NewSecrets(cmd).Value("password", "Пароль", true)
+			if err != nil {
+				cmd.Println(prompt.Message(err))
 				return
 			}
-			cmd.Println("Успешная регистрация ;)")
+
+			if err = accountService.SignUp(login, password, name); err != nil {
+				var tmrErr *account.TooManyRequestsError
+				if errors.As(err, &tmrErr) {
+					cmd.Printf("Слишком много попыток, повторите через %s.\n", tmrErr.RetryAfter)
+					return
+				}
+				if !errors.Is(err, account.ErrKeysNotReady) {
+					cmd.Println(err)
+					return
+				}
+				cmd.Println("Успешная регистрация ;)")
+				cmd.Println("Не удалось настроить ключи шифрования, общие хранилища недоступны:", err)
+			} else {
+				cmd.Println("Успешная регистрация ;)")
+			}
+
+			generateRecoveryKey(cmd, recovery)
 		},
 	}
 
 	cmd.Flags().StringVarP(&login, "login", "l", "", "Логин")
-	cmd.Flags().StringVarP(&password, "password", "p", "", "Пароль")
+	cmd.Flags().StringP("password", "p", "", "Пароль («-» или без флага — ввести без отображения)")
 	cmd.Flags().StringVarP(&name, "name", "n", "", "Имя")
 
 	_ = cmd.MarkFlagRequired("login")
-	_ = cmd.MarkFlagRequired("password")
 	_ = cmd.MarkFlagRequired("name")
 
 	return &cmd
@@ -0,0 +1,250 @@
+package audit
+
+//go:generate mockgen -destination=mock/audit.go -source=audit.go
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/casnerano/seckeep/internal/client/command/exitcode"
+	"github.com/casnerano/seckeep/internal/client/service/audit"
+	"github.com/casnerano/seckeep/pkg/pwned"
+	"github.com/casnerano/seckeep/pkg/strength"
+	"github.com/spf13/cobra"
+)
+
+// Коды завершения команды проверки паролей.
+const (
+	// ExitCodeIssues код завершения, если найдено больше проблем, чем задано флагом --max-issues.
+	ExitCodeIssues = 1
+
+	// ExitCodeError код завершения, если проверку выполнить не удалось.
+	ExitCodeError = 2
+)
+
+// Service интерфейс проверки паролей.
+type Service interface {
+	Run(opts audit.Options) *audit.Report
+	Breaches(checker audit.BreachChecker) (*audit.BreachReport, error)
+}
+
+// Dataset интерфейс локальной базы утечек.
+type Dataset interface {
+	Count(password string) (int, error)
+	Close() error
+}
+
+// DatasetOpener функция открытия локальной базы утечек по пути к файлу.
+type DatasetOpener func(fName string) (Dataset, error)
+
+// SyncerService интерфейс синхронизации сервера и клиента.
+type SyncerService interface {
+	ServerHealthErr() error
+	RunWithStatus()
+}
+
+// NewCmd конструктор команды проверки паролей учетных записей.
+// Содердит инициализацию дочерних команд.
+// В формате JSON печатается только отчет: приветствие и синхронизация пропускаются,
+// проверяются записи локального хранилища.
+func NewCmd(auditService Service, openDataset DatasetOpener, syncer SyncerService) *cobra.Command {
+	var (
+		minScore, maxAgeDays int
+		auditCmd             *cobra.Command
+	)
+
+	auditCmd = &cobra.Command{
+		Use:   "audit",
+		Short: "Проверка паролей",
+		Long: "Проверка паролей учетных записей: слабые, повторяющиеся и давно не менявшиеся пароли,\n" +
+			"учетные записи без адреса сайта. С флагом --max-issues клиент завершается с кодом 1,\n" +
+			"если проблем больше заданного кол-ва, и с кодом 2, если проверку выполнить не удалось.",
+		SilenceErrors: true,
+		SilenceUsage:  true,
+		PersistentPreRun: func(cmd *cobra.Command, args []string) {
+			if jsonOutput, _ := cmd.Flags().GetBool("json"); jsonOutput {
+				return
+			}
+
+			if root := auditCmd.Parent(); root != nil && root.PersistentPreRun != nil {
+				root.PersistentPreRun(cmd, args)
+			}
+
+			if syncer.ServerHealthErr() == nil {
+				syncer.RunWithStatus()
+			}
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if minScore < strength.ScoreVeryWeak || minScore > strength.ScoreVeryStrong {
+				return fail(cmd, "Оценка стойкости задается от 0 до 4.")
+			}
+
+			report := auditService.Run(audit.Options{
+				MinScore: minScore,
+				MaxAge:   time.Duration(maxAgeDays) * 24 * time.Hour,
+			})
+
+			return writeReport(cmd, report, report.Issues(), func(w io.Writer) {
+				printReport(w, report)
+			})
+		},
+	}
+
+	auditCmd.Flags().IntVar(&minScore, "min-score", audit.DefaultMinScore, "Минимальная оценка стойкости пароля (0–4)")
+	auditCmd.Flags().IntVar(
+		&maxAgeDays,
+		"max-age",
+		int(audit.DefaultMaxAge/(24*time.Hour)),
+		"Срок в днях, после которого пароль считается старым (0 — не проверять)",
+	)
+	auditCmd.PersistentFlags().Int("max-issues", -1, "Допустимое кол-во проблем, при превышении код завершения 1")
+	auditCmd.PersistentFlags().Bool("json", false, "Вывести отчет в формате JSON")
+
+	auditCmd.AddCommand(NewBreachesCmd(auditService, openDataset))
+
+	return auditCmd
+}
+
+// NewBreachesCmd конструктор команды проверки паролей по локальной базе утечек Have I Been Pwned.
+// Пароли не покидают клиент: поиск выполняется по файлу базы.
+func NewBreachesCmd(auditService Service, openDataset DatasetOpener) *cobra.Command {
+	var dataset string
+
+	cmd := cobra.Command{
+		Use:   "breaches",
+		Short: "Проверка паролей по базе утечек",
+		Long: "Проверка паролей по загруженной базе утечек Have I Been Pwned\n" +
+			"(SHA-1 хеши, отсортированные по хешу, в формате «хеш:кол-во»). Запросы в сеть не выполняются.",
+		SilenceErrors: true,
+		SilenceUsage:  true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			checker, err := openDataset(dataset)
+			if err != nil {
+				return fail(cmd, "Не удалось открыть базу утечек.")
+			}
+			defer func() { _ = checker.Close() }()
+
+			report, err := auditService.Breaches(checker)
+			if err != nil {
+				if errors.Is(err, pwned.ErrInvalidDataset) {
+					return fail(cmd, "Файл не соответствует формату базы утечек (SHA1:кол-во).")
+				}
+				return fail(cmd, err.Error())
+			}
+
+			return writeReport(cmd, report, report.Issues(), func(w io.Writer) {
+				printBreachReport(w, report)
+			})
+		},
+	}
+
+	cmd.Flags().StringVar(&dataset, "dataset", "", "Путь к файлу базы утечек")
+	_ = cmd.MarkFlagRequired("dataset")
+
+	return &cmd
+}
+
+// writeReport печатает отчет в текстовом виде или, с флагом --json, в формате JSON.
+// Возвращает ошибку exitcode.Error, если проблем больше, чем задано флагом --max-issues.
+func writeReport(cmd *cobra.Command, report any, issues int, printText func(w io.Writer)) error {
+	if jsonOutput, _ := cmd.Flags().GetBool("json"); jsonOutput {
+		encoder := json.NewEncoder(cmd.OutOrStdout())
+		encoder.SetIndent("", "  ")
+		if err := encoder.Encode(report); err != nil {
+			return err
+		}
+	} else {
+		printText(cmd.OutOrStdout())
+	}
+
+	if maxIssues, _ := cmd.Flags().GetInt("max-issues"); maxIssues >= 0 && issues > maxIssues {
+		return exitcode.New(ExitCodeIssues)
+	}
+	return nil
+}
+
+// fail печатает сообщение об ошибке в поток ошибок, чтобы не смешивать его с отчетом,
+// и возвращает ошибку exitcode.Error с кодом ExitCodeError.
+func fail(cmd *cobra.Command, message string) error {
+	fmt.Fprintln(cmd.ErrOrStderr(), message)
+	return exitcode.New(ExitCodeError)
+}
+
+// printReport печатает отчет в текстовом виде.
+func printReport(w io.Writer, report *audit.Report) {
+	fmt.Fprintf(w, "Проверено учетных записей: %d.\n", report.Checked)
+
+	if len(report.Weak) > 0 {
+		fmt.Fprintf(w, "\nСлабые пароли (%d):\n", len(report.Weak))
+		for _, item := range report.Weak {
+			fmt.Fprintf(w, "  %s — оценка %d из 4, ~%.0f бит\n", label(item.Item), item.Score, item.Entropy)
+		}
+	}
+
+	if len(report.Reused) > 0 {
+		fmt.Fprintf(w, "\nПовторяющиеся пароли (%d):\n", len(report.Reused))
+		for _, group := range report.Reused {
+			fmt.Fprintln(w, "  Один пароль у записей:")
+			for _, item := range group {
+				fmt.Fprintf(w, "    %s\n", label(item))
+			}
+		}
+	}
+
+	if len(report.Old) > 0 {
+		fmt.Fprintf(w, "\nПароли старше %d дн. (%d):\n", report.Options.MaxAgeDays, len(report.Old))
+		for _, item := range report.Old {
+			fmt.Fprintf(
+				w,
+				"  %s — не менялся %d дн. (с %s)\n",
+				label(item.Item),
+				item.Days,
+				item.ChangedAt.Local().Format("02.01.2006"),
+			)
+		}
+	}
+
+	if len(report.NoURI) > 0 {
+		fmt.Fprintf(w, "\nБез адреса сайта (%d):\n", len(report.NoURI))
+		for _, item := range report.NoURI {
+			fmt.Fprintf(w, "  %s\n", label(item))
+		}
+	}
+
+	if issues := report.Issues(); issues > 0 {
+		fmt.Fprintf(w, "\nНайдено проблем: %d.\n", issues)
+	} else {
+		fmt.Fprintln(w, "Проблем не найдено.")
+	}
+}
+
+// printBreachReport печатает отчет о проверке по базе утечек в текстовом виде.
+func printBreachReport(w io.Writer, report *audit.BreachReport) {
+	fmt.Fprintf(w, "Проверено учетных записей: %d.\n", report.Checked)
+
+	if len(report.Breached) == 0 {
+		fmt.Fprintln(w, "Пароли в базе утечек не найдены.")
+		return
+	}
+
+	fmt.Fprintf(w, "\nПароли из утечек (%d):\n", len(report.Breached))
+	for _, item := range report.Breached {
+		fmt.Fprintf(w, "  %s — встречался в утечках %d раз\n", label(item.Item), item.Count)
+	}
+}
+
+// label возвращает краткое описание учетной записи: индекс, заголовок и логин.
+func label(item audit.Item) string {
+	result := fmt.Sprintf("#%d", item.Index)
+	if item.Title != "" {
+		result += " " + item.Title
+	}
+	result += " (" + item.Login + ")"
+	if item.VaultUUID != "" {
+		result += " [общее хранилище]"
+	}
+	return result
+}
@@ -0,0 +1,222 @@
+package audit
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io"
+	"os"
+	"testing"
+	"time"
+
+	mock_audit "github.com/casnerano/seckeep/internal/client/command/audit/mock"
+	"github.com/casnerano/seckeep/internal/client/command/exitcode"
+	"github.com/casnerano/seckeep/internal/client/service/audit"
+	"github.com/casnerano/seckeep/pkg/pwned"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/suite"
+)
+
+type AuditCmdTestSuite struct {
+	suite.Suite
+	auditService  *mock_audit.MockService
+	syncerService *mock_audit.MockSyncerService
+	dataset       *mock_audit.MockDataset
+	report        *audit.Report
+}
+
+func (s *AuditCmdTestSuite) openDataset(fName string) (Dataset, error) {
+	if fName != "pwned.txt" {
+		return nil, os.ErrNotExist
+	}
+	return s.dataset, nil
+}
+
+func (s *AuditCmdTestSuite) SetupTest() {
+	ctrl := gomock.NewController(s.T())
+	s.auditService = mock_audit.NewMockService(ctrl)
+	s.syncerService = mock_audit.NewMockSyncerService(ctrl)
+	s.dataset = mock_audit.NewMockDataset(ctrl)
+
+	mail := audit.Item{Index: 1, Title: "Mail", Login: "ivan@example.com"}
+	root := audit.Item{Index: 3, Login: "root", VaultUUID: "vault-uuid"}
+
+	s.report = &audit.Report{
+		Checked: 4,
+		Weak:    []audit.WeakItem{{Item: mail, Score: 0, Entropy: 3}},
+		Reused:  [][]audit.Item{{mail, root}},
+		Old:     []audit.OldItem{{Item: root, ChangedAt: time.Date(2022, 6, 1, 12, 0, 0, 0, time.UTC), Days: 731}},
+		NoURI:   []audit.Item{mail},
+		Options: audit.ReportLimits{MinScore: 3, MaxAgeDays: 365},
+	}
+}
+
+func (s *AuditCmdTestSuite) TestText() {
+	s.syncerService.EXPECT().ServerHealthErr().Return(errors.New("offline"))
+	s.auditService.EXPECT().Run(audit.Options{MinScore: 3, MaxAge: 365 * 24 * time.Hour}).Return(s.report)
+
+	cmd := NewCmd(s.auditService, s.openDataset, s.syncerService)
+	cmdBuf := bytes.NewBufferString("")
+	cmd.SetOut(cmdBuf)
+
+	cmd.SetArgs([]string{})
+	s.Require().NoError(cmd.Execute())
+
+	out, err := io.ReadAll(cmdBuf)
+	s.Require().NoError(err)
+
+	s.Contains(string(out), "Проверено учетных записей: 4.")
+	s.Contains(string(out), "#1 Mail (ivan@example.com) — оценка 0 из 4, ~3 бит")
+	s.Contains(string(out), "    #3 (root) [общее хранилище]")
+	s.Contains(string(out), "Пароли старше 365 дн. (1):")
+	s.Contains(string(out), "не менялся 731 дн.")
+	s.Contains(string(out), "Без адреса сайта (1):")
+	s.Contains(string(out), "Найдено проблем: 4.")
+}
+
+func (s *AuditCmdTestSuite) TestJSON() {
+	s.auditService.EXPECT().Run(audit.Options{MinScore: 2}).Return(s.report)
+
+	cmd := NewCmd(s.auditService, s.openDataset, s.syncerService)
+	cmdBuf := bytes.NewBufferString("")
+	cmd.SetOut(cmdBuf)
+
+	cmd.SetArgs([]string{"--json", "--min-score", "2", "--max-age", "0"})
+	s.Require().NoError(cmd.Execute())
+
+	report := audit.Report{}
+	s.Require().NoError(json.NewDecoder(cmdBuf).Decode(&report))
+	s.Equal(4, report.Checked)
+	s.Equal(s.report.Reused, report.Reused)
+	s.Equal(731, report.Old[0].Days)
+}
+
+func (s *AuditCmdTestSuite) TestMaxIssues() {
+	s.Run("Exceeded", func() {
+		s.auditService.EXPECT().Run(gomock.Any()).Return(s.report)
+
+		cmd := NewCmd(s.auditService, s.openDataset, s.syncerService)
+		cmd.SetOut(bytes.NewBufferString(""))
+
+		cmd.SetArgs([]string{"--json", "--max-issues", "3"})
+
+		var exitErr *exitcode.Error
+		s.Require().ErrorAs(cmd.Execute(), &exitErr)
+		s.Equal(ExitCodeIssues, exitErr.Code)
+	})
+
+	s.Run("Within limit", func() {
+		s.auditService.EXPECT().Run(gomock.Any()).Return(s.report)
+
+		cmd := NewCmd(s.auditService, s.openDataset, s.syncerService)
+		cmd.SetOut(bytes.NewBufferString(""))
+
+		cmd.SetArgs([]string{"--json", "--max-issues", "4"})
+		s.Require().NoError(cmd.Execute())
+	})
+}
+
+func (s *AuditCmdTestSuite) TestInvalidScore() {
+	cmd := NewCmd(s.auditService, s.openDataset, s.syncerService)
+	cmdBuf := bytes.NewBufferString("")
+	cmd.SetOut(cmdBuf)
+
+	errBuf := bytes.NewBufferString("")
+	cmd.SetErr(errBuf)
+
+	cmd.SetArgs([]string{"--json", "--min-score", "5"})
+
+	var exitErr *exitcode.Error
+	s.Require().ErrorAs(cmd.Execute(), &exitErr)
+	s.Equal(ExitCodeError, exitErr.Code)
+
+	s.Empty(cmdBuf.String())
+	s.Contains(errBuf.String(), "Оценка стойкости задается от 0 до 4.")
+}
+
+func (s *AuditCmdTestSuite) TestBreaches() {
+	report := &audit.BreachReport{
+		Checked:  4,
+		Breached: []audit.BreachedItem{{Item: audit.Item{Index: 1, Title: "Mail", Login: "ivan@example.com"}, Count: 52000}},
+	}
+
+	s.Run("Text", func() {
+		s.syncerService.EXPECT().ServerHealthErr().Return(errors.New("offline"))
+		s.auditService.EXPECT().Breaches(s.dataset).Return(report, nil)
+		s.dataset.EXPECT().Close().Return(nil)
+
+		cmd := NewCmd(s.auditService, s.openDataset, s.syncerService)
+		cmdBuf := bytes.NewBufferString("")
+		cmd.SetOut(cmdBuf)
+
+		cmd.SetArgs([]string{"breaches", "--dataset", "pwned.txt"})
+		s.Require().NoError(cmd.Execute())
+
+		out, err := io.ReadAll(cmdBuf)
+		s.Require().NoError(err)
+
+		s.Contains(string(out), "Пароли из утечек (1):")
+		s.Contains(string(out), "#1 Mail (ivan@example.com) — встречался в утечках 52000 раз")
+	})
+
+	s.Run("JSON with max issues", func() {
+		s.auditService.EXPECT().Breaches(s.dataset).Return(report, nil)
+		s.dataset.EXPECT().Close().Return(nil)
+
+		cmd := NewCmd(s.auditService, s.openDataset, s.syncerService)
+		cmdBuf := bytes.NewBufferString("")
+		cmd.SetOut(cmdBuf)
+
+		cmd.SetArgs([]string{"breaches", "--dataset", "pwned.txt", "--json", "--max-issues", "0"})
+
+		var exitErr *exitcode.Error
+		s.Require().ErrorAs(cmd.Execute(), &exitErr)
+
+		decoded := audit.BreachReport{}
+		s.Require().NoError(json.NewDecoder(cmdBuf).Decode(&decoded))
+		s.Equal(*report, decoded)
+	})
+
+	s.Run("Missing dataset", func() {
+		cmd := NewCmd(s.auditService, s.openDataset, s.syncerService)
+		cmdBuf := bytes.NewBufferString("")
+		cmd.SetOut(cmdBuf)
+
+		errBuf := bytes.NewBufferString("")
+		cmd.SetErr(errBuf)
+
+		cmd.SetArgs([]string{"breaches", "--dataset", "missing.txt", "--json"})
+
+		var exitErr *exitcode.Error
+		s.Require().ErrorAs(cmd.Execute(), &exitErr)
+		s.Equal(ExitCodeError, exitErr.Code)
+
+		s.Empty(cmdBuf.String())
+		s.Contains(errBuf.String(), "Не удалось открыть базу утечек.")
+	})
+
+	s.Run("Invalid dataset", func() {
+		s.auditService.EXPECT().Breaches(s.dataset).Return(nil, pwned.ErrInvalidDataset)
+		s.dataset.EXPECT().Close().Return(nil)
+
+		cmd := NewCmd(s.auditService, s.openDataset, s.syncerService)
+		cmdBuf := bytes.NewBufferString("")
+		cmd.SetOut(cmdBuf)
+
+		errBuf := bytes.NewBufferString("")
+		cmd.SetErr(errBuf)
+
+		cmd.SetArgs([]string{"breaches", "--dataset", "pwned.txt", "--json"})
+
+		var exitErr *exitcode.Error
+		s.Require().ErrorAs(cmd.Execute(), &exitErr)
+		s.Equal(ExitCodeError, exitErr.Code)
+
+		s.Empty(cmdBuf.String())
+		s.Contains(errBuf.String(), "Файл не соответствует формату базы утечек")
+	})
+}
+
+func TestAuditCmdTestSuite(t *testing.T) {
+	suite.Run(t, new(AuditCmdTestSuite))
+}
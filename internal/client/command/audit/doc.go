@@ -0,0 +1,2 @@
+// Package audit содержит команду проверки паролей учетных записей.
+package audit
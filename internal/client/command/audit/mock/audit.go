@@ -0,0 +1,165 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: audit.go
+
+// Package mock_audit is a generated GoMock package.
+package mock_audit
+
+import (
+	reflect "reflect"
+
+	audit "github.com/casnerano/seckeep/internal/client/service/audit"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockService is a mock of Service interface.
+type MockService struct {
+	ctrl     *gomock.Controller
+	recorder *MockServiceMockRecorder
+}
+
+// MockServiceMockRecorder is the mock recorder for MockService.
+type MockServiceMockRecorder struct {
+	mock *MockService
+}
+
+// NewMockService creates a new mock instance.
+func NewMockService(ctrl *gomock.Controller) *MockService {
+	mock := &MockService{ctrl: ctrl}
+	mock.recorder = &MockServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockService) EXPECT() *MockServiceMockRecorder {
+	return m.recorder
+}
+
+// Breaches mocks base method.
+func (m *MockService) Breaches(checker audit.BreachChecker) (*audit.BreachReport, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Breaches", checker)
+	ret0, _ := ret[0].(*audit.BreachReport)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Breaches indicates an expected call of Breaches.
+func (mr *MockServiceMockRecorder) Breaches(checker interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Breaches", reflect.TypeOf((*MockService)(nil).Breaches), checker)
+}
+
+// Run mocks base method.
+func (m *MockService) Run(opts audit.Options) *audit.Report {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Run", opts)
+	ret0, _ := ret[0].(*audit.Report)
+	return ret0
+}
+
+// Run indicates an expected call of Run.
+func (mr *MockServiceMockRecorder) Run(opts interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Run", reflect.TypeOf((*MockService)(nil).Run), opts)
+}
+
+// MockDataset is a mock of Dataset interface.
+type MockDataset struct {
+	ctrl     *gomock.Controller
+	recorder *MockDatasetMockRecorder
+}
+
+// MockDatasetMockRecorder is the mock recorder for MockDataset.
+type MockDatasetMockRecorder struct {
+	mock *MockDataset
+}
+
+// NewMockDataset creates a new mock instance.
+func NewMockDataset(ctrl *gomock.Controller) *MockDataset {
+	mock := &MockDataset{ctrl: ctrl}
+	mock.recorder = &MockDatasetMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockDataset) EXPECT() *MockDatasetMockRecorder {
+	return m.recorder
+}
+
+// Close mocks base method.
+func (m *MockDataset) Close() error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Close")
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Close indicates an expected call of Close.
+func (mr *MockDatasetMockRecorder) Close() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Close", reflect.TypeOf((*MockDataset)(nil).Close))
+}
+
+// Count mocks base method.
+func (m *MockDataset) Count(password string) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Count", password)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Count indicates an expected call of Count.
+func (mr *MockDatasetMockRecorder) Count(password interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Count", reflect.TypeOf((*MockDataset)(nil).Count), password)
+}
+
+// MockSyncerService is a mock of SyncerService interface.
+type MockSyncerService struct {
+	ctrl     *gomock.Controller
+	recorder *MockSyncerServiceMockRecorder
+}
+
+// MockSyncerServiceMockRecorder is the mock recorder for MockSyncerService.
+type MockSyncerServiceMockRecorder struct {
+	mock *MockSyncerService
+}
+
+// NewMockSyncerService creates a new mock instance.
+func NewMockSyncerService(ctrl *gomock.Controller) *MockSyncerService {
+	mock := &MockSyncerService{ctrl: ctrl}
+	mock.recorder = &MockSyncerServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSyncerService) EXPECT() *MockSyncerServiceMockRecorder {
+	return m.recorder
+}
+
+// RunWithStatus mocks base method.
+func (m *MockSyncerService) RunWithStatus() {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "RunWithStatus")
+}
+
+// RunWithStatus indicates an expected call of RunWithStatus.
+func (mr *MockSyncerServiceMockRecorder) RunWithStatus() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RunWithStatus", reflect.TypeOf((*MockSyncerService)(nil).RunWithStatus))
+}
+
+// ServerHealthErr mocks base method.
+func (m *MockSyncerService) ServerHealthErr() error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ServerHealthErr")
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ServerHealthErr indicates an expected call of ServerHealthErr.
+func (mr *MockSyncerServiceMockRecorder) ServerHealthErr() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ServerHealthErr", reflect.TypeOf((*MockSyncerService)(nil).ServerHealthErr))
+}
@@ -0,0 +1,147 @@
+package data
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/casnerano/seckeep/internal/client/model"
+	"github.com/casnerano/seckeep/internal/client/service/data/print"
+	"github.com/spf13/cobra"
+)
+
+// errInvalidPeriod некорректный срок в днях.
+var errInvalidPeriod = errors.New("invalid period, expected days like 60d")
+
+// NewCardsCmd конструктор команды вывода банковских карт со сроком действия.
+// С флагом --expiring выводятся только карты, срок которых истекает в течение заданного периода или уже истек.
+func NewCardsCmd(dataService Service, syncer SyncerService) *cobra.Command {
+	var expiring string
+
+	cmd := cobra.Command{
+		Use:           "cards",
+		Short:         "Банковские карты и сроки действия",
+		SilenceErrors: true,
+		SilenceUsage:  true,
+		PersistentPreRun: func(cmd *cobra.Command, args []string) {
+			if cmd.Parent() != nil && cmd.Parent().Parent() != nil {
+				cmd.Parent().Parent().PersistentPreRun(cmd.Parent(), args)
+			}
+
+			syncBeforeOutput(cmd, syncer)
+		},
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			return textOutputOnly(cmd)
+		},
+		Run: func(cmd *cobra.Command, args []string) {
+			var period time.Duration
+			if expiring != "" {
+				var err error
+				if period, err = parseDays(expiring); err != nil {
+					cmd.Println("Некорректный период, ожидается кол-во дней, например 60d.")
+					return
+				}
+			}
+
+			now := time.Now()
+			cards := expiringCards(dataService.GetList(), now, period, expiring != "")
+
+			if len(cards) == 0 {
+				if expiring != "" {
+					cmd.Println("Карт с истекающим сроком действия нет.")
+				} else {
+					cmd.Println("Банковских карт нет.")
+				}
+				return
+			}
+
+			p := print.New(cmd.OutOrStdout())
+			for _, c := range cards {
+				fmt.Fprintf(cmd.OutOrStdout(), "[%s] ", expiryStatus(c.data, now))
+				p.Line(c.index, c.data)
+			}
+		},
+	}
+
+	cmd.Flags().StringVar(&expiring, "expiring", "", "Только карты, срок которых истекает в течение периода (например, 60d)")
+
+	return &cmd
+}
+
+// indexedCard банковская карта с индексом записи.
+type indexedCard struct {
+	index int
+	data  *model.DataCard
+}
+
+// expiringCards возвращает банковские карты, отсортированные по сроку действия.
+// Если onlyExpiring, возвращаются только карты, срок которых истекает до now+period.
+// Карты с нераспознанным сроком печатаются в конце списка и не попадают в выборку по сроку.
+func expiringCards(dList map[int]model.DataTypeable, now time.Time, period time.Duration, onlyExpiring bool) []indexedCard {
+	cards := make([]indexedCard, 0)
+	for index, dt := range dList {
+		data, ok := dt.(*model.DataCard)
+		if !ok {
+			continue
+		}
+
+		expiry, err := data.Expiry()
+		if onlyExpiring && (err != nil || expiry.End().After(now.Add(period))) {
+			continue
+		}
+
+		cards = append(cards, indexedCard{index: index, data: data})
+	}
+
+	sort.Slice(cards, func(i, j int) bool {
+		ei, erri := cards[i].data.Expiry()
+		ej, errj := cards[j].data.Expiry()
+		if (erri == nil) != (errj == nil) {
+			return erri == nil
+		}
+		if erri == nil && !ei.End().Equal(ej.End()) {
+			return ei.End().Before(ej.End())
+		}
+		return cards[i].index < cards[j].index
+	})
+
+	return cards
+}
+
+// expiryStatus возвращает состояние срока действия карты.
+func expiryStatus(data *model.DataCard, now time.Time) string {
+	expiry, err := data.Expiry()
+	if err != nil {
+		return "срок не распознан"
+	}
+
+	days := expiry.DaysLeft(now)
+	switch {
+	case expiry.Expired(now):
+		return fmt.Sprintf("истекла %d дн. назад", -days)
+	case days < 31:
+		return fmt.Sprintf("истекает через %d дн.", days)
+	}
+	return "до " + expiry.String()
+}
+
+// parseDays разбирает период в днях: «60d», «60» или длительность Go («1440h»).
+func parseDays(s string) (time.Duration, error) {
+	s = strings.TrimSpace(s)
+
+	if days, err := strconv.Atoi(strings.TrimSuffix(s, "d")); err == nil {
+		if days < 0 {
+			return 0, errInvalidPeriod
+		}
+		return time.Duration(days) * 24 * time.Hour, nil
+	}
+
+	period, err := time.ParseDuration(s)
+	if err != nil || period < 0 {
+		return 0, errInvalidPeriod
+	}
+	return period, nil
+}
@@ -0,0 +1,139 @@
+package data
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/casnerano/seckeep/internal/client/model"
+	"github.com/spf13/cobra"
+)
+
+// errFieldNotFound у записи нет поля с заданным именем.
+var errFieldNotFound = errors.New("field not found")
+
+// NewCopyCmd конструктор команды копирования значения поля записи в буфер обмена.
+// Через clearAfter (флаг --clear-after) буфер очищается, если в нем все еще скопированное значение.
+// До очистки команда ожидает завершения, Ctrl+C, SIGTERM и SIGHUP очищают буфер сразу.
+func NewCopyCmd(dataService Service, clip Clipboard, clearAfter time.Duration, syncer SyncerService) *cobra.Command {
+	var (
+		index int
+		field string
+	)
+
+	cmd := cobra.Command{
+		Use:   "copy",
+		Short: "Копирование значения в буфер обмена",
+		PersistentPreRun: func(cmd *cobra.Command, args []string) {
+			if cmd.Parent() != nil && cmd.Parent().Parent() != nil {
+				cmd.Parent().Parent().PersistentPreRun(cmd.Parent(), args)
+			}
+
+			if syncer.ServerHealthErr() == nil {
+				syncer.RunWithStatus()
+			}
+		},
+		Run: func(cmd *cobra.Command, args []string) {
+			d, err := dataService.Read(index)
+			if err != nil {
+				cmd.Println(err.Error())
+				return
+			}
+
+			value, err := fieldValue(d, field)
+			if err != nil {
+				cmd.Printf("Поле «%s» не найдено.\n", field)
+				return
+			}
+
+			// Буфер очищается и при завершении процесса или закрытии терминала.
+			// Сигналы перехватываются до записи, чтобы значение не осталось в буфере при сигнале сразу после нее.
+			interrupt := make(chan os.Signal, 1)
+			if clearAfter > 0 {
+				signal.Notify(interrupt, os.Interrupt, syscall.SIGTERM, syscall.SIGHUP)
+				defer signal.Stop(interrupt)
+			}
+
+			if err = clip.Write(value); err != nil {
+				cmd.Println("Не удалось скопировать значение в буфер обмена:", err)
+				return
+			}
+
+			if clearAfter <= 0 {
+				cmd.Println("Значение скопировано в буфер обмена.")
+				return
+			}
+
+			cmd.Printf("Значение скопировано в буфер обмена и будет удалено через %s (Ctrl+C — удалить сейчас).\n", clearAfter)
+
+			select {
+			case <-time.After(clearAfter):
+			case <-interrupt:
+			}
+
+			if err = clip.Clear(value); err != nil {
+				cmd.Println("Не удалось очистить буфер обмена:", err)
+				return
+			}
+
+			cmd.Println("Буфер обмена очищен.")
+		},
+	}
+
+	cmd.Flags().IntVarP(&index, "index", "i", 0, "Индекс (номер) записи")
+	cmd.Flags().StringVarP(
+		&field,
+		"field",
+		"f",
+		"",
+		"Имя основного или пользовательского поля (по умолчанию — первое секретное поле, например, password)",
+	)
+	cmd.Flags().DurationVar(&clearAfter, "clear-after", clearAfter, "Очистить буфер обмена через заданное время (0 — не очищать)")
+	cmd.Flags().SetNormalizeFunc(indexAlias)
+	_ = cmd.MarkFlagRequired("index")
+
+	return &cmd
+}
+
+// fieldValue возвращает значение поля записи по имени: основного (в том числе по имени флага, например, month-year)
+// или пользовательского, для поля-ссылки — значение основного поля, на которое оно ссылается.
+// Без имени возвращает первое секретное основное поле, а если таких нет — первое основное поле.
+func fieldValue(d model.DataTypeable, name string) (string, error) {
+	spec, ok := model.LookupType(d.Type())
+	if !ok {
+		return "", model.ErrUnknownDataType
+	}
+
+	if name == "" {
+		for _, field := range spec.Fields {
+			if field.Secret {
+				return field.Get(d), nil
+			}
+		}
+		if len(spec.Fields) > 0 {
+			return spec.Fields[0].Get(d), nil
+		}
+	}
+
+	if field, ok := lookupField(spec, name); ok {
+		return field.Get(d), nil
+	}
+
+	for _, custom := range d.Info().Fields {
+		if custom.Name != name {
+			continue
+		}
+
+		if custom.Type == model.FieldTypeLinked {
+			if field, ok := spec.Field(custom.Value); ok {
+				return field.Get(d), nil
+			}
+		}
+		return custom.Value, nil
+	}
+
+	return "", fmt.Errorf("%w: %q", errFieldNotFound, name)
+}
@@ -1,14 +1,21 @@
 package create
 
 import (
+	"github.com/casnerano/seckeep/internal/client/command/prompt"
 	"github.com/casnerano/seckeep/internal/client/model"
+	"github.com/casnerano/seckeep/pkg/card"
 	"github.com/casnerano/seckeep/pkg/svalid"
 	"github.com/spf13/cobra"
 )
 
 // NewCardCmd конструктор команды создания записи банковской карты.
+// Номер сохраняется без пробелов и дефисов, срок действия задается в формате MM/YY.
+// Если CVV не задан или равен «-», он вводится без отображения.
 func NewCardCmd(dataService DataService) *cobra.Command {
-	var number, monthYear, cvv, owner string
+	var (
+		number, monthYear, cvv, owner string
+		files                         []string
+	)
 
 	cmd := cobra.Command{
 		Use:   "card",
@@ -16,12 +23,31 @@ func NewCardCmd(dataService DataService) *cobra.Command {
 		Run: func(cmd *cobra.Command, args []string) {
 			meta, _ := cmd.Flags().GetStringSlice("meta")
 
+			dInfo, err := info(cmd)
+			if err != nil {
+				cmd.Println(err)
+				return
+			}
+
+			if cvv, err = prompt.NewSecrets(cmd).Value("cvv", "CVV", true); err != nil {
+				cmd.Println(prompt.Message(err))
+				return
+			}
+
+			dAttachments, err := attachments(files)
+			if err != nil {
+				cmd.Println("Не удалось прочитать вложение.")
+				return
+			}
+
 			d := model.DataCard{
-				Number:    number,
-				MonthYear: monthYear,
-				CVV:       cvv,
-				Owner:     owner,
-				Meta:      meta,
+				DataInfo:    dInfo,
+				Number:      card.Normalize(number),
+				MonthYear:   monthYear,
+				CVV:         cvv,
+				Owner:       owner,
+				Attachments: dAttachments,
+				Meta:        meta,
 			}
 
 			validator := svalid.New()
@@ -30,7 +56,7 @@ func NewCardCmd(dataService DataService) *cobra.Command {
 				return
 			}
 
-			err := dataService.Create(d)
+			err = save(cmd, dataService, d)
 
 			if err != nil {
 				cmd.Println(err.Error())
@@ -42,13 +68,13 @@ func NewCardCmd(dataService DataService) *cobra.Command {
 	}
 
 	cmd.Flags().StringVarP(&number, "number", "n", "", "Номер карты")
-	cmd.Flags().StringVarP(&monthYear, "month-year", "m", "", "Месяц/Год")
+	cmd.Flags().StringVarP(&monthYear, "month-year", "m", "", "Срок действия в формате MM/YY")
 	cmd.Flags().StringVarP(&owner, "owner", "o", "", "Держатель")
-	cmd.Flags().StringVarP(&cvv, "cvv", "c", "", "CVV")
+	cmd.Flags().StringVarP(&cvv, "cvv", "c", "", "CVV («-» — ввести без отображения)")
+	cmd.Flags().StringArrayVar(&files, "attach", []string{}, "Путь к прикрепляемому файлу")
 
 	_ = cmd.MarkFlagRequired("number")
 	_ = cmd.MarkFlagRequired("month-year")
-	_ = cmd.MarkFlagRequired("cvv")
 
 	return &cmd
 }
@@ -3,13 +3,18 @@ package create
 //go:generate mockgen -destination=mock/create.go -source=create.go
 
 import (
+	"os"
+	"path/filepath"
+
 	"github.com/casnerano/seckeep/internal/client/model"
+	smodel "github.com/casnerano/seckeep/internal/pkg/model"
 	"github.com/spf13/cobra"
 )
 
 // DataService интерфейс взаимодействия с данными.
 type DataService interface {
 	Create(dt model.DataTypeable) error
+	CreateInVault(vault string, dt model.DataTypeable) error
 }
 
 // SyncerService интерфейс синхронизации сервера и клиента.
@@ -35,11 +40,78 @@ func NewCmd(dataService DataService, syncer SyncerService) *cobra.Command {
 	}
 
 	cmd.PersistentFlags().StringSlice("meta", []string{}, "Мета данные")
+	cmd.PersistentFlags().String("vault", "", "Общее хранилище (имя или UUID)")
+	cmd.PersistentFlags().String("title", "", "Заголовок")
+	cmd.PersistentFlags().StringSlice("tag", []string{}, "Теги")
+	cmd.PersistentFlags().String("folder", "", "Папка (например, work/servers)")
+	cmd.PersistentFlags().Bool("favorite", false, "Добавить в избранное")
+	cmd.PersistentFlags().StringArray(
+		"field",
+		[]string{},
+		"Пользовательское поле в формате [тип:]имя=значение, типы: text (по умолчанию), hidden, boolean, linked",
+	)
 
-	cmd.AddCommand(NewCredentialCmd(dataService))
-	cmd.AddCommand(NewTextCmd(dataService))
-	cmd.AddCommand(NewCardCmd(dataService))
-	cmd.AddCommand(NewDocumentCmd(dataService))
+	// Для типов с особыми флагами (адреса, вложения, файл документа) используются собственные команды,
+	// для остальных типов команда строится по описанию типа из реестра.
+	commands := map[smodel.DataType]func(DataService) *cobra.Command{
+		smodel.DataTypeCredential: NewCredentialCmd,
+		smodel.DataTypeText:       NewTextCmd,
+		smodel.DataTypeCard:       NewCardCmd,
+		smodel.DataTypeDocument:   NewDocumentCmd,
+	}
+
+	for _, spec := range model.Types() {
+		if newCmd, ok := commands[spec.Type]; ok {
+			cmd.AddCommand(newCmd(dataService))
+			continue
+		}
+		cmd.AddCommand(NewTypeCmd(dataService, spec))
+	}
 
 	return &cmd
 }
+
+// save сохраняет запись в личное или, при указании флага --vault, в общее хранилище.
+func save(cmd *cobra.Command, dataService DataService, dt model.DataTypeable) error {
+	if err := model.CheckFields(dt); err != nil {
+		return err
+	}
+
+	if vault, _ := cmd.Flags().GetString("vault"); vault != "" {
+		return dataService.CreateInVault(vault, dt)
+	}
+	return dataService.Create(dt)
+}
+
+// info возвращает сведения о записи из флагов --title, --tag, --folder, --favorite и --field.
+func info(cmd *cobra.Command) (model.DataInfo, error) {
+	title, _ := cmd.Flags().GetString("title")
+	tags, _ := cmd.Flags().GetStringSlice("tag")
+	folder, _ := cmd.Flags().GetString("folder")
+	favorite, _ := cmd.Flags().GetBool("favorite")
+	fields, _ := cmd.Flags().GetStringArray("field")
+
+	dInfo := model.NewDataInfo(title, tags, folder, favorite)
+	for _, value := range fields {
+		field, err := model.ParseDataField(value)
+		if err != nil {
+			return model.DataInfo{}, err
+		}
+		dInfo.Fields = append(dInfo.Fields, field)
+	}
+
+	return dInfo, nil
+}
+
+// attachments читает файлы, указанные флагом --attach.
+func attachments(files []string) ([]model.DataAttachment, error) {
+	result := make([]model.DataAttachment, 0, len(files))
+	for _, file := range files {
+		content, err := os.ReadFile(file)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, model.DataAttachment{Name: filepath.Base(file), Content: content})
+	}
+	return result, nil
+}
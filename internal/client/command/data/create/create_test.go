@@ -5,9 +5,12 @@ import (
 	"errors"
 	"io"
 	"os"
+	"path/filepath"
+	"strings"
 	"testing"
 
 	mock_create "github.com/casnerano/seckeep/internal/client/command/data/create/mock"
+	"github.com/casnerano/seckeep/internal/client/model"
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/suite"
 )
@@ -35,6 +38,178 @@ func (s *DataCreateCmdTestSuite) TestCreateCmd() {
 	s.True(cmd.HasSubCommands())
 }
 
+func (s *DataCreateCmdTestSuite) TestCreateInVault() {
+	cmd := NewCmd(s.dataService, s.syncerService)
+	cmdBuf := bytes.NewBufferString("")
+	cmd.SetOut(cmdBuf)
+
+	s.dataService.EXPECT().CreateInVault("family", gomock.Any()).Return(nil)
+	s.syncerService.EXPECT().ServerHealthErr().Return(errUnknown)
+
+	cmd.SetArgs([]string{"text", "-v", "Example text", "--vault", "family"})
+	s.Require().NoError(cmd.Execute())
+
+	out, err := io.ReadAll(cmdBuf)
+	s.Require().NoError(err)
+
+	s.Contains(string(out), "Текстовые данные успешно добавлены")
+}
+
+func (s *DataCreateCmdTestSuite) TestCreateWithInfo() {
+	cmd := NewCmd(s.dataService, s.syncerService)
+	cmd.SetOut(bytes.NewBufferString(""))
+
+	s.dataService.EXPECT().Create(gomock.Any()).DoAndReturn(func(dt model.DataTypeable) error {
+		s.Equal(model.DataInfo{
+			Title:    "Notes",
+			Tags:     []string{"work", "ssh"},
+			Folder:   "work/servers",
+			Favorite: true,
+		}, dt.Info())
+		return nil
+	})
+	s.syncerService.EXPECT().ServerHealthErr().Return(errUnknown)
+
+	cmd.SetArgs([]string{
+		"text", "-v", "Example text",
+		"--title", "Notes", "--tag", "work,ssh", "--folder", "/work/servers/", "--favorite",
+	})
+	s.Require().NoError(cmd.Execute())
+}
+
+func (s *DataCreateCmdTestSuite) TestCredentialURIs() {
+	cmd := NewCredentialCmd(s.dataService)
+	cmd.SetOut(bytes.NewBufferString(""))
+
+	s.dataService.EXPECT().Create(gomock.Any()).DoAndReturn(func(dt model.DataTypeable) error {
+		credential, ok := dt.(model.DataCredential)
+		s.Require().True(ok)
+		s.Equal([]model.DataURI{
+			{URI: "https://example.com", Match: model.URIMatchDomain},
+			{URI: `^https://(a|b)\.example\.com/{1,2}`, Match: model.URIMatchRegex},
+		}, credential.URIs)
+		return nil
+	})
+
+	cmd.SetArgs([]string{
+		"-l", "ivan", "-p", "ivanov",
+		"--uri", "https://example.com",
+		"--uri", `regex=^https://(a|b)\.example\.com/{1,2}`,
+	})
+	s.Require().NoError(cmd.Execute())
+}
+
+func (s *DataCreateCmdTestSuite) TestCustomFieldsAndAttachments() {
+	file := filepath.Join(s.T().TempDir(), "codes.txt")
+	s.Require().NoError(os.WriteFile(file, []byte("1111 2222"), 0600))
+
+	s.Run("Success create", func() {
+		cmd := NewCmd(s.dataService, s.syncerService)
+		cmd.SetOut(bytes.NewBufferString(""))
+
+		s.dataService.EXPECT().Create(gomock.Any()).DoAndReturn(func(dt model.DataTypeable) error {
+			s.Equal([]model.DataField{
+				{Name: "PIN", Type: model.FieldTypeHidden, Value: "1234"},
+				{Name: "username", Type: model.FieldTypeLinked, Value: "login"},
+			}, dt.Info().Fields)
+			s.Equal([]model.DataAttachment{{Name: "codes.txt", Content: []byte("1111 2222")}}, model.Attachments(dt))
+			return nil
+		})
+		s.syncerService.EXPECT().ServerHealthErr().Return(errUnknown)
+
+		cmd.SetArgs([]string{
+			"credential", "-l", "ivan", "-p", "ivanov",
+			"--field", "hidden:PIN=1234", "--field", "linked:username=login", "--attach", file,
+		})
+		s.Require().NoError(cmd.Execute())
+	})
+
+	s.Run("Unknown linked field", func() {
+		cmd := NewCmd(s.dataService, s.syncerService)
+		cmdBuf := bytes.NewBufferString("")
+		cmd.SetOut(cmdBuf)
+
+		s.syncerService.EXPECT().ServerHealthErr().Return(errUnknown)
+
+		cmd.SetArgs([]string{"text", "-v", "Example text", "--field", "linked:secret=password"})
+		s.Require().NoError(cmd.Execute())
+
+		out, err := io.ReadAll(cmdBuf)
+		s.Require().NoError(err)
+
+		s.Contains(string(out), model.ErrInvalidField.Error())
+	})
+}
+
+func (s *DataCreateCmdTestSuite) TestRegisteredType() {
+	file := filepath.Join(s.T().TempDir(), "id_ed25519")
+	s.Require().NoError(os.WriteFile(file, []byte("private key"), 0600))
+
+	s.Run("Success create", func() {
+		cmd := NewCmd(s.dataService, s.syncerService)
+		cmdBuf := bytes.NewBufferString("")
+		cmd.SetOut(cmdBuf)
+
+		s.dataService.EXPECT().Create(gomock.Any()).DoAndReturn(func(dt model.DataTypeable) error {
+			s.Equal(&model.DataSSHKey{
+				Host:       "example.com",
+				User:       "root",
+				PrivateKey: "private key",
+				Meta:       []string{"prod"},
+			}, dt)
+			return nil
+		})
+		s.syncerService.EXPECT().ServerHealthErr().Return(errUnknown)
+
+		cmd.SetArgs([]string{
+			"ssh-key", "--host", "example.com", "--user", "root", "--private-key-file", file, "--meta", "prod",
+		})
+		s.Require().NoError(cmd.Execute())
+
+		out, err := io.ReadAll(cmdBuf)
+		s.Require().NoError(err)
+
+		s.Contains(string(out), "успешно добавлена")
+	})
+
+	s.Run("Secret from stdin", func() {
+		cmd := NewCmd(s.dataService, s.syncerService)
+		cmdBuf := bytes.NewBufferString("")
+		cmd.SetOut(cmdBuf)
+		cmd.SetIn(strings.NewReader("-----BEGIN KEY-----\nkey\n-----END KEY-----\n"))
+
+		s.dataService.EXPECT().Create(gomock.Any()).DoAndReturn(func(dt model.DataTypeable) error {
+			s.Equal(&model.DataSSHKey{Host: "example.com", PrivateKey: "-----BEGIN KEY-----\nkey\n-----END KEY-----", Meta: []string{}}, dt)
+			return nil
+		})
+		s.syncerService.EXPECT().ServerHealthErr().Return(errUnknown)
+
+		cmd.SetArgs([]string{"ssh-key", "--host", "example.com", "--private-key", "-"})
+		s.Require().NoError(cmd.Execute())
+
+		out, err := io.ReadAll(cmdBuf)
+		s.Require().NoError(err)
+
+		s.Contains(string(out), "успешно добавлена")
+	})
+
+	s.Run("Validation error", func() {
+		cmd := NewCmd(s.dataService, s.syncerService)
+		cmdBuf := bytes.NewBufferString("")
+		cmd.SetOut(cmdBuf)
+
+		s.syncerService.EXPECT().ServerHealthErr().Return(errUnknown)
+
+		cmd.SetArgs([]string{"wifi", "--ssid", "home", "--security", "WPA9"})
+		s.Require().NoError(cmd.Execute())
+
+		out, err := io.ReadAll(cmdBuf)
+		s.Require().NoError(err)
+
+		s.NotContains(string(out), "успешно добавлена")
+	})
+}
+
 func (s *DataCreateCmdTestSuite) TestCredential() {
 	login := "ivan"
 	password := "ivanov"
@@ -81,6 +256,96 @@ func (s *DataCreateCmdTestSuite) TestCredential() {
 	})
 }
 
+func (s *DataCreateCmdTestSuite) TestCredentialGenerate() {
+	s.Run("Password", func() {
+		cmd := NewCredentialCmd(s.dataService)
+		cmdBuf := bytes.NewBufferString("")
+		cmd.SetOut(cmdBuf)
+
+		s.dataService.EXPECT().Create(gomock.Any()).DoAndReturn(func(dt model.DataTypeable) error {
+			credential, ok := dt.(model.DataCredential)
+			s.Require().True(ok)
+			s.Len(credential.Password, 12)
+			s.NotContains(credential.Password, "!")
+			return nil
+		})
+
+		cmd.SetArgs([]string{"-l", "ivan", "--generate", "--length", "12", "--no-symbols"})
+		s.Require().NoError(cmd.Execute())
+
+		out, err := io.ReadAll(cmdBuf)
+		s.Require().NoError(err)
+
+		s.Contains(string(out), "Пароль сгенерирован")
+	})
+
+	s.Run("Passphrase", func() {
+		cmd := NewCredentialCmd(s.dataService)
+		cmd.SetOut(bytes.NewBufferString(""))
+
+		s.dataService.EXPECT().Create(gomock.Any()).DoAndReturn(func(dt model.DataTypeable) error {
+			credential, ok := dt.(model.DataCredential)
+			s.Require().True(ok)
+			s.Len(strings.Split(credential.Password, "_"), 4)
+			return nil
+		})
+
+		cmd.SetArgs([]string{"-l", "ivan", "--generate=passphrase", "--words", "4", "--separator", "_"})
+		s.Require().NoError(cmd.Execute())
+	})
+
+	s.Run("Password from stdin", func() {
+		cmd := NewCredentialCmd(s.dataService)
+		cmdBuf := bytes.NewBufferString("")
+		cmd.SetOut(cmdBuf)
+		cmd.SetIn(strings.NewReader("pass with spaces\n"))
+
+		s.dataService.EXPECT().Create(gomock.Any()).DoAndReturn(func(dt model.DataTypeable) error {
+			credential, ok := dt.(model.DataCredential)
+			s.Require().True(ok)
+			s.Equal("pass with spaces", credential.Password)
+			return nil
+		})
+
+		cmd.SetArgs([]string{"-l", "ivan"})
+		s.Require().NoError(cmd.Execute())
+
+		out, err := io.ReadAll(cmdBuf)
+		s.Require().NoError(err)
+
+		s.Contains(string(out), "Учетная запись успешно добавлена")
+	})
+
+	s.Run("Without password", func() {
+		cmd := NewCredentialCmd(s.dataService)
+		cmdBuf := bytes.NewBufferString("")
+		cmd.SetOut(cmdBuf)
+		cmd.SetIn(strings.NewReader(""))
+
+		cmd.SetArgs([]string{"-l", "ivan"})
+		s.Require().NoError(cmd.Execute())
+
+		out, err := io.ReadAll(cmdBuf)
+		s.Require().NoError(err)
+
+		s.Contains(string(out), "Укажите пароль (--password) или сгенерируйте его (--generate)")
+	})
+
+	s.Run("Invalid options", func() {
+		cmd := NewCredentialCmd(s.dataService)
+		cmdBuf := bytes.NewBufferString("")
+		cmd.SetOut(cmdBuf)
+
+		cmd.SetArgs([]string{"-l", "ivan", "--generate", "--no-lower", "--no-upper", "--no-digits", "--no-symbols"})
+		s.Require().NoError(cmd.Execute())
+
+		out, err := io.ReadAll(cmdBuf)
+		s.Require().NoError(err)
+
+		s.Contains(string(out), "Не выбран ни один класс символов")
+	})
+}
+
 func (s *DataCreateCmdTestSuite) TestText() {
 	value := "Example text"
 
@@ -150,6 +415,60 @@ func (s *DataCreateCmdTestSuite) TestCard() {
 
 		s.Contains(string(out), errUnknown.Error())
 	})
+
+	s.Run("Formatted number", func() {
+		s.dataService.EXPECT().Create(gomock.Any()).DoAndReturn(func(dt model.DataTypeable) error {
+			card, ok := dt.(model.DataCard)
+			s.Require().True(ok)
+			s.Equal("2200000000000004", card.Number)
+			return nil
+		})
+
+		cmd.SetArgs([]string{"-n", "2200 0000 0000 0004", "-m", "06/28", "-c", cvv})
+		s.Require().NoError(cmd.Execute())
+	})
+
+	s.Run("Invalid number (validate)", func() {
+		cmd.SetArgs([]string{"-n", "4969677832915893", "-m", monthYear, "-c", cvv})
+		s.Require().NoError(cmd.Execute())
+
+		out, err := io.ReadAll(cmdBuf)
+		s.Require().NoError(err)
+
+		s.Contains(string(out), "Error:Field validation for 'Number' failed on the 'card_number' tag")
+	})
+
+	s.Run("Invalid expiry (validate)", func() {
+		cmd.SetArgs([]string{"-n", number, "-m", "13/28", "-c", cvv})
+		s.Require().NoError(cmd.Execute())
+
+		out, err := io.ReadAll(cmdBuf)
+		s.Require().NoError(err)
+
+		s.Contains(string(out), "Error:Field validation for 'MonthYear' failed on the 'card_expiry' tag")
+	})
+
+	s.Run("CVV from stdin", func() {
+		cmd := NewCardCmd(s.dataService)
+		cmdBuf := bytes.NewBufferString("")
+		cmd.SetOut(cmdBuf)
+		cmd.SetIn(strings.NewReader("987\n"))
+
+		s.dataService.EXPECT().Create(gomock.Any()).DoAndReturn(func(dt model.DataTypeable) error {
+			card, ok := dt.(model.DataCard)
+			s.Require().True(ok)
+			s.Equal("987", card.CVV)
+			return nil
+		})
+
+		cmd.SetArgs([]string{"-n", number, "-m", monthYear, "-c", "-"})
+		s.Require().NoError(cmd.Execute())
+
+		out, err := io.ReadAll(cmdBuf)
+		s.Require().NoError(err)
+
+		s.Contains(string(out), "Данные кредитной карты успешно добавлены")
+	})
 }
 
 func (s *DataCreateCmdTestSuite) TestDocument() {
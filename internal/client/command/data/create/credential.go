@@ -1,14 +1,23 @@
 package create
 
 import (
+	"github.com/casnerano/seckeep/internal/client/command/generate"
+	"github.com/casnerano/seckeep/internal/client/command/prompt"
 	"github.com/casnerano/seckeep/internal/client/model"
 	"github.com/casnerano/seckeep/pkg/svalid"
 	"github.com/spf13/cobra"
 )
 
 // NewCredentialCmd конструктор команды создания записи учетной записи.
+// Вместо --password пароль можно сгенерировать флагом --generate.
+// Если пароль не задан или равен «-», он вводится без отображения.
 func NewCredentialCmd(dataService DataService) *cobra.Command {
-	var login, password string
+	var (
+		login, password, kind string
+		uris, files           []string
+	)
+
+	genOpts := generate.NewOptions()
 
 	cmd := cobra.Command{
 		Use:   "credential",
@@ -16,10 +25,38 @@ func NewCredentialCmd(dataService DataService) *cobra.Command {
 		Run: func(cmd *cobra.Command, args []string) {
 			meta, _ := cmd.Flags().GetStringSlice("meta")
 
+			dInfo, err := info(cmd)
+			if err != nil {
+				cmd.Println(err)
+				return
+			}
+
+			if kind != "" {
+				if password, err = genOpts.Generate(kind); err != nil {
+					cmd.Println(generate.Message(err))
+					return
+				}
+			} else if password, err = prompt.NewSecrets(cmd).Value("password", "Пароль", true); err != nil {
+				cmd.Println("Укажите пароль (--password) или сгенерируйте его (--generate).")
+				return
+			}
+
+			dAttachments, err := attachments(files)
+			if err != nil {
+				cmd.Println("Не удалось прочитать вложение.")
+				return
+			}
+
 			d := model.DataCredential{
-				Login:    login,
-				Password: password,
-				Meta:     meta,
+				DataInfo:    dInfo,
+				Login:       login,
+				Password:    password,
+				Attachments: dAttachments,
+				Meta:        meta,
+			}
+
+			for _, uri := range uris {
+				d.URIs = append(d.URIs, model.ParseDataURI(uri))
 			}
 
 			validator := svalid.New()
@@ -28,7 +65,7 @@ func NewCredentialCmd(dataService DataService) *cobra.Command {
 				return
 			}
 
-			err := dataService.Create(d)
+			err = save(cmd, dataService, d)
 
 			if err != nil {
 				cmd.Println(err)
@@ -36,14 +73,28 @@ func NewCredentialCmd(dataService DataService) *cobra.Command {
 			}
 
 			cmd.Println("Учетная запись успешно добавлена.")
+			if kind != "" {
+				cmd.Println("Пароль сгенерирован, для просмотра используйте «data read».")
+			}
 		},
 	}
 
 	cmd.Flags().StringVarP(&login, "login", "l", "", "Логин")
-	cmd.Flags().StringVarP(&password, "password", "p", "", "Пароль")
+	cmd.Flags().StringVarP(&password, "password", "p", "", "Пароль («-» — ввести без отображения)")
+	cmd.Flags().StringVar(&kind, "generate", "", "Сгенерировать пароль: password (по умолчанию) или passphrase")
+	cmd.Flags().Lookup("generate").NoOptDefVal = generate.KindPassword
+	genOpts.RegisterPasswordFlags(cmd.Flags())
+	genOpts.RegisterPassphraseFlags(cmd.Flags())
+	cmd.Flags().StringArrayVar(&files, "attach", []string{}, "Путь к прикрепляемому файлу")
+	cmd.Flags().StringArrayVar(
+		&uris,
+		"uri",
+		[]string{},
+		"Адрес сайта в формате [режим=]адрес, режимы: domain (по умолчанию), host, exact, regex",
+	)
 
 	_ = cmd.MarkFlagRequired("login")
-	_ = cmd.MarkFlagRequired("password")
+	cmd.MarkFlagsMutuallyExclusive("password", "generate")
 
 	return &cmd
 }
@@ -19,6 +19,12 @@ func NewDocumentCmd(dataService DataService) *cobra.Command {
 		Run: func(cmd *cobra.Command, args []string) {
 			meta, _ := cmd.Flags().GetStringSlice("meta")
 
+			dInfo, err := info(cmd)
+			if err != nil {
+				cmd.Println(err)
+				return
+			}
+
 			bContent, err := os.ReadFile(file)
 			if err != nil {
 				cmd.Println("Не удалось прочитать файл.")
@@ -30,9 +36,10 @@ func NewDocumentCmd(dataService DataService) *cobra.Command {
 			}
 
 			d := model.DataDocument{
-				Name:    name,
-				Content: bContent,
-				Meta:    meta,
+				DataInfo: dInfo,
+				Name:     name,
+				Content:  bContent,
+				Meta:     meta,
 			}
 
 			validator := svalid.New()
@@ -41,7 +48,7 @@ func NewDocumentCmd(dataService DataService) *cobra.Command {
 				return
 			}
 
-			err = dataService.Create(&d)
+			err = save(cmd, dataService, &d)
 
 			if err != nil {
 				cmd.Println(err)
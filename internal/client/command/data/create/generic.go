@@ -0,0 +1,96 @@
+package create
+
+import (
+	"os"
+
+	"github.com/casnerano/seckeep/internal/client/command/prompt"
+	"github.com/casnerano/seckeep/internal/client/model"
+	"github.com/casnerano/seckeep/pkg/svalid"
+	"github.com/spf13/cobra"
+)
+
+// NewTypeCmd конструктор команды создания записи по описанию типа из реестра.
+// Для каждого основного поля добавляется флаг, для полей с опцией file — еще и флаг --<поле>-file.
+// Незаданные обязательные и равные «-» секретные поля вводятся без отображения или читаются из стандартного ввода.
+func NewTypeCmd(dataService DataService, spec model.TypeSpec) *cobra.Command {
+	values := make(map[string]*string, len(spec.Fields))
+	files := make(map[string]*string, len(spec.Fields))
+
+	cmd := cobra.Command{
+		Use:   spec.Command,
+		Short: spec.Title,
+		Run: func(cmd *cobra.Command, args []string) {
+			meta, _ := cmd.Flags().GetStringSlice("meta")
+
+			dInfo, err := info(cmd)
+			if err != nil {
+				cmd.Println(err)
+				return
+			}
+
+			secrets := prompt.NewSecrets(cmd)
+			fieldValues := make(map[string]string, len(spec.Fields))
+			for _, field := range spec.Fields {
+				fieldValues[field.Name] = *values[field.Name]
+
+				if file, ok := files[field.Name]; ok && *file != "" {
+					content, err := os.ReadFile(*file)
+					if err != nil {
+						cmd.Println("Не удалось прочитать файл.")
+						return
+					}
+					fieldValues[field.Name] = string(content)
+					continue
+				}
+
+				if field.Secret {
+					read := secrets.Value
+					if field.Multiline {
+						read = secrets.MultilineValue
+					}
+					if fieldValues[field.Name], err = read(field.Flag(), field.Title, field.Required); err != nil {
+						cmd.Println(prompt.Message(err))
+						return
+					}
+				}
+			}
+
+			d, err := spec.Build(dInfo, meta, fieldValues)
+			if err != nil {
+				cmd.Println(err)
+				return
+			}
+
+			validator := svalid.New()
+			if err = validator.Validate(d); err != nil {
+				cmd.Println(err.Error())
+				return
+			}
+
+			if err = save(cmd, dataService, d); err != nil {
+				cmd.Println(err)
+				return
+			}
+
+			cmd.Printf("Запись «%s» успешно добавлена.\n", spec.Title)
+		},
+	}
+
+	for _, field := range spec.Fields {
+		usage := field.Title
+		if field.Secret {
+			usage += " («-» — ввести без отображения)"
+		}
+		values[field.Name] = cmd.Flags().String(field.Flag(), "", usage)
+
+		if field.File {
+			files[field.Name] = cmd.Flags().String(field.Flag()+"-file", "", field.Title+" (путь к файлу)")
+		}
+
+		if field.Required && !field.File && !field.Secret {
+			_ = cmd.MarkFlagRequired(field.Flag())
+		}
+	}
+
+	return &cmd
+}
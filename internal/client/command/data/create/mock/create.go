@@ -48,6 +48,20 @@ func (mr *MockDataServiceMockRecorder) Create(dt interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockDataService)(nil).Create), dt)
 }
 
+// CreateInVault mocks base method.
+func (m *MockDataService) CreateInVault(vault string, dt model.DataTypeable) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateInVault", vault, dt)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateInVault indicates an expected call of CreateInVault.
+func (mr *MockDataServiceMockRecorder) CreateInVault(vault, dt interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateInVault", reflect.TypeOf((*MockDataService)(nil).CreateInVault), vault, dt)
+}
+
 // MockSyncerService is a mock of SyncerService interface.
 type MockSyncerService struct {
 	ctrl     *gomock.Controller
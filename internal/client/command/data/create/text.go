@@ -16,9 +16,16 @@ func NewTextCmd(dataService DataService) *cobra.Command {
 		Run: func(cmd *cobra.Command, args []string) {
 			meta, _ := cmd.Flags().GetStringSlice("meta")
 
+			dInfo, err := info(cmd)
+			if err != nil {
+				cmd.Println(err)
+				return
+			}
+
 			d := model.DataText{
-				Value: value,
-				Meta:  meta,
+				DataInfo: dInfo,
+				Value:    value,
+				Meta:     meta,
 			}
 
 			validator := svalid.New()
@@ -27,7 +34,7 @@ func NewTextCmd(dataService DataService) *cobra.Command {
 				return
 			}
 
-			err := dataService.Create(d)
+			err = save(cmd, dataService, d)
 
 			if err != nil {
 				cmd.Println(err)
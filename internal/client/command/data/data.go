@@ -3,29 +3,89 @@ package data
 //go:generate mockgen -destination=mock/data.go -source=data.go
 
 import (
+	"fmt"
+	"time"
+
 	"github.com/casnerano/seckeep/internal/client/command/data/create"
+	"github.com/casnerano/seckeep/internal/client/command/exitcode"
 	"github.com/casnerano/seckeep/internal/client/model"
+	"github.com/casnerano/seckeep/internal/client/service/data/print"
+	"github.com/casnerano/seckeep/internal/client/service/history"
+	"github.com/casnerano/seckeep/internal/client/service/search"
+	"github.com/casnerano/seckeep/internal/client/service/trash"
+	"github.com/casnerano/seckeep/internal/client/service/urimatch"
 	"github.com/spf13/cobra"
 )
 
+// ExitCodeError код завершения, если команду вывода данных выполнить не удалось.
+const ExitCodeError = 1
+
 // Service интерфейс взаимодействия с данными.
 type Service interface {
 	Create(dt model.DataTypeable) error
+	CreateInVault(vault string, dt model.DataTypeable) error
 	Read(index int) (model.DataTypeable, error)
 	GetList() map[int]model.DataTypeable
+	GetVaultList(vault string) (map[int]model.DataTypeable, error)
 	Update(index int, dt model.DataTypeable) error
 	Delete(index int) error
 }
 
+// ShareService интерфейс создания ссылок на секреты.
+type ShareService interface {
+	Create(dt model.DataTypeable, ttl time.Duration, views int) (string, error)
+}
+
+// SearchService интерфейс поиска по записям.
+type SearchService interface {
+	Find(query string) []search.Result
+	FindIndexed(query string) ([]search.Result, error)
+}
+
+// URIMatcher интерфейс поиска учетных записей по адресу сайта.
+type URIMatcher interface {
+	Find(target string) ([]urimatch.Result, error)
+}
+
+// HistoryService интерфейс получения ревизий записей.
+type HistoryService interface {
+	List(index int) ([]history.Revision, error)
+	Revision(index, revision int) (*history.Revision, error)
+}
+
+// TrashService интерфейс работы с корзиной удаленных записей.
+type TrashService interface {
+	List(remote bool) ([]trash.Item, error)
+	Restore(item trash.Item) error
+	Empty() error
+}
+
+// Clipboard интерфейс буфера обмена.
+type Clipboard interface {
+	Write(text string) error
+	Clear(text string) error
+}
+
 // SyncerService интерфейс синхронизации сервера и клиента.
 type SyncerService interface {
 	ServerHealthErr() error
+	Run() error
 	RunWithStatus()
 }
 
 // NewCmd конструктор базовой команды работы с данными.
 // Содердит инициализацию дочерних команд.
-func NewCmd(dataService Service, syncer SyncerService) *cobra.Command {
+func NewCmd(
+	dataService Service,
+	shareService ShareService,
+	searchService SearchService,
+	uriMatcher URIMatcher,
+	historyService HistoryService,
+	trashService TrashService,
+	clip Clipboard,
+	clipClearAfter time.Duration,
+	syncer SyncerService,
+) *cobra.Command {
 	cmd := cobra.Command{
 		Use:   "data",
 		Short: "Взаимодействие с данными",
@@ -36,6 +96,60 @@ func NewCmd(dataService Service, syncer SyncerService) *cobra.Command {
 	cmd.AddCommand(NewListCmd(dataService, syncer))
 	cmd.AddCommand(NewUpdateCmd(dataService, syncer))
 	cmd.AddCommand(NewDeleteCmd(dataService, syncer))
+	cmd.AddCommand(NewShareCmd(dataService, shareService, syncer))
+	cmd.AddCommand(NewSearchCmd(searchService, syncer))
+	cmd.AddCommand(NewFindURLCmd(uriMatcher, syncer))
+	cmd.AddCommand(NewHistoryCmd(dataService, historyService, syncer))
+	cmd.AddCommand(NewRestoreCmd(dataService, historyService, syncer))
+	cmd.AddCommand(NewTrashCmd(trashService, syncer))
+	cmd.AddCommand(NewCardsCmd(dataService, syncer))
+	cmd.AddCommand(NewCopyCmd(dataService, clip, clipClearAfter, syncer))
 
 	return &cmd
 }
+
+// outputFormat возвращает формат вывода из глобального флага --output, без флага — print.FormatText.
+func outputFormat(cmd *cobra.Command) (print.Format, error) {
+	value, err := cmd.Flags().GetString("output")
+	if err != nil {
+		return print.FormatText, nil
+	}
+	return print.ParseFormat(value)
+}
+
+// syncBeforeOutput синхронизирует данные с сервером перед выводом.
+// Для машиночитаемых форматов синхронизация выполняется без сообщений, чтобы не нарушать разбор вывода.
+func syncBeforeOutput(cmd *cobra.Command, syncer SyncerService) {
+	if syncer.ServerHealthErr() != nil {
+		return
+	}
+
+	if format, err := outputFormat(cmd); err == nil && format.Machine() {
+		_ = syncer.Run()
+		return
+	}
+
+	syncer.RunWithStatus()
+}
+
+// fail печатает сообщение об ошибке в поток ошибок, чтобы не смешивать его с выводом данных,
+// и возвращает ошибку exitcode.Error с кодом ExitCodeError.
+func fail(cmd *cobra.Command, message string) error {
+	fmt.Fprintln(cmd.ErrOrStderr(), message)
+	return exitcode.New(ExitCodeError)
+}
+
+// textOutputOnly проверяет, что для команды, печатающей только текст, не задан другой формат вывода.
+// Иначе печатает ошибку в поток ошибок и возвращает exitcode.Error.
+func textOutputOnly(cmd *cobra.Command) error {
+	format, err := outputFormat(cmd)
+	if err != nil {
+		return fail(cmd, err.Error())
+	}
+
+	if format != print.FormatText {
+		return fail(cmd, fmt.Sprintf("Команда «%s» поддерживает только текстовый вывод (--output text).", cmd.CommandPath()))
+	}
+
+	return nil
+}
@@ -2,14 +2,29 @@ package data
 
 import (
 	"bytes"
+	"encoding/json"
 	"errors"
+	"fmt"
 	"io"
+	"os"
+	"path/filepath"
 	"strconv"
+	"strings"
+	"syscall"
 	"testing"
+	"time"
 
 	mock_data "github.com/casnerano/seckeep/internal/client/command/data/mock"
+	"github.com/casnerano/seckeep/internal/client/command/exitcode"
 	"github.com/casnerano/seckeep/internal/client/model"
+	"github.com/casnerano/seckeep/internal/client/service/data/print"
+	"github.com/casnerano/seckeep/internal/client/service/history"
+	"github.com/casnerano/seckeep/internal/client/service/search"
+	"github.com/casnerano/seckeep/internal/client/service/trash"
+	"github.com/casnerano/seckeep/internal/client/service/urimatch"
+	"github.com/casnerano/seckeep/pkg/clipboard"
 	"github.com/golang/mock/gomock"
+	"github.com/spf13/cobra"
 	"github.com/stretchr/testify/suite"
 )
 
@@ -19,8 +34,13 @@ var (
 
 type DataCmdTestSuite struct {
 	suite.Suite
-	dataService   *mock_data.MockService
-	syncerService *mock_data.MockSyncerService
+	dataService    *mock_data.MockService
+	shareService   *mock_data.MockShareService
+	searchService  *mock_data.MockSearchService
+	uriMatcher     *mock_data.MockURIMatcher
+	historyService *mock_data.MockHistoryService
+	trashService   *mock_data.MockTrashService
+	syncerService  *mock_data.MockSyncerService
 }
 
 func (s *DataCmdTestSuite) SetupSuite() {
@@ -28,11 +48,26 @@ func (s *DataCmdTestSuite) SetupSuite() {
 	defer ctrl.Finish()
 
 	s.dataService = mock_data.NewMockService(ctrl)
+	s.shareService = mock_data.NewMockShareService(ctrl)
+	s.searchService = mock_data.NewMockSearchService(ctrl)
+	s.uriMatcher = mock_data.NewMockURIMatcher(ctrl)
+	s.historyService = mock_data.NewMockHistoryService(ctrl)
+	s.trashService = mock_data.NewMockTrashService(ctrl)
 	s.syncerService = mock_data.NewMockSyncerService(ctrl)
 }
 
 func (s *DataCmdTestSuite) TestDataCmd() {
-	cmd := NewCmd(s.dataService, s.syncerService)
+	cmd := NewCmd(
+		s.dataService,
+		s.shareService,
+		s.searchService,
+		s.uriMatcher,
+		s.historyService,
+		s.trashService,
+		clipboard.NewFake(nil),
+		time.Second,
+		s.syncerService,
+	)
 	s.True(cmd.HasSubCommands())
 }
 
@@ -56,7 +91,7 @@ func (s *DataCmdTestSuite) TestDelete() {
 		out, err := io.ReadAll(cmdBuf)
 		s.Require().NoError(err)
 
-		s.Contains(string(out), "Запись успешно удалена")
+		s.Contains(string(out), "Запись перемещена в корзину")
 	})
 
 	s.Run("Invalid delete", func() {
@@ -103,14 +138,67 @@ func (s *DataCmdTestSuite) TestRead() {
 	s.Run("Invalid delete", func() {
 		s.dataService.EXPECT().Read(index).Return(nil, errUnknown)
 
-		err := cmd.Execute()
+		errBuf := bytes.NewBufferString("")
+		cmd.SetErr(errBuf)
+
+		s.requireExitCode(cmd.Execute())
+
+		s.Contains(errBuf.String(), errUnknown.Error())
+	})
+}
+
+func (s *DataCmdTestSuite) TestShare() {
+	s.syncerService.EXPECT().ServerHealthErr().Return(nil).AnyTimes()
+	s.syncerService.EXPECT().RunWithStatus().AnyTimes()
+	index := 3
+
+	cmd := NewShareCmd(s.dataService, s.shareService, s.syncerService)
+	cmdBuf := bytes.NewBufferString("")
+	cmd.SetOut(cmdBuf)
+
+	cmd.SetArgs([]string{"-i", strconv.Itoa(index), "--ttl", "1h", "--views", "2"})
+
+	s.Run("Success share", func() {
+		dt := model.DataText{Value: "Example text"}
+		link := "https://vault.example.com/share/0b8e5c1a#AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA"
+
+		s.dataService.EXPECT().Read(index).Return(&dt, nil)
+		s.shareService.EXPECT().Create(&dt, time.Hour, 2).Return(link, nil)
+
+		s.Require().NoError(cmd.Execute())
+
+		out, err := io.ReadAll(cmdBuf)
 		s.Require().NoError(err)
 
+		s.Contains(string(out), link)
+	})
+
+	s.Run("Unknown record", func() {
+		s.dataService.EXPECT().Read(index).Return(nil, errUnknown)
+
+		s.Require().NoError(cmd.Execute())
+
 		out, err := io.ReadAll(cmdBuf)
 		s.Require().NoError(err)
 
 		s.Contains(string(out), errUnknown.Error())
 	})
+
+	s.Run("Index by id alias", func() {
+		dt := model.DataText{Value: "Example text"}
+		link := "https://vault.example.com/share/0b8e5c1a#AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA"
+
+		s.dataService.EXPECT().Read(index).Return(&dt, nil)
+		s.shareService.EXPECT().Create(&dt, time.Hour, 2).Return(link, nil)
+
+		cmd := NewShareCmd(s.dataService, s.shareService, s.syncerService)
+		cmdBuf := bytes.NewBufferString("")
+		cmd.SetOut(cmdBuf)
+		cmd.SetArgs([]string{"--id", strconv.Itoa(index), "--ttl", "1h", "--views", "2"})
+
+		s.Require().NoError(cmd.Execute())
+		s.Contains(cmdBuf.String(), link)
+	})
 }
 
 func (s *DataCmdTestSuite) TestList() {
@@ -150,6 +238,935 @@ func (s *DataCmdTestSuite) TestList() {
 
 		s.Contains(string(out), "Список записей пуст")
 	})
+
+	s.Run("Filtered tree", func() {
+		dt := map[int]model.DataTypeable{
+			0: &model.DataText{
+				DataInfo: model.DataInfo{Title: "Keys", Folder: "work/servers", Tags: []string{"ssh"}},
+				Value:    "Example #1 Text",
+			},
+			1: &model.DataText{
+				DataInfo: model.DataInfo{Title: "Recipes", Folder: "home"},
+				Value:    "Example #2 Text",
+			},
+		}
+		s.dataService.EXPECT().GetList().Return(dt)
+
+		filteredCmd := NewListCmd(s.dataService, s.syncerService)
+		filteredBuf := bytes.NewBufferString("")
+		filteredCmd.SetOut(filteredBuf)
+		filteredCmd.SetArgs([]string{"--folder", "work", "--tag", "SSH", "--tree"})
+
+		err := filteredCmd.Execute()
+		s.Require().NoError(err)
+
+		out, err := io.ReadAll(filteredBuf)
+		s.Require().NoError(err)
+
+		s.Contains(string(out), "work/\n  servers/\n    #0 Keys [")
+		s.NotContains(string(out), "Recipes")
+	})
+}
+
+func (s *DataCmdTestSuite) TestSearch() {
+	s.syncerService.EXPECT().ServerHealthErr().Return(errUnknown).AnyTimes()
+
+	s.Run("Ranked results", func() {
+		cmd := NewSearchCmd(s.searchService, s.syncerService)
+		cmdBuf := bytes.NewBufferString("")
+		cmd.SetOut(cmdBuf)
+
+		s.searchService.EXPECT().Find("work mail").Return([]search.Result{
+			{Index: 3, Data: &model.DataCredential{DataInfo: model.DataInfo{Title: "Work mail"}, Login: "ivan"}, Score: 400},
+			{Index: 1, Data: &model.DataText{Value: "mail server at work"}, Score: 120},
+		})
+
+		cmd.SetArgs([]string{"work", "mail", "--limit", "1"})
+		s.Require().NoError(cmd.Execute())
+
+		out, err := io.ReadAll(cmdBuf)
+		s.Require().NoError(err)
+
+		s.Contains(string(out), "#3 Work mail [ Логин: ivan")
+		s.NotContains(string(out), "#1")
+	})
+
+	s.Run("Indexed search error", func() {
+		cmd := NewSearchCmd(s.searchService, s.syncerService)
+		cmdBuf := bytes.NewBufferString("")
+		cmd.SetOut(cmdBuf)
+
+		s.searchService.EXPECT().FindIndexed("mail").Return(nil, errUnknown)
+
+		cmd.SetArgs([]string{"mail", "--index"})
+		s.Require().NoError(cmd.Execute())
+
+		out, err := io.ReadAll(cmdBuf)
+		s.Require().NoError(err)
+
+		s.Contains(string(out), errUnknown.Error())
+	})
+
+	s.Run("Nothing found", func() {
+		cmd := NewSearchCmd(s.searchService, s.syncerService)
+		cmdBuf := bytes.NewBufferString("")
+		cmd.SetOut(cmdBuf)
+
+		s.searchService.EXPECT().Find("nothing").Return(nil)
+
+		cmd.SetArgs([]string{"nothing"})
+		s.Require().NoError(cmd.Execute())
+
+		out, err := io.ReadAll(cmdBuf)
+		s.Require().NoError(err)
+
+		s.Contains(string(out), "Ничего не найдено")
+	})
+}
+
+func (s *DataCmdTestSuite) TestFindURL() {
+	s.syncerService.EXPECT().ServerHealthErr().Return(errUnknown).AnyTimes()
+
+	s.Run("Ranked credentials", func() {
+		cmd := NewFindURLCmd(s.uriMatcher, s.syncerService)
+		cmdBuf := bytes.NewBufferString("")
+		cmd.SetOut(cmdBuf)
+
+		s.uriMatcher.EXPECT().Find("https://example.com/login").Return([]urimatch.Result{
+			{Index: 2, Credential: &model.DataCredential{Login: "ivan"}, Quality: urimatch.QualityHost},
+			{Index: 0, Credential: &model.DataCredential{Login: "petr"}, Quality: urimatch.QualityDomain},
+		}, nil)
+
+		cmd.SetArgs([]string{"https://example.com/login"})
+		s.Require().NoError(cmd.Execute())
+
+		out, err := io.ReadAll(cmdBuf)
+		s.Require().NoError(err)
+
+		s.Contains(string(out), "[хост] #2 [ Логин: ivan | Пароль: ***** | Мета: — ]\n[домен] #0 [ Логин: petr")
+	})
+
+	s.Run("Invalid URL", func() {
+		cmd := NewFindURLCmd(s.uriMatcher, s.syncerService)
+		cmdBuf := bytes.NewBufferString("")
+		cmd.SetOut(cmdBuf)
+
+		s.uriMatcher.EXPECT().Find("https://").Return(nil, urimatch.ErrInvalidURL)
+
+		cmd.SetArgs([]string{"https://"})
+		s.Require().NoError(cmd.Execute())
+
+		out, err := io.ReadAll(cmdBuf)
+		s.Require().NoError(err)
+
+		s.Contains(string(out), urimatch.ErrInvalidURL.Error())
+	})
+}
+
+func (s *DataCmdTestSuite) TestCards() {
+	s.syncerService.EXPECT().ServerHealthErr().Return(errUnknown).AnyTimes()
+
+	now := time.Now()
+	monthYear := func(t time.Time) string {
+		return fmt.Sprintf("%02d/%02d", int(t.Month()), t.Year()%100)
+	}
+
+	s.dataService.EXPECT().GetList().Return(map[int]model.DataTypeable{
+		0: &model.DataCard{Number: "4111111111111111", MonthYear: monthYear(now.AddDate(3, 0, 0)), CVV: "123"},
+		1: &model.DataCard{Number: "2200000000000004", MonthYear: monthYear(now), CVV: "123"},
+		2: &model.DataCard{Number: "5555555555554444", MonthYear: monthYear(now.AddDate(0, -2, 0)), CVV: "123"},
+		3: &model.DataText{Value: "Example"},
+	}).Times(2)
+
+	s.Run("Expiring", func() {
+		cmd := NewCardsCmd(s.dataService, s.syncerService)
+		cmdBuf := bytes.NewBufferString("")
+		cmd.SetOut(cmdBuf)
+
+		cmd.SetArgs([]string{"--expiring", "60d"})
+		s.Require().NoError(cmd.Execute())
+
+		out, err := io.ReadAll(cmdBuf)
+		s.Require().NoError(err)
+
+		lines := strings.Split(strings.TrimSpace(string(out)), "\n")
+		s.Require().Len(lines, 2)
+		s.Regexp(`^\[истекла \d+ дн\. назад\] #2 \[ Номер: \*\*\*\* 4444 `, lines[0])
+		s.Regexp(`^\[истекает через \d+ дн\.\] #1 \[ Номер: \*\*\*\* 0004 `, lines[1])
+	})
+
+	s.Run("All", func() {
+		cmd := NewCardsCmd(s.dataService, s.syncerService)
+		cmdBuf := bytes.NewBufferString("")
+		cmd.SetOut(cmdBuf)
+
+		cmd.SetArgs([]string{})
+		s.Require().NoError(cmd.Execute())
+
+		out, err := io.ReadAll(cmdBuf)
+		s.Require().NoError(err)
+
+		lines := strings.Split(strings.TrimSpace(string(out)), "\n")
+		s.Require().Len(lines, 3)
+		s.Contains(lines[2], "[до "+monthYear(now.AddDate(3, 0, 0))+"] #0")
+	})
+
+	s.Run("Invalid period", func() {
+		cmd := NewCardsCmd(s.dataService, s.syncerService)
+		cmdBuf := bytes.NewBufferString("")
+		cmd.SetOut(cmdBuf)
+
+		cmd.SetArgs([]string{"--expiring", "soon"})
+		s.Require().NoError(cmd.Execute())
+
+		out, err := io.ReadAll(cmdBuf)
+		s.Require().NoError(err)
+
+		s.Contains(string(out), "Некорректный период")
+	})
+}
+
+func (s *DataCmdTestSuite) TestUpdateExtras() {
+	s.syncerService.EXPECT().ServerHealthErr().Return(errUnknown).AnyTimes()
+
+	file := filepath.Join(s.T().TempDir(), "scan.pdf")
+	s.Require().NoError(os.WriteFile(file, []byte("scan"), 0600))
+
+	s.Run("Fields and attachments", func() {
+		cmd := NewUpdateCmd(s.dataService, s.syncerService)
+		cmdBuf := bytes.NewBufferString("")
+		cmd.SetOut(cmdBuf)
+
+		card := &model.DataCard{
+			DataInfo:    model.DataInfo{Fields: []model.DataField{{Name: "bank", Type: model.FieldTypeText, Value: "Bank"}}},
+			Number:      "4969677832915892",
+			MonthYear:   "01.02",
+			CVV:         "123",
+			Attachments: []model.DataAttachment{{Name: "old.pdf"}},
+		}
+		s.dataService.EXPECT().Read(7).Return(card, nil)
+		s.dataService.EXPECT().Update(7, gomock.Any()).DoAndReturn(func(_ int, dt model.DataTypeable) error {
+			s.Equal([]model.DataField{{Name: "PIN", Type: model.FieldTypeHidden, Value: "0000"}}, dt.Info().Fields)
+			s.Equal([]model.DataAttachment{{Name: "scan.pdf", Content: []byte("scan")}}, model.Attachments(dt))
+			return nil
+		})
+
+		cmd.SetArgs([]string{
+			"-i", "7",
+			"--remove-field", "bank", "--field", "hidden:PIN=0000",
+			"--detach", "old.pdf", "--attach", file,
+		})
+		s.Require().NoError(cmd.Execute())
+
+		out, err := io.ReadAll(cmdBuf)
+		s.Require().NoError(err)
+
+		s.Contains(string(out), "Данные успешно обновлены")
+	})
+
+	s.Run("Text is not attachable", func() {
+		cmd := NewUpdateCmd(s.dataService, s.syncerService)
+		cmdBuf := bytes.NewBufferString("")
+		cmd.SetOut(cmdBuf)
+
+		errBuf := bytes.NewBufferString("")
+		cmd.SetErr(errBuf)
+
+		s.dataService.EXPECT().Read(8).Return(&model.DataText{Value: "Example"}, nil)
+
+		cmd.SetArgs([]string{"-i", "8", "--attach", file})
+		s.requireExitCode(cmd.Execute())
+
+		s.Contains(errBuf.String(), model.ErrNotAttachable.Error())
+		s.Empty(cmdBuf.String())
+	})
+}
+
+func (s *DataCmdTestSuite) TestUpdateGenerate() {
+	s.syncerService.EXPECT().ServerHealthErr().Return(errUnknown).AnyTimes()
+
+	s.Run("Credential", func() {
+		cmd := NewUpdateCmd(s.dataService, s.syncerService)
+		cmdBuf := bytes.NewBufferString("")
+		cmd.SetOut(cmdBuf)
+
+		s.dataService.EXPECT().Read(9).Return(&model.DataCredential{Login: "ivan", Password: "old"}, nil)
+		s.dataService.EXPECT().Update(9, gomock.Any()).DoAndReturn(func(_ int, dt model.DataTypeable) error {
+			credential, ok := dt.(*model.DataCredential)
+			s.Require().True(ok)
+			s.Equal("ivan", credential.Login)
+			s.Len(credential.Password, 32)
+			return nil
+		})
+
+		cmd.SetArgs([]string{"-i", "9", "--generate", "--length", "32"})
+		s.Require().NoError(cmd.Execute())
+
+		out, err := io.ReadAll(cmdBuf)
+		s.Require().NoError(err)
+
+		s.Contains(string(out), "Данные успешно обновлены")
+	})
+
+	s.Run("Not credential", func() {
+		cmd := NewUpdateCmd(s.dataService, s.syncerService)
+		cmdBuf := bytes.NewBufferString("")
+		cmd.SetOut(cmdBuf)
+
+		errBuf := bytes.NewBufferString("")
+		cmd.SetErr(errBuf)
+
+		s.dataService.EXPECT().Read(10).Return(&model.DataText{Value: "Example"}, nil)
+
+		cmd.SetArgs([]string{"-i", "10", "--generate=passphrase"})
+		s.requireExitCode(cmd.Execute())
+
+		s.Contains(errBuf.String(), "Генерация пароля доступна только для учетных записей")
+	})
+}
+
+func (s *DataCmdTestSuite) TestUpdateFlags() {
+	s.syncerService.EXPECT().ServerHealthErr().Return(errUnknown).AnyTimes()
+
+	s.Run("Main fields, info and meta", func() {
+		cmd := NewUpdateCmd(s.dataService, s.syncerService)
+		cmdBuf := bytes.NewBufferString("")
+		cmd.SetOut(cmdBuf)
+
+		credential := &model.DataCredential{
+			DataInfo: model.DataInfo{Tags: []string{"work"}},
+			Login:    "ivan",
+			Password: "old",
+			Meta:     []string{"a", "b"},
+		}
+		s.dataService.EXPECT().Read(11).Return(credential, nil)
+		s.dataService.EXPECT().Update(11, gomock.Any()).DoAndReturn(func(_ int, dt model.DataTypeable) error {
+			s.Equal(&model.DataCredential{
+				DataInfo: model.DataInfo{
+					Title:    "Почта",
+					Tags:     []string{"work", "mail"},
+					Folder:   "personal/mail",
+					Favorite: true,
+					Fields:   []model.DataField{{Name: "login", Type: model.FieldTypeText, Value: "alias"}},
+				},
+				Login:    "ivan petrov",
+				Password: "new pass word",
+				Meta:     []string{"b", "c"},
+			}, dt)
+			return nil
+		})
+
+		cmd.SetArgs([]string{
+			"--id", "11",
+			"--field", "login=ivan petrov",
+			"--field", "password=new pass word",
+			"--field", "title=Почта",
+			"--field", "folder=/personal/mail/",
+			"--field", "favorite=true",
+			"--field", "tag+=mail",
+			"--field", "meta-=a",
+			"--field", "meta+=c",
+			"--field", "text:login=alias",
+		})
+		s.Require().NoError(cmd.Execute())
+
+		out, err := io.ReadAll(cmdBuf)
+		s.Require().NoError(err)
+
+		s.Contains(string(out), "Данные успешно обновлены")
+	})
+
+	s.Run("Meta values with commas", func() {
+		cmd := NewUpdateCmd(s.dataService, s.syncerService)
+		cmdBuf := bytes.NewBufferString("")
+		cmd.SetOut(cmdBuf)
+
+		text := &model.DataText{
+			DataInfo: model.DataInfo{Tags: []string{"old"}},
+			Value:    "Example",
+			Meta:     []string{"Moscow, Tverskaya 1", "note"},
+		}
+		s.dataService.EXPECT().Read(18).Return(text, nil)
+		s.dataService.EXPECT().Update(18, gomock.Any()).DoAndReturn(func(_ int, dt model.DataTypeable) error {
+			s.Equal([]string{"home", "work"}, dt.Info().Tags)
+			s.Equal([]string{"note", "Saint Petersburg, Nevsky 2"}, dt.(*model.DataText).Meta)
+			return nil
+		})
+
+		cmd.SetArgs([]string{
+			"-i", "18",
+			"--field", "tag=home, work",
+			"--field", "meta-=Moscow, Tverskaya 1",
+			"--field", "meta+=Saint Petersburg, Nevsky 2",
+		})
+		s.Require().NoError(cmd.Execute())
+
+		s.Contains(cmdBuf.String(), "Данные успешно обновлены")
+	})
+
+	s.Run("Meta replaced with a single value", func() {
+		cmd := NewUpdateCmd(s.dataService, s.syncerService)
+		cmdBuf := bytes.NewBufferString("")
+		cmd.SetOut(cmdBuf)
+
+		s.dataService.EXPECT().Read(19).Return(&model.DataText{Value: "Example", Meta: []string{"a", "b"}}, nil)
+		s.dataService.EXPECT().Update(19, &model.DataText{Value: "Example", Meta: []string{"a, b and c"}}).Return(nil)
+
+		cmd.SetArgs([]string{"-i", "19", "--field", "meta=a, b and c"})
+		s.Require().NoError(cmd.Execute())
+
+		s.Contains(cmdBuf.String(), "Данные успешно обновлены")
+	})
+
+	s.Run("Secret from stdin", func() {
+		cmd := NewUpdateCmd(s.dataService, s.syncerService)
+		cmdBuf := bytes.NewBufferString("")
+		cmd.SetOut(cmdBuf)
+		cmd.SetIn(strings.NewReader("9876\n"))
+
+		card := &model.DataCard{Number: "4969677832915892", MonthYear: "01/30", CVV: "123"}
+		s.dataService.EXPECT().Read(12).Return(card, nil)
+		s.dataService.EXPECT().Update(12, gomock.Any()).DoAndReturn(func(_ int, dt model.DataTypeable) error {
+			updated, ok := dt.(*model.DataCard)
+			s.Require().True(ok)
+			s.Equal("9876", updated.CVV)
+			s.Equal("02/31", updated.MonthYear)
+			return nil
+		})
+
+		cmd.SetArgs([]string{"-i", "12", "--field", "month-year=02/31", "--stdin", "cvv"})
+		s.Require().NoError(cmd.Execute())
+
+		out, err := io.ReadAll(cmdBuf)
+		s.Require().NoError(err)
+
+		s.Contains(string(out), "Данные успешно обновлены")
+	})
+
+	s.Run("Secret prompt", func() {
+		cmd := NewUpdateCmd(s.dataService, s.syncerService)
+		cmdBuf := bytes.NewBufferString("")
+		cmd.SetOut(cmdBuf)
+		errBuf := bytes.NewBufferString("")
+		cmd.SetErr(errBuf)
+		cmd.SetIn(strings.NewReader("new pass\n4321\n"))
+
+		s.dataService.EXPECT().Read(17).Return(&model.DataCredential{Login: "ivan", Password: "old"}, nil)
+		s.dataService.EXPECT().Update(17, gomock.Any()).DoAndReturn(func(_ int, dt model.DataTypeable) error {
+			credential, ok := dt.(*model.DataCredential)
+			s.Require().True(ok)
+			s.Equal("new pass", credential.Password)
+			s.Equal([]model.DataField{
+				{Name: "PIN", Type: model.FieldTypeHidden, Value: "4321"},
+				{Name: "answer", Type: model.FieldTypeHidden, Value: "blue"},
+			}, credential.Fields)
+			return nil
+		})
+
+		cmd.SetArgs([]string{
+			"-i", "17", "--field", "password=-", "--field", "hidden:PIN=-", "--field", "hidden:answer=blue",
+		})
+		s.Require().NoError(cmd.Execute())
+
+		out, err := io.ReadAll(cmdBuf)
+		s.Require().NoError(err)
+
+		s.Contains(string(out), "Пароль > PIN > ")
+		s.Contains(string(out), "Данные успешно обновлены")
+		s.Contains(errBuf.String(), "значение --field hidden:answer передано в командной строке")
+		s.NotContains(errBuf.String(), "--field password")
+	})
+
+	s.Run("Document content", func() {
+		cmd := NewUpdateCmd(s.dataService, s.syncerService)
+		cmdBuf := bytes.NewBufferString("")
+		cmd.SetOut(cmdBuf)
+
+		file := filepath.Join(s.T().TempDir(), "passport.pdf")
+		s.Require().NoError(os.WriteFile(file, []byte("new content"), 0600))
+
+		s.dataService.EXPECT().Read(13).Return(&model.DataDocument{Name: "Паспорт", Content: []byte("old")}, nil)
+		s.dataService.EXPECT().Update(13, &model.DataDocument{Name: "Паспорт РФ", Content: []byte("new content")}).Return(nil)
+
+		cmd.SetArgs([]string{"-i", "13", "--file", file, "--field", "name=Паспорт РФ"})
+		s.Require().NoError(cmd.Execute())
+
+		out, err := io.ReadAll(cmdBuf)
+		s.Require().NoError(err)
+
+		s.Contains(string(out), "Данные успешно обновлены")
+	})
+
+	s.Run("Invalid edits", func() {
+		for _, args := range [][]string{
+			{"--file", "missing.pdf"},
+			{"--field", "login+=x"},
+			{"--field", "favorite=maybe"},
+		} {
+			cmd := NewUpdateCmd(s.dataService, s.syncerService)
+			cmdBuf := bytes.NewBufferString("")
+			cmd.SetOut(cmdBuf)
+			errBuf := bytes.NewBufferString("")
+			cmd.SetErr(errBuf)
+
+			s.dataService.EXPECT().Read(14).Return(&model.DataCredential{Login: "ivan", Password: "old"}, nil)
+
+			cmd.SetArgs(append([]string{"-i", "14"}, args...))
+			s.requireExitCode(cmd.Execute())
+
+			s.NotEmpty(errBuf.String(), args)
+			s.NotContains(cmdBuf.String(), "Данные успешно обновлены", args)
+		}
+	})
+
+	s.Run("Failed update", func() {
+		cmd := NewUpdateCmd(s.dataService, s.syncerService)
+		cmdBuf := bytes.NewBufferString("")
+		cmd.SetOut(cmdBuf)
+
+		errBuf := bytes.NewBufferString("")
+		cmd.SetErr(errBuf)
+
+		s.dataService.EXPECT().Read(15).Return(&model.DataText{Value: "Example"}, nil)
+		s.dataService.EXPECT().Update(15, gomock.Any()).Return(errUnknown)
+
+		cmd.SetArgs([]string{"-i", "15", "--field", "value=Other"})
+		s.requireExitCode(cmd.Execute())
+
+		s.Contains(errBuf.String(), errUnknown.Error())
+		s.NotContains(cmdBuf.String(), "Данные успешно обновлены")
+	})
+}
+
+func (s *DataCmdTestSuite) TestUpdateInteractive() {
+	s.syncerService.EXPECT().ServerHealthErr().Return(errUnknown).AnyTimes()
+
+	cmd := NewUpdateCmd(s.dataService, s.syncerService)
+	cmdBuf := bytes.NewBufferString("")
+	cmd.SetOut(cmdBuf)
+	cmd.SetIn(strings.NewReader("\n02/31\n\nIvan Ivanov\n"))
+
+	card := &model.DataCard{Number: "4969677832915892", MonthYear: "01/30", CVV: "123", Owner: "Ivan"}
+	s.dataService.EXPECT().Read(16).Return(card, nil)
+	s.dataService.EXPECT().Update(16, gomock.Any()).DoAndReturn(func(_ int, dt model.DataTypeable) error {
+		s.Equal(&model.DataCard{Number: "4969677832915892", MonthYear: "02/31", CVV: "123", Owner: "Ivan Ivanov"}, dt)
+		return nil
+	})
+
+	cmd.SetArgs([]string{"-i", "16"})
+	s.Require().NoError(cmd.Execute())
+
+	out, err := io.ReadAll(cmdBuf)
+	s.Require().NoError(err)
+
+	s.Contains(string(out), "Номер (текущее значение: 4969677832915892) > ")
+	s.Contains(string(out), "CVV (Enter — оставить текущее значение) > ")
+	s.NotContains(string(out), "123")
+}
+
+func (s *DataCmdTestSuite) TestCopy() {
+	s.syncerService.EXPECT().ServerHealthErr().Return(errUnknown).AnyTimes()
+
+	credential := &model.DataCredential{
+		DataInfo: model.DataInfo{Fields: []model.DataField{
+			{Name: "PIN", Type: model.FieldTypeHidden, Value: "0000"},
+			{Name: "secret", Type: model.FieldTypeLinked, Value: "password"},
+		}},
+		Login:    "ivan",
+		Password: "pass with spaces",
+	}
+
+	tests := []struct {
+		name  string
+		args  []string
+		value string
+	}{
+		{"Default field", []string{}, "pass with spaces"},
+		{"Main field", []string{"--field", "login"}, "ivan"},
+		{"Custom field", []string{"-f", "PIN"}, "0000"},
+		{"Linked field", []string{"-f", "secret"}, "pass with spaces"},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			clip := clipboard.NewFake(nil)
+			cmd := NewCopyCmd(s.dataService, clip, time.Minute, s.syncerService)
+			cmdBuf := bytes.NewBufferString("")
+			cmd.SetOut(cmdBuf)
+
+			s.dataService.EXPECT().Read(1).Return(credential, nil)
+
+			cmd.SetArgs(append([]string{"--id", "1", "--clear-after", "0"}, tt.args...))
+			s.Require().NoError(cmd.Execute())
+
+			out, err := io.ReadAll(cmdBuf)
+			s.Require().NoError(err)
+
+			s.Equal(tt.value, clip.Text())
+			s.Contains(string(out), "Значение скопировано в буфер обмена.")
+			s.NotContains(string(out), tt.value)
+		})
+	}
+
+	s.Run("Clear after timeout", func() {
+		clip := clipboard.NewFake(nil)
+		cmd := NewCopyCmd(s.dataService, clip, 10*time.Millisecond, s.syncerService)
+		cmdBuf := bytes.NewBufferString("")
+		cmd.SetOut(cmdBuf)
+
+		s.dataService.EXPECT().Read(1).Return(credential, nil)
+
+		cmd.SetArgs([]string{"-i", "1"})
+		s.Require().NoError(cmd.Execute())
+
+		out, err := io.ReadAll(cmdBuf)
+		s.Require().NoError(err)
+
+		s.Empty(clip.Text())
+		s.Contains(string(out), "будет удалено через 10ms")
+		s.Contains(string(out), "Буфер обмена очищен.")
+	})
+
+	for _, sig := range []syscall.Signal{syscall.SIGTERM, syscall.SIGHUP} {
+		s.Run("Clear on "+sig.String(), func() {
+			clip := clipboard.NewFake(nil)
+			cmd := NewCopyCmd(s.dataService, clip, time.Hour, s.syncerService)
+			cmd.SetOut(io.Discard)
+
+			s.dataService.EXPECT().Read(1).Return(credential, nil)
+
+			done := make(chan error, 1)
+			cmd.SetArgs([]string{"-i", "1"})
+			go func() { done <- cmd.Execute() }()
+
+			s.Require().Eventually(func() bool {
+				return clip.Text() == "pass with spaces"
+			}, time.Second, time.Millisecond)
+			s.Require().NoError(syscall.Kill(os.Getpid(), sig))
+
+			select {
+			case err := <-done:
+				s.Require().NoError(err)
+			case <-time.After(time.Second):
+				s.FailNow("The clipboard is not cleared on " + sig.String())
+			}
+			s.Empty(clip.Text())
+		})
+	}
+
+	s.Run("Unknown field", func() {
+		clip := clipboard.NewFake(nil)
+		cmd := NewCopyCmd(s.dataService, clip, time.Minute, s.syncerService)
+		cmdBuf := bytes.NewBufferString("")
+		cmd.SetOut(cmdBuf)
+
+		s.dataService.EXPECT().Read(1).Return(credential, nil)
+
+		cmd.SetArgs([]string{"-i", "1", "-f", "cvv"})
+		s.Require().NoError(cmd.Execute())
+
+		out, err := io.ReadAll(cmdBuf)
+		s.Require().NoError(err)
+
+		s.Empty(clip.Text())
+		s.Contains(string(out), "Поле «cvv» не найдено.")
+	})
+
+	s.Run("Clipboard error", func() {
+		cmd := NewCopyCmd(s.dataService, clipboard.NewFake(errUnknown), time.Minute, s.syncerService)
+		cmdBuf := bytes.NewBufferString("")
+		cmd.SetOut(cmdBuf)
+
+		s.dataService.EXPECT().Read(1).Return(credential, nil)
+
+		cmd.SetArgs([]string{"-i", "1"})
+		s.Require().NoError(cmd.Execute())
+
+		out, err := io.ReadAll(cmdBuf)
+		s.Require().NoError(err)
+
+		s.Contains(string(out), "Не удалось скопировать значение в буфер обмена")
+	})
+}
+
+func (s *DataCmdTestSuite) TestOutputFormat() {
+	s.syncerService.EXPECT().ServerHealthErr().Return(nil).AnyTimes()
+
+	// execute встраивает команду в корневую с глобальным флагом --output
+	// и возвращает стандартный вывод, поток ошибок и ошибку выполнения.
+	execute := func(child *cobra.Command, args ...string) (*bytes.Buffer, *bytes.Buffer, error) {
+		root := &cobra.Command{Use: "seckeep"}
+		root.PersistentFlags().String("output", "text", "")
+		root.AddCommand(child)
+
+		cmdBuf := bytes.NewBufferString("")
+		errBuf := bytes.NewBufferString("")
+		root.SetOut(cmdBuf)
+		root.SetErr(errBuf)
+		root.SetArgs(append([]string{child.Name()}, args...))
+
+		return cmdBuf, errBuf, root.Execute()
+	}
+
+	// requireExitCode проверяет, что команда завершилась с кодом ExitCodeError.
+	credential := &model.DataCredential{Login: "ivan", Password: "secret"}
+
+	s.Run("JSON list with silent sync", func() {
+		s.syncerService.EXPECT().Run().Return(nil)
+		s.dataService.EXPECT().GetList().Return(map[int]model.DataTypeable{2: credential})
+
+		cmdBuf, _, err := execute(NewListCmd(s.dataService, s.syncerService), "--output", "json")
+		s.Require().NoError(err)
+
+		var records []print.Record
+		s.Require().NoError(json.Unmarshal(cmdBuf.Bytes(), &records))
+		s.Require().Len(records, 1)
+		s.Equal(2, records[0].Index)
+		s.Equal("*****", records[0].Values["password"])
+	})
+
+	s.Run("Revealed YAML detail", func() {
+		s.syncerService.EXPECT().Run().Return(nil)
+		s.dataService.EXPECT().Read(2).Return(credential, nil)
+
+		cmdBuf, _, err := execute(NewReadCmd(s.dataService, s.syncerService), "-i", "2", "--reveal", "--output=yaml")
+		s.Require().NoError(err)
+
+		s.Contains(cmdBuf.String(), "index: 2\n")
+		s.Contains(cmdBuf.String(), "password: secret\n")
+	})
+
+	s.Run("Unknown format", func() {
+		s.syncerService.EXPECT().RunWithStatus()
+
+		cmdBuf, errBuf, err := execute(NewListCmd(s.dataService, s.syncerService), "--output", "xml")
+		s.requireExitCode(err)
+
+		s.Empty(cmdBuf.String())
+		s.Contains(errBuf.String(), print.ErrUnknownFormat.Error())
+	})
+
+	s.Run("JSON read error", func() {
+		s.syncerService.EXPECT().Run().Return(nil)
+		s.dataService.EXPECT().Read(7).Return(nil, errUnknown)
+
+		cmdBuf, errBuf, err := execute(NewReadCmd(s.dataService, s.syncerService), "-i", "7", "--output", "json")
+		s.requireExitCode(err)
+
+		s.Empty(cmdBuf.String())
+		s.Contains(errBuf.String(), errUnknown.Error())
+	})
+
+	s.Run("YAML vault list error", func() {
+		s.syncerService.EXPECT().Run().Return(nil)
+		s.dataService.EXPECT().GetList().Return(map[int]model.DataTypeable{})
+		s.dataService.EXPECT().GetVaultList("family").Return(nil, errUnknown)
+
+		cmdBuf, errBuf, err := execute(NewListCmd(s.dataService, s.syncerService), "--vault", "family", "--output", "yaml")
+		s.requireExitCode(err)
+
+		s.Empty(cmdBuf.String())
+		s.Contains(errBuf.String(), errUnknown.Error())
+	})
+
+	s.Run("Text read error", func() {
+		s.syncerService.EXPECT().RunWithStatus()
+		s.dataService.EXPECT().Read(7).Return(nil, errUnknown)
+
+		cmdBuf, errBuf, err := execute(NewReadCmd(s.dataService, s.syncerService), "-i", "7")
+		s.requireExitCode(err)
+
+		s.NotContains(cmdBuf.String(), errUnknown.Error())
+		s.Contains(errBuf.String(), errUnknown.Error())
+	})
+
+	s.Run("Table list error", func() {
+		s.syncerService.EXPECT().RunWithStatus()
+		s.dataService.EXPECT().GetList().Return(map[int]model.DataTypeable{})
+		s.dataService.EXPECT().GetVaultList("family").Return(nil, errUnknown)
+
+		cmdBuf, errBuf, err := execute(NewListCmd(s.dataService, s.syncerService), "--vault", "family", "--output", "table")
+		s.requireExitCode(err)
+
+		s.NotContains(cmdBuf.String(), errUnknown.Error())
+		s.Contains(errBuf.String(), errUnknown.Error())
+	})
+
+	textOnly := []struct {
+		name string
+		cmd  *cobra.Command
+		args []string
+	}{
+		{"search", NewSearchCmd(s.searchService, s.syncerService), []string{"mail"}},
+		{"find-url", NewFindURLCmd(s.uriMatcher, s.syncerService), []string{"https://example.com"}},
+		{"cards", NewCardsCmd(s.dataService, s.syncerService), nil},
+		{"history", NewHistoryCmd(s.dataService, s.historyService, s.syncerService), []string{"-i", "1"}},
+	}
+
+	for _, tt := range textOnly {
+		s.Run("Rejected JSON for "+tt.name, func() {
+			s.syncerService.EXPECT().Run().Return(nil)
+
+			cmdBuf, errBuf, err := execute(tt.cmd, append(tt.args, "--output", "json")...)
+			s.requireExitCode(err)
+
+			s.Empty(cmdBuf.String())
+			s.Contains(errBuf.String(), "поддерживает только текстовый вывод")
+		})
+	}
+
+	s.Run("Rejected YAML for trash list", func() {
+		s.syncerService.EXPECT().Run().Return(nil)
+
+		cmdBuf, errBuf, err := execute(NewTrashCmd(s.trashService, s.syncerService), "list", "--output", "yaml")
+		s.requireExitCode(err)
+
+		s.Empty(cmdBuf.String())
+		s.Contains(errBuf.String(), "«seckeep trash list» поддерживает только текстовый вывод")
+	})
+}
+
+func (s *DataCmdTestSuite) TestReadAttachment() {
+	s.syncerService.EXPECT().ServerHealthErr().Return(errUnknown).AnyTimes()
+
+	cmd := NewReadCmd(s.dataService, s.syncerService)
+	cmd.SetOut(bytes.NewBufferString(""))
+
+	output := filepath.Join(s.T().TempDir(), "codes.txt")
+	s.dataService.EXPECT().Read(1).Return(&model.DataCredential{
+		Login:       "ivan",
+		Password:    "ivanov",
+		Attachments: []model.DataAttachment{{Name: "codes.txt", Content: []byte("1111")}},
+	}, nil)
+
+	cmd.SetArgs([]string{"-i", "1", "--attachment", "codes.txt", "--save-to", output})
+	s.Require().NoError(cmd.Execute())
+
+	content, err := os.ReadFile(output)
+	s.Require().NoError(err)
+	s.Equal([]byte("1111"), content)
+}
+
+func (s *DataCmdTestSuite) TestHistory() {
+	s.syncerService.EXPECT().ServerHealthErr().Return(errUnknown).AnyTimes()
+
+	s.Run("Revisions with changes", func() {
+		cmd := NewHistoryCmd(s.dataService, s.historyService, s.syncerService)
+		cmdBuf := bytes.NewBufferString("")
+		cmd.SetOut(cmdBuf)
+
+		s.dataService.EXPECT().Read(1).Return(&model.DataCredential{Login: "ivan", Password: "3"}, nil)
+		s.historyService.EXPECT().List(1).Return([]history.Revision{
+			{Revision: 2, Data: &model.DataCredential{Login: "ivan", Password: "2"}},
+			{Revision: 1, Data: &model.DataCredential{Login: "petr", Password: "2"}},
+		}, nil)
+
+		cmd.SetArgs([]string{"--id", "1", "--reveal"})
+		s.Require().NoError(cmd.Execute())
+
+		out, err := io.ReadAll(cmdBuf)
+		s.Require().NoError(err)
+
+		s.Contains(string(out), "Ревизия #2 от ")
+		s.Contains(string(out), "Пароль: 2 → 3\n")
+		s.Contains(string(out), "Логин: petr → ivan\n")
+	})
+
+	s.Run("Not synchronized", func() {
+		cmd := NewHistoryCmd(s.dataService, s.historyService, s.syncerService)
+		cmdBuf := bytes.NewBufferString("")
+		cmd.SetOut(cmdBuf)
+
+		s.dataService.EXPECT().Read(2).Return(&model.DataText{Value: "text"}, nil)
+		s.historyService.EXPECT().List(2).Return(nil, history.ErrNotSynced)
+
+		cmd.SetArgs([]string{"-i", "2"})
+		s.Require().NoError(cmd.Execute())
+
+		out, err := io.ReadAll(cmdBuf)
+		s.Require().NoError(err)
+
+		s.Contains(string(out), history.ErrNotSynced.Error())
+	})
+}
+
+func (s *DataCmdTestSuite) TestRestore() {
+	s.syncerService.EXPECT().ServerHealthErr().Return(errUnknown).AnyTimes()
+
+	cmd := NewRestoreCmd(s.dataService, s.historyService, s.syncerService)
+	cmdBuf := bytes.NewBufferString("")
+	cmd.SetOut(cmdBuf)
+
+	restored := &model.DataCredential{Login: "ivan", Password: "old"}
+	s.dataService.EXPECT().Read(1).Return(&model.DataCredential{Login: "ivan", Password: "new"}, nil)
+	s.historyService.EXPECT().Revision(1, 4).Return(&history.Revision{Revision: 4, Data: restored}, nil)
+	s.dataService.EXPECT().Update(1, restored).Return(nil)
+
+	cmd.SetArgs([]string{"-i", "1", "--rev", "4"})
+	s.Require().NoError(cmd.Execute())
+
+	out, err := io.ReadAll(cmdBuf)
+	s.Require().NoError(err)
+
+	s.Equal("Пароль: ***** → *****\nЗапись восстановлена из ревизии #4.\n", string(out))
+}
+
+func (s *DataCmdTestSuite) TestTrash() {
+	// Отдельный синхронизатор: корзина ведет себя по-разному при наличии соединения с сервером.
+	syncer := mock_data.NewMockSyncerService(gomock.NewController(s.T()))
+	syncer.EXPECT().ServerHealthErr().Return(errUnknown).AnyTimes()
+
+	items := []trash.Item{
+		{UUID: "pending", Pending: true, Data: &model.DataText{Value: "pending"}},
+		{UUID: "trashed", DeletedAt: time.Now(), Data: &model.DataText{Value: "trashed"}},
+	}
+
+	s.Run("List", func() {
+		cmd := NewTrashCmd(s.trashService, syncer)
+		cmdBuf := bytes.NewBufferString("")
+		cmd.SetOut(cmdBuf)
+
+		s.trashService.EXPECT().List(false).Return(items, nil)
+
+		cmd.SetArgs([]string{"list"})
+		s.Require().NoError(cmd.Execute())
+
+		out, err := io.ReadAll(cmdBuf)
+		s.Require().NoError(err)
+
+		s.Contains(string(out), "[ожидает синхронизации] #0 [ Значение: pe | Мета: — ]\n[удалена ")
+	})
+
+	s.Run("Restore", func() {
+		cmd := NewTrashCmd(s.trashService, syncer)
+		cmdBuf := bytes.NewBufferString("")
+		cmd.SetOut(cmdBuf)
+
+		s.trashService.EXPECT().List(false).Return(items, nil)
+		s.trashService.EXPECT().Restore(items[1]).Return(nil)
+
+		cmd.SetArgs([]string{"restore", "-i", "1"})
+		s.Require().NoError(cmd.Execute())
+
+		out, err := io.ReadAll(cmdBuf)
+		s.Require().NoError(err)
+
+		s.Contains(string(out), "Запись восстановлена из корзины.")
+	})
+
+	s.Run("Empty without server", func() {
+		cmd := NewTrashCmd(s.trashService, syncer)
+		cmdBuf := bytes.NewBufferString("")
+		cmd.SetOut(cmdBuf)
+
+		cmd.SetArgs([]string{"empty", "--yes"})
+		s.Require().NoError(cmd.Execute())
+
+		out, err := io.ReadAll(cmdBuf)
+		s.Require().NoError(err)
+
+		s.Contains(string(out), "только при соединении с сервером")
+	})
 }
 
 func (s *DataCmdTestSuite) TestUpdate() {
@@ -189,17 +1206,23 @@ func (s *DataCmdTestSuite) TestUpdate() {
 		index := dataCount + 1
 		s.dataService.EXPECT().Read(index).Return(nil, errUnknown)
 
-		cmd.SetArgs([]string{"-i", strconv.Itoa(index)})
-		err := cmd.Execute()
-		s.Require().NoError(err)
+		errBuf := bytes.NewBufferString("")
+		cmd.SetErr(errBuf)
 
-		out, err := io.ReadAll(cmdBuf)
-		s.Require().NoError(err)
+		cmd.SetArgs([]string{"-i", strconv.Itoa(index)})
+		s.requireExitCode(cmd.Execute())
 
-		s.Contains(string(out), errUnknown.Error())
+		s.Contains(errBuf.String(), errUnknown.Error())
 	})
 }
 
+// requireExitCode проверяет, что команда завершилась с кодом ExitCodeError.
+func (s *DataCmdTestSuite) requireExitCode(err error) {
+	var exitErr *exitcode.Error
+	s.Require().ErrorAs(err, &exitErr)
+	s.Equal(ExitCodeError, exitErr.Code)
+}
+
 func TestDataCmdTestSuite(t *testing.T) {
 	suite.Run(t, new(DataCmdTestSuite))
 }
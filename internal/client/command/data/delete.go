@@ -5,6 +5,7 @@ import (
 )
 
 // NewDeleteCmd конструктор команда удаления записи по индексу.
+// Удаленная запись перемещается в корзину, откуда ее можно восстановить.
 func NewDeleteCmd(dataService Service, syncer SyncerService) *cobra.Command {
 	var index int
 
@@ -21,7 +22,7 @@ func NewDeleteCmd(dataService Service, syncer SyncerService) *cobra.Command {
 				cmd.Println(err.Error())
 				return
 			}
-			cmd.Println("Запись успешно удалена.")
+			cmd.Println("Запись перемещена в корзину.")
 		},
 	}
 
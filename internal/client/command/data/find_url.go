@@ -0,0 +1,67 @@
+package data
+
+import (
+	"fmt"
+
+	"github.com/casnerano/seckeep/internal/client/service/data/print"
+	"github.com/casnerano/seckeep/internal/client/service/urimatch"
+	"github.com/spf13/cobra"
+)
+
+// NewFindURLCmd конструктор команды поиска учетных записей по адресу сайта.
+func NewFindURLCmd(uriMatcher URIMatcher, syncer SyncerService) *cobra.Command {
+	cmd := cobra.Command{
+		Use:   "find-url URL",
+		Short: "Учетные записи для адреса сайта",
+		Long: "Поиск учетных записей, адреса которых совпадают с адресом сайта.\n" +
+			"Записи отсортированы по качеству совпадения: адрес целиком, регулярное выражение, хост, домен.",
+		Args:          cobra.ExactArgs(1),
+		SilenceErrors: true,
+		SilenceUsage:  true,
+		PersistentPreRun: func(cmd *cobra.Command, args []string) {
+			if cmd.Parent() != nil && cmd.Parent().Parent() != nil {
+				cmd.Parent().Parent().PersistentPreRun(cmd.Parent(), args)
+			}
+
+			syncBeforeOutput(cmd, syncer)
+		},
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			return textOutputOnly(cmd)
+		},
+		Run: func(cmd *cobra.Command, args []string) {
+			results, err := uriMatcher.Find(args[0])
+			if err != nil {
+				cmd.Println(err)
+				return
+			}
+
+			if len(results) == 0 {
+				cmd.Println("Учетные записи для адреса не найдены.")
+				return
+			}
+
+			p := print.New(cmd.OutOrStdout())
+			for _, result := range results {
+				fmt.Fprintf(cmd.OutOrStdout(), "[%s] ", qualityName(result.Quality))
+				p.Line(result.Index, result.Credential)
+			}
+		},
+	}
+
+	return &cmd
+}
+
+// qualityName возвращает название качества совпадения адресов.
+func qualityName(quality int) string {
+	switch quality {
+	case urimatch.QualityExact:
+		return "адрес"
+	case urimatch.QualityRegex:
+		return "шаблон"
+	case urimatch.QualityHost:
+		return "хост"
+	case urimatch.QualityDomain:
+		return "домен"
+	}
+	return "—"
+}
@@ -0,0 +1,153 @@
+package data
+
+import (
+	"fmt"
+
+	"github.com/casnerano/seckeep/internal/client/model"
+	"github.com/casnerano/seckeep/internal/client/service/data/print"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+)
+
+// revisionTimeLayout формат вывода времени ревизии.
+const revisionTimeLayout = "02.01.2006 15:04"
+
+// NewHistoryCmd конструктор команды вывода ревизий записи по индексу.
+// Для каждой ревизии печатаются изменения, сделанные после нее.
+func NewHistoryCmd(dataService Service, historyService HistoryService, syncer SyncerService) *cobra.Command {
+	var (
+		index  int
+		reveal bool
+	)
+
+	cmd := cobra.Command{
+		Use:           "history",
+		Short:         "История изменений",
+		SilenceErrors: true,
+		SilenceUsage:  true,
+		PersistentPreRun: func(cmd *cobra.Command, args []string) {
+			if cmd.Parent() != nil && cmd.Parent().Parent() != nil {
+				cmd.Parent().Parent().PersistentPreRun(cmd.Parent(), args)
+			}
+
+			syncBeforeOutput(cmd, syncer)
+		},
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			return textOutputOnly(cmd)
+		},
+		Run: func(cmd *cobra.Command, args []string) {
+			current, err := dataService.Read(index)
+			if err != nil {
+				cmd.Println(err.Error())
+				return
+			}
+
+			revisions, err := historyService.List(index)
+			if err != nil {
+				cmd.Println(err.Error())
+				return
+			}
+
+			if len(revisions) == 0 {
+				cmd.Println("У записи нет прежних версий.")
+				return
+			}
+
+			p := print.New(cmd.OutOrStdout())
+			p.SetReveal(reveal)
+
+			newer := current
+			for _, revision := range revisions {
+				fmt.Fprintf(
+					cmd.OutOrStdout(),
+					"Ревизия #%d от %s (заменена %s):\n",
+					revision.Revision,
+					revision.Version.Local().Format(revisionTimeLayout),
+					revision.CreatedAt.Local().Format(revisionTimeLayout),
+				)
+				p.Diff(model.Diff(revision.Data, newer))
+				fmt.Fprintln(cmd.OutOrStdout())
+
+				newer = revision.Data
+			}
+
+			cmd.Println("Восстановить ревизию: seckeep data restore --index N --rev R")
+		},
+	}
+
+	cmd.Flags().IntVarP(&index, "index", "i", 0, "Индекс (номер) записи")
+	cmd.Flags().BoolVar(&reveal, "reveal", false, "Показать значения скрытых полей")
+	cmd.Flags().SetNormalizeFunc(indexAlias)
+	_ = cmd.MarkFlagRequired("index")
+
+	return &cmd
+}
+
+// NewRestoreCmd конструктор команды восстановления записи по индексу из ревизии.
+// Восстановление — обычное обновление записи, поэтому текущее значение сохраняется новой ревизией.
+func NewRestoreCmd(dataService Service, historyService HistoryService, syncer SyncerService) *cobra.Command {
+	var (
+		index, rev int
+		reveal     bool
+	)
+
+	cmd := cobra.Command{
+		Use:   "restore",
+		Short: "Восстановление из ревизии",
+		PersistentPreRun: func(cmd *cobra.Command, args []string) {
+			if cmd.Parent() != nil && cmd.Parent().Parent() != nil {
+				cmd.Parent().Parent().PersistentPreRun(cmd.Parent(), args)
+			}
+
+			if syncer.ServerHealthErr() == nil {
+				syncer.RunWithStatus()
+			}
+		},
+		PersistentPostRun: func(cmd *cobra.Command, args []string) {
+			if syncer.ServerHealthErr() == nil {
+				syncer.RunWithStatus()
+			}
+		},
+		Run: func(cmd *cobra.Command, args []string) {
+			current, err := dataService.Read(index)
+			if err != nil {
+				cmd.Println(err.Error())
+				return
+			}
+
+			revision, err := historyService.Revision(index, rev)
+			if err != nil {
+				cmd.Println(err.Error())
+				return
+			}
+
+			p := print.New(cmd.OutOrStdout())
+			p.SetReveal(reveal)
+			p.Diff(model.Diff(current, revision.Data))
+
+			if err = dataService.Update(index, revision.Data); err != nil {
+				cmd.Println(err.Error())
+				return
+			}
+
+			cmd.Printf("Запись восстановлена из ревизии #%d.\n", revision.Revision)
+		},
+	}
+
+	cmd.Flags().IntVarP(&index, "index", "i", 0, "Индекс (номер) записи")
+	cmd.Flags().IntVar(&rev, "rev", 0, "Номер ревизии")
+	cmd.Flags().BoolVar(&reveal, "reveal", false, "Показать значения скрытых полей")
+	cmd.Flags().SetNormalizeFunc(indexAlias)
+	_ = cmd.MarkFlagRequired("index")
+	_ = cmd.MarkFlagRequired("rev")
+
+	return &cmd
+}
+
+// indexAlias приводит флаг --id к флагу --index.
+func indexAlias(f *pflag.FlagSet, name string) pflag.NormalizedName {
+	if name == "id" {
+		name = "index"
+	}
+	return pflag.NormalizedName(name)
+}
@@ -1,34 +1,77 @@
 package data
 
 import (
+	"github.com/casnerano/seckeep/internal/client/model"
 	"github.com/casnerano/seckeep/internal/client/service/data/print"
 	"github.com/spf13/cobra"
 )
 
 // NewListCmd конструктор команда вывода списка записей.
+// Ошибки при любом формате вывода печатаются в поток ошибок, клиент завершается с кодом ExitCodeError.
 func NewListCmd(dataService Service, syncer SyncerService) *cobra.Command {
+	var (
+		vault  string
+		tree   bool
+		reveal bool
+		filter model.DataFilter
+	)
+
 	cmd := cobra.Command{
-		Use:   "list",
-		Short: "Список",
+		Use:           "list",
+		Short:         "Список",
+		SilenceErrors: true,
+		SilenceUsage:  true,
 		PersistentPreRun: func(cmd *cobra.Command, args []string) {
 			if cmd.Parent() != nil && cmd.Parent().Parent() != nil {
 				cmd.Parent().Parent().PersistentPreRun(cmd.Parent(), args)
 			}
 
-			if syncer.ServerHealthErr() == nil {
-				syncer.RunWithStatus()
-			}
+			syncBeforeOutput(cmd, syncer)
 		},
-		Run: func(cmd *cobra.Command, args []string) {
+		RunE: func(cmd *cobra.Command, args []string) error {
+			format, err := outputFormat(cmd)
+			if err != nil {
+				return fail(cmd, err.Error())
+			}
+
 			dList := dataService.GetList()
+			if vault != "" {
+				if dList, err = dataService.GetVaultList(vault); err != nil {
+					return fail(cmd, err.Error())
+				}
+			}
+
+			dList = filter.Apply(dList)
+
+			p := print.New(cmd.OutOrStdout())
+			p.SetReveal(reveal)
+
+			if format != print.FormatText {
+				if err = p.FormattedList(format, dList); err != nil {
+					return fail(cmd, err.Error())
+				}
+				return nil
+			}
+
 			if len(dList) == 0 {
 				cmd.Println("Список записей пуст.")
-				return
+				return nil
+			}
+			if tree {
+				p.Tree(dList)
+				return nil
 			}
-			p := print.New(cmd.OutOrStdout())
 			p.GroupedList(dList)
+			return nil
 		},
 	}
 
+	cmd.Flags().StringVar(&vault, "vault", "", "Общее хранилище (имя или UUID)")
+	cmd.Flags().StringVar(&filter.Tag, "tag", "", "Только записи с тегом")
+	cmd.Flags().StringVar(&filter.Folder, "folder", "", "Только записи из папки (включая вложенные)")
+	cmd.Flags().BoolVar(&filter.Favorites, "favorites", false, "Только избранные записи")
+	cmd.Flags().BoolVar(&tree, "tree", false, "Вывести деревом папок")
+	cmd.Flags().BoolVar(&reveal, "reveal", false, "Показать скрытые значения в форматах json, yaml и table")
+
 	return &cmd
 }
@@ -6,8 +6,13 @@ package mock_data
 
 import (
 	reflect "reflect"
+	time "time"
 
 	model "github.com/casnerano/seckeep/internal/client/model"
+	history "github.com/casnerano/seckeep/internal/client/service/history"
+	search "github.com/casnerano/seckeep/internal/client/service/search"
+	trash "github.com/casnerano/seckeep/internal/client/service/trash"
+	urimatch "github.com/casnerano/seckeep/internal/client/service/urimatch"
 	gomock "github.com/golang/mock/gomock"
 )
 
@@ -48,6 +53,20 @@ func (mr *MockServiceMockRecorder) Create(dt interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockService)(nil).Create), dt)
 }
 
+// CreateInVault mocks base method.
+func (m *MockService) CreateInVault(vault string, dt model.DataTypeable) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateInVault", vault, dt)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateInVault indicates an expected call of CreateInVault.
+func (mr *MockServiceMockRecorder) CreateInVault(vault, dt interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateInVault", reflect.TypeOf((*MockService)(nil).CreateInVault), vault, dt)
+}
+
 // Delete mocks base method.
 func (m *MockService) Delete(index int) error {
 	m.ctrl.T.Helper()
@@ -76,6 +95,21 @@ func (mr *MockServiceMockRecorder) GetList() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetList", reflect.TypeOf((*MockService)(nil).GetList))
 }
 
+// GetVaultList mocks base method.
+func (m *MockService) GetVaultList(vault string) (map[int]model.DataTypeable, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetVaultList", vault)
+	ret0, _ := ret[0].(map[int]model.DataTypeable)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetVaultList indicates an expected call of GetVaultList.
+func (mr *MockServiceMockRecorder) GetVaultList(vault interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVaultList", reflect.TypeOf((*MockService)(nil).GetVaultList), vault)
+}
+
 // Read mocks base method.
 func (m *MockService) Read(index int) (model.DataTypeable, error) {
 	m.ctrl.T.Helper()
@@ -105,6 +139,304 @@ func (mr *MockServiceMockRecorder) Update(index, dt interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockService)(nil).Update), index, dt)
 }
 
+// MockShareService is a mock of ShareService interface.
+type MockShareService struct {
+	ctrl     *gomock.Controller
+	recorder *MockShareServiceMockRecorder
+}
+
+// MockShareServiceMockRecorder is the mock recorder for MockShareService.
+type MockShareServiceMockRecorder struct {
+	mock *MockShareService
+}
+
+// NewMockShareService creates a new mock instance.
+func NewMockShareService(ctrl *gomock.Controller) *MockShareService {
+	mock := &MockShareService{ctrl: ctrl}
+	mock.recorder = &MockShareServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockShareService) EXPECT() *MockShareServiceMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockShareService) Create(dt model.DataTypeable, ttl time.Duration, views int) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", dt, ttl, views)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockShareServiceMockRecorder) Create(dt, ttl, views interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockShareService)(nil).Create), dt, ttl, views)
+}
+
+// MockSearchService is a mock of SearchService interface.
+type MockSearchService struct {
+	ctrl     *gomock.Controller
+	recorder *MockSearchServiceMockRecorder
+}
+
+// MockSearchServiceMockRecorder is the mock recorder for MockSearchService.
+type MockSearchServiceMockRecorder struct {
+	mock *MockSearchService
+}
+
+// NewMockSearchService creates a new mock instance.
+func NewMockSearchService(ctrl *gomock.Controller) *MockSearchService {
+	mock := &MockSearchService{ctrl: ctrl}
+	mock.recorder = &MockSearchServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSearchService) EXPECT() *MockSearchServiceMockRecorder {
+	return m.recorder
+}
+
+// Find mocks base method.
+func (m *MockSearchService) Find(query string) []search.Result {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Find", query)
+	ret0, _ := ret[0].([]search.Result)
+	return ret0
+}
+
+// Find indicates an expected call of Find.
+func (mr *MockSearchServiceMockRecorder) Find(query interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Find", reflect.TypeOf((*MockSearchService)(nil).Find), query)
+}
+
+// FindIndexed mocks base method.
+func (m *MockSearchService) FindIndexed(query string) ([]search.Result, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindIndexed", query)
+	ret0, _ := ret[0].([]search.Result)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindIndexed indicates an expected call of FindIndexed.
+func (mr *MockSearchServiceMockRecorder) FindIndexed(query interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindIndexed", reflect.TypeOf((*MockSearchService)(nil).FindIndexed), query)
+}
+
+// MockURIMatcher is a mock of URIMatcher interface.
+type MockURIMatcher struct {
+	ctrl     *gomock.Controller
+	recorder *MockURIMatcherMockRecorder
+}
+
+// MockURIMatcherMockRecorder is the mock recorder for MockURIMatcher.
+type MockURIMatcherMockRecorder struct {
+	mock *MockURIMatcher
+}
+
+// NewMockURIMatcher creates a new mock instance.
+func NewMockURIMatcher(ctrl *gomock.Controller) *MockURIMatcher {
+	mock := &MockURIMatcher{ctrl: ctrl}
+	mock.recorder = &MockURIMatcherMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockURIMatcher) EXPECT() *MockURIMatcherMockRecorder {
+	return m.recorder
+}
+
+// Find mocks base method.
+func (m *MockURIMatcher) Find(target string) ([]urimatch.Result, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Find", target)
+	ret0, _ := ret[0].([]urimatch.Result)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Find indicates an expected call of Find.
+func (mr *MockURIMatcherMockRecorder) Find(target interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Find", reflect.TypeOf((*MockURIMatcher)(nil).Find), target)
+}
+
+// MockHistoryService is a mock of HistoryService interface.
+type MockHistoryService struct {
+	ctrl     *gomock.Controller
+	recorder *MockHistoryServiceMockRecorder
+}
+
+// MockHistoryServiceMockRecorder is the mock recorder for MockHistoryService.
+type MockHistoryServiceMockRecorder struct {
+	mock *MockHistoryService
+}
+
+// NewMockHistoryService creates a new mock instance.
+func NewMockHistoryService(ctrl *gomock.Controller) *MockHistoryService {
+	mock := &MockHistoryService{ctrl: ctrl}
+	mock.recorder = &MockHistoryServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockHistoryService) EXPECT() *MockHistoryServiceMockRecorder {
+	return m.recorder
+}
+
+// List mocks base method.
+func (m *MockHistoryService) List(index int) ([]history.Revision, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", index)
+	ret0, _ := ret[0].([]history.Revision)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockHistoryServiceMockRecorder) List(index interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockHistoryService)(nil).List), index)
+}
+
+// Revision mocks base method.
+func (m *MockHistoryService) Revision(index, revision int) (*history.Revision, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Revision", index, revision)
+	ret0, _ := ret[0].(*history.Revision)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Revision indicates an expected call of Revision.
+func (mr *MockHistoryServiceMockRecorder) Revision(index, revision interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Revision", reflect.TypeOf((*MockHistoryService)(nil).Revision), index, revision)
+}
+
+// MockTrashService is a mock of TrashService interface.
+type MockTrashService struct {
+	ctrl     *gomock.Controller
+	recorder *MockTrashServiceMockRecorder
+}
+
+// MockTrashServiceMockRecorder is the mock recorder for MockTrashService.
+type MockTrashServiceMockRecorder struct {
+	mock *MockTrashService
+}
+
+// NewMockTrashService creates a new mock instance.
+func NewMockTrashService(ctrl *gomock.Controller) *MockTrashService {
+	mock := &MockTrashService{ctrl: ctrl}
+	mock.recorder = &MockTrashServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTrashService) EXPECT() *MockTrashServiceMockRecorder {
+	return m.recorder
+}
+
+// Empty mocks base method.
+func (m *MockTrashService) Empty() error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Empty")
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Empty indicates an expected call of Empty.
+func (mr *MockTrashServiceMockRecorder) Empty() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Empty", reflect.TypeOf((*MockTrashService)(nil).Empty))
+}
+
+// List mocks base method.
+func (m *MockTrashService) List(remote bool) ([]trash.Item, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", remote)
+	ret0, _ := ret[0].([]trash.Item)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockTrashServiceMockRecorder) List(remote interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockTrashService)(nil).List), remote)
+}
+
+// Restore mocks base method.
+func (m *MockTrashService) Restore(item trash.Item) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Restore", item)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Restore indicates an expected call of Restore.
+func (mr *MockTrashServiceMockRecorder) Restore(item interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Restore", reflect.TypeOf((*MockTrashService)(nil).Restore), item)
+}
+
+// MockClipboard is a mock of Clipboard interface.
+type MockClipboard struct {
+	ctrl     *gomock.Controller
+	recorder *MockClipboardMockRecorder
+}
+
+// MockClipboardMockRecorder is the mock recorder for MockClipboard.
+type MockClipboardMockRecorder struct {
+	mock *MockClipboard
+}
+
+// NewMockClipboard creates a new mock instance.
+func NewMockClipboard(ctrl *gomock.Controller) *MockClipboard {
+	mock := &MockClipboard{ctrl: ctrl}
+	mock.recorder = &MockClipboardMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockClipboard) EXPECT() *MockClipboardMockRecorder {
+	return m.recorder
+}
+
+// Clear mocks base method.
+func (m *MockClipboard) Clear(text string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Clear", text)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Clear indicates an expected call of Clear.
+func (mr *MockClipboardMockRecorder) Clear(text interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Clear", reflect.TypeOf((*MockClipboard)(nil).Clear), text)
+}
+
+// Write mocks base method.
+func (m *MockClipboard) Write(text string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Write", text)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Write indicates an expected call of Write.
+func (mr *MockClipboardMockRecorder) Write(text interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Write", reflect.TypeOf((*MockClipboard)(nil).Write), text)
+}
+
 // MockSyncerService is a mock of SyncerService interface.
 type MockSyncerService struct {
 	ctrl     *gomock.Controller
@@ -128,6 +460,20 @@ func (m *MockSyncerService) EXPECT() *MockSyncerServiceMockRecorder {
 	return m.recorder
 }
 
+// Run mocks base method.
+func (m *MockSyncerService) Run() error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Run")
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Run indicates an expected call of Run.
+func (mr *MockSyncerServiceMockRecorder) Run() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Run", reflect.TypeOf((*MockSyncerService)(nil).Run))
+}
+
 // RunWithStatus mocks base method.
 func (m *MockSyncerService) RunWithStatus() {
 	m.ctrl.T.Helper()
@@ -1,39 +1,100 @@
 package data
 
 import (
+	"errors"
+	"os"
+	"path/filepath"
+
+	"github.com/casnerano/seckeep/internal/client/model"
 	"github.com/casnerano/seckeep/internal/client/service/data/print"
 	"github.com/spf13/cobra"
 )
 
 // NewReadCmd конструктор команда вывода записи по индексу.
+// Ошибки при любом формате вывода печатаются в поток ошибок, клиент завершается с кодом ExitCodeError.
 func NewReadCmd(dataService Service, syncer SyncerService) *cobra.Command {
-	var index int
+	var (
+		index              int
+		reveal             bool
+		attachment, saveTo string
+	)
 
 	cmd := cobra.Command{
-		Use:   "read",
-		Short: "Чтение",
+		Use:           "read",
+		Short:         "Чтение",
+		SilenceErrors: true,
+		SilenceUsage:  true,
 		PersistentPreRun: func(cmd *cobra.Command, args []string) {
 			if cmd.Parent() != nil && cmd.Parent().Parent() != nil {
 				cmd.Parent().Parent().PersistentPreRun(cmd.Parent(), args)
 			}
 
-			if syncer.ServerHealthErr() == nil {
-				syncer.RunWithStatus()
-			}
+			syncBeforeOutput(cmd, syncer)
 		},
-		Run: func(cmd *cobra.Command, args []string) {
+		RunE: func(cmd *cobra.Command, args []string) error {
+			format, err := outputFormat(cmd)
+			if err != nil {
+				return fail(cmd, err.Error())
+			}
+
 			d, err := dataService.Read(index)
 			if err != nil {
-				cmd.Println(err.Error())
-				return
+				return fail(cmd, err.Error())
 			}
+
+			if attachment != "" {
+				output, err := saveAttachment(d, attachment, saveTo)
+				if err != nil {
+					if errors.Is(err, errAttachmentNotFound) {
+						return fail(cmd, "Вложение не найдено.")
+					}
+					return fail(cmd, err.Error())
+				}
+				if !format.Machine() {
+					cmd.Printf("Вложение сохранено в %s.\n", output)
+				}
+				return nil
+			}
+
 			p := print.New(cmd.OutOrStdout())
-			p.Detail(index, d)
+			p.SetReveal(reveal)
+			if err = p.FormattedDetail(format, index, d); err != nil {
+				return fail(cmd, err.Error())
+			}
+			return nil
 		},
 	}
 
 	cmd.Flags().IntVarP(&index, "index", "i", 0, "Индекс (номер) записи")
+	cmd.Flags().BoolVar(&reveal, "reveal", false, "Показать скрытые значения (пользовательские поля, номер и CVV карты)")
+	cmd.Flags().StringVar(&attachment, "attachment", "", "Сохранить прикрепленный файл с этим именем")
+	cmd.Flags().StringVar(&saveTo, "save-to", "", "Путь для сохранения прикрепленного файла (по умолчанию — его имя)")
 	_ = cmd.MarkFlagRequired("index")
 
 	return &cmd
 }
+
+// errAttachmentNotFound у записи нет вложения с заданным именем.
+var errAttachmentNotFound = errors.New("attachment not found")
+
+// saveAttachment сохраняет прикрепленный к записи файл name по пути output (по умолчанию — имя файла)
+// и возвращает путь сохраненного файла.
+func saveAttachment(d model.DataTypeable, name, output string) (string, error) {
+	for _, attachment := range model.Attachments(d) {
+		if attachment.Name != name {
+			continue
+		}
+
+		if output == "" {
+			output = filepath.Base(attachment.Name)
+		}
+
+		if err := os.WriteFile(output, attachment.Content, 0600); err != nil {
+			return "", err
+		}
+
+		return output, nil
+	}
+
+	return "", errAttachmentNotFound
+}
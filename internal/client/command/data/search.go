@@ -0,0 +1,70 @@
+package data
+
+import (
+	"strings"
+
+	"github.com/casnerano/seckeep/internal/client/service/data/print"
+	"github.com/casnerano/seckeep/internal/client/service/search"
+	"github.com/spf13/cobra"
+)
+
+// NewSearchCmd конструктор команды поиска записей.
+func NewSearchCmd(searchService SearchService, syncer SyncerService) *cobra.Command {
+	var (
+		limit    int
+		useIndex bool
+	)
+
+	cmd := cobra.Command{
+		Use:   "search QUERY",
+		Short: "Поиск",
+		Long: "Поиск по заголовкам, тегам, логинам, тексту, держателям карт, названиям документов и мета данным.\n" +
+			"С флагом --index используется зашифрованный локальный индекс, и расшифровываются только найденные записи.",
+		Args:          cobra.MinimumNArgs(1),
+		SilenceErrors: true,
+		SilenceUsage:  true,
+		PersistentPreRun: func(cmd *cobra.Command, args []string) {
+			if cmd.Parent() != nil && cmd.Parent().Parent() != nil {
+				cmd.Parent().Parent().PersistentPreRun(cmd.Parent(), args)
+			}
+
+			syncBeforeOutput(cmd, syncer)
+		},
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			return textOutputOnly(cmd)
+		},
+		Run: func(cmd *cobra.Command, args []string) {
+			query := strings.Join(args, " ")
+
+			var results []search.Result
+			if useIndex {
+				var err error
+				if results, err = searchService.FindIndexed(query); err != nil {
+					cmd.Println(err)
+					return
+				}
+			} else {
+				results = searchService.Find(query)
+			}
+
+			if len(results) == 0 {
+				cmd.Println("Ничего не найдено.")
+				return
+			}
+
+			if limit > 0 && len(results) > limit {
+				results = results[:limit]
+			}
+
+			p := print.New(cmd.OutOrStdout())
+			for _, result := range results {
+				p.Line(result.Index, result.Data)
+			}
+		},
+	}
+
+	cmd.Flags().IntVarP(&limit, "limit", "n", 0, "Максимальное количество результатов (0 — без ограничения)")
+	cmd.Flags().BoolVar(&useIndex, "index", false, "Использовать локальный поисковый индекс")
+
+	return &cmd
+}
@@ -0,0 +1,59 @@
+package data
+
+import (
+	"errors"
+	"time"
+
+	"github.com/casnerano/seckeep/internal/client/service/share"
+	"github.com/spf13/cobra"
+)
+
+// NewShareCmd конструктор команды создания ссылки на запись.
+// Ссылка действует ограниченное время и ограниченное число просмотров,
+// ключ расшифровки содержится только в самой ссылке.
+func NewShareCmd(dataService Service, shareService ShareService, syncer SyncerService) *cobra.Command {
+	var index, views int
+	var ttl time.Duration
+
+	cmd := cobra.Command{
+		Use:   "share",
+		Short: "Ссылка на запись для передачи",
+		PersistentPreRun: func(cmd *cobra.Command, args []string) {
+			if cmd.Parent() != nil && cmd.Parent().Parent() != nil {
+				cmd.Parent().Parent().PersistentPreRun(cmd.Parent(), args)
+			}
+
+			if syncer.ServerHealthErr() == nil {
+				syncer.RunWithStatus()
+			}
+		},
+		Run: func(cmd *cobra.Command, args []string) {
+			d, err := dataService.Read(index)
+			if err != nil {
+				cmd.Println(err.Error())
+				return
+			}
+
+			link, err := shareService.Create(d, ttl, views)
+			if err != nil {
+				if errors.Is(err, share.ErrUnauthorized) {
+					cmd.Println("Необходима авторизация.")
+					return
+				}
+				cmd.Println(err)
+				return
+			}
+
+			cmd.Printf("Ссылка действует %s, просмотров: %d.\n", ttl, views)
+			cmd.Println(link)
+		},
+	}
+
+	cmd.Flags().IntVarP(&index, "index", "i", 0, "Индекс (номер) записи")
+	cmd.Flags().DurationVar(&ttl, "ttl", 24*time.Hour, "Срок действия ссылки (от 1m до 168h)")
+	cmd.Flags().IntVar(&views, "views", 1, "Допустимое число просмотров")
+	cmd.Flags().SetNormalizeFunc(indexAlias)
+	_ = cmd.MarkFlagRequired("index")
+
+	return &cmd
+}
@@ -0,0 +1,141 @@
+package data
+
+import (
+	"fmt"
+
+	"github.com/casnerano/seckeep/internal/client/service/data/print"
+	"github.com/casnerano/seckeep/internal/client/service/trash"
+	"github.com/spf13/cobra"
+)
+
+// NewTrashCmd конструктор команды работы с корзиной удаленных записей.
+// Перед выполнением дочерних команд удаленные записи синхронизируются с сервером.
+func NewTrashCmd(trashService TrashService, syncer SyncerService) *cobra.Command {
+	cmd := cobra.Command{
+		Use:   "trash",
+		Short: "Корзина удаленных записей",
+		PersistentPreRun: func(cmd *cobra.Command, args []string) {
+			if trashCmd := cmd.Parent(); trashCmd != nil && trashCmd.Parent() != nil && trashCmd.Parent().Parent() != nil {
+				trashCmd.Parent().Parent().PersistentPreRun(cmd, args)
+			}
+
+			syncBeforeOutput(cmd, syncer)
+		},
+	}
+
+	cmd.AddCommand(newTrashListCmd(trashService, syncer))
+	cmd.AddCommand(newTrashRestoreCmd(trashService, syncer))
+	cmd.AddCommand(newTrashEmptyCmd(trashService, syncer))
+
+	return &cmd
+}
+
+// newTrashListCmd конструктор команды вывода записей корзины.
+func newTrashListCmd(trashService TrashService, syncer SyncerService) *cobra.Command {
+	return &cobra.Command{
+		Use:           "list",
+		Short:         "Список записей в корзине",
+		SilenceErrors: true,
+		SilenceUsage:  true,
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			return textOutputOnly(cmd)
+		},
+		Run: func(cmd *cobra.Command, args []string) {
+			items, err := trashService.List(syncer.ServerHealthErr() == nil)
+			if err != nil {
+				cmd.Println(err.Error())
+				return
+			}
+
+			if len(items) == 0 {
+				cmd.Println("Корзина пуста.")
+				return
+			}
+
+			p := print.New(cmd.OutOrStdout())
+			for index, item := range items {
+				fmt.Fprintf(cmd.OutOrStdout(), "[%s] ", deletedStatus(item))
+				p.Line(index, item.Data)
+			}
+		},
+	}
+}
+
+// newTrashRestoreCmd конструктор команды восстановления записи из корзины по индексу в списке корзины.
+func newTrashRestoreCmd(trashService TrashService, syncer SyncerService) *cobra.Command {
+	var index int
+
+	cmd := cobra.Command{
+		Use:   "restore",
+		Short: "Восстановление записи из корзины",
+		PersistentPostRun: func(cmd *cobra.Command, args []string) {
+			if syncer.ServerHealthErr() == nil {
+				syncer.RunWithStatus()
+			}
+		},
+		Run: func(cmd *cobra.Command, args []string) {
+			items, err := trashService.List(syncer.ServerHealthErr() == nil)
+			if err != nil {
+				cmd.Println(err.Error())
+				return
+			}
+
+			if index < 0 || index >= len(items) {
+				cmd.Println("Запись в корзине не найдена.")
+				return
+			}
+
+			if err = trashService.Restore(items[index]); err != nil {
+				cmd.Println(err.Error())
+				return
+			}
+
+			cmd.Println("Запись восстановлена из корзины.")
+		},
+	}
+
+	cmd.Flags().IntVarP(&index, "index", "i", 0, "Индекс (номер) записи в корзине")
+	_ = cmd.MarkFlagRequired("index")
+
+	return &cmd
+}
+
+// newTrashEmptyCmd конструктор команды окончательного удаления записей из корзины.
+func newTrashEmptyCmd(trashService TrashService, syncer SyncerService) *cobra.Command {
+	var yes bool
+
+	cmd := cobra.Command{
+		Use:   "empty",
+		Short: "Очистка корзины",
+		Run: func(cmd *cobra.Command, args []string) {
+			if syncer.ServerHealthErr() != nil {
+				cmd.Println("Очистка корзины доступна только при соединении с сервером.")
+				return
+			}
+
+			if !yes && !confirm(cmd, "Записи в корзине будут удалены без возможности восстановления. Продолжить?") {
+				cmd.Println("Очистка корзины отменена.")
+				return
+			}
+
+			if err := trashService.Empty(); err != nil {
+				cmd.Println(err.Error())
+				return
+			}
+
+			cmd.Println("Корзина очищена.")
+		},
+	}
+
+	cmd.Flags().BoolVarP(&yes, "yes", "y", false, "Не запрашивать подтверждение")
+
+	return &cmd
+}
+
+// deletedStatus возвращает состояние записи в корзине: время удаления или ожидание синхронизации.
+func deletedStatus(item trash.Item) string {
+	if item.Pending {
+		return "ожидает синхронизации"
+	}
+	return "удалена " + item.DeletedAt.Local().Format(revisionTimeLayout)
+}
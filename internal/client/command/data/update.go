@@ -1,130 +1,418 @@
 package data
 
 import (
+	"errors"
 	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
 
+	"github.com/casnerano/seckeep/internal/client/command/generate"
+	"github.com/casnerano/seckeep/internal/client/command/prompt"
 	"github.com/casnerano/seckeep/internal/client/model"
-	smodel "github.com/casnerano/seckeep/internal/pkg/model"
 	"github.com/casnerano/seckeep/pkg/svalid"
 	"github.com/spf13/cobra"
 )
 
-type uQuestion struct {
-	title        string
-	currentValue string
-	value        string
-}
-
-type uQuestions map[string]*uQuestion
-
-func (uq uQuestions) ask() {
-	for _, v := range uq {
-		fmt.Printf("%s (текущее значение: %s) > ", v.title, v.currentValue)
-		if _, err := fmt.Scanf("%s\n", &v.value); err != nil {
-			v.value = v.currentValue
-		}
-	}
-}
-
 // NewUpdateCmd конструктор команда обновления записи по индексу.
+// Изменения задаются флагами: --field для основных полей, сведений о записи, мета данных
+// и пользовательских полей, --stdin для секретных значений, --file для содержимого документа.
+// Секретное поле, заданное как "имя=-", вводится без отображения.
+// Пароль учетной записи можно заменить сгенерированным флагом --generate.
+// Без флагов изменений основные поля запрашиваются интерактивно.
+// Ошибки печатаются в поток ошибок, клиент завершается с кодом ExitCodeError.
 func NewUpdateCmd(dataService Service, syncer SyncerService) *cobra.Command {
-	var index int
+	var (
+		index int
+		kind  string
+		edit  extrasEdit
+	)
+
+	genOpts := generate.NewOptions()
 
 	cmd := cobra.Command{
-		Use:   "update",
-		Short: "Обновление",
+		Use:           "update",
+		Short:         "Обновление",
+		SilenceErrors: true,
+		SilenceUsage:  true,
 		PersistentPostRun: func(cmd *cobra.Command, args []string) {
 			if syncer.ServerHealthErr() == nil {
 				syncer.RunWithStatus()
 			}
 		},
-		Run: func(cmd *cobra.Command, args []string) {
+		RunE: func(cmd *cobra.Command, args []string) error {
 			d, err := dataService.Read(index)
 			if err != nil {
-				cmd.Println(err.Error())
-				return
+				return fail(cmd, err.Error())
 			}
 
-			var updatedData model.DataTypeable
-
-			switch d.Type() {
-			case smodel.DataTypeCredential:
-				if credential, ok := d.(*model.DataCredential); ok {
-					questions := uQuestions{
-						"login":    {title: "Логин", currentValue: credential.Login},
-						"password": {title: "Пароль", currentValue: credential.Password},
-					}
-
-					questions.ask()
-
-					credential.Login = questions["login"].value
-					credential.Password = questions["password"].value
-
-					updatedData = credential
+			if edit.changed(cmd) {
+				if err = edit.apply(cmd, d); err != nil {
+					return fail(cmd, prompt.Message(err))
 				}
-			case smodel.DataTypeText:
-				if text, ok := d.(*model.DataText); ok {
-					questions := uQuestions{
-						"value": {title: "Значение", currentValue: text.Value},
+				if kind != "" {
+					credential, ok := d.(*model.DataCredential)
+					if !ok {
+						return fail(cmd, "Генерация пароля доступна только для учетных записей.")
 					}
-
-					questions.ask()
-
-					text.Value = questions["value"].value
-
-					updatedData = text
-				}
-			case smodel.DataTypeCard:
-				if card, ok := d.(*model.DataCard); ok {
-					questions := uQuestions{
-						"number":     {title: "Номер карты", currentValue: card.Number},
-						"month-year": {title: "Месяц/Год", currentValue: card.MonthYear},
-						"owner":      {title: "Держатель", currentValue: card.Owner},
-						"cvv":        {title: "CVV", currentValue: card.CVV},
+					if credential.Password, err = genOpts.Generate(kind); err != nil {
+						return fail(cmd, generate.Message(err))
 					}
-
-					questions.ask()
-
-					card.Number = questions["number"].value
-					card.MonthYear = questions["month-year"].value
-					card.CVV = questions["owner"].value
-					card.Owner = questions["cvv"].value
-
-					updatedData = card
 				}
-			case smodel.DataTypeDocument:
-				if document, ok := d.(*model.DataDocument); ok {
-					questions := uQuestions{
-						"name": {title: "Название", currentValue: document.Name},
-					}
-
-					questions.ask()
-
-					document.Name = questions["name"].value
-
-					updatedData = document
+			} else if err = ask(cmd, d); err != nil {
+				if errors.Is(err, model.ErrUnknownDataType) {
+					return fail(cmd, "Неизвестный тип данных.")
 				}
-			default:
-				cmd.Println("Неизвестный тип данных.")
-				return
+				return fail(cmd, err.Error())
+			}
+
+			if err = model.CheckFields(d); err != nil {
+				return fail(cmd, err.Error())
 			}
 
 			validator := svalid.New()
-			if err = validator.Validate(updatedData); err != nil {
-				cmd.Println(err.Error())
-				return
+			if err = validator.Validate(d); err != nil {
+				return fail(cmd, err.Error())
 			}
 
-			if err = dataService.Update(index, updatedData); err != nil {
-				cmd.Println(err.Error())
+			if err = dataService.Update(index, d); err != nil {
+				return fail(cmd, err.Error())
 			}
 
 			cmd.Println("Данные успешно обновлены.")
+			return nil
 		},
 	}
 
 	cmd.Flags().IntVarP(&index, "index", "i", 0, "Индекс (номер) записи")
+	cmd.Flags().StringArrayVar(
+		&edit.setFields,
+		"field",
+		[]string{},
+		"Изменить поле: основное (password=...), title, folder, favorite, tag и meta (=, += и -=) "+
+			"или пользовательское в формате [тип:]имя=значение; для секретного поля «имя=-» — ввести без отображения",
+	)
+	cmd.Flags().StringVar(
+		&edit.stdinField,
+		"stdin",
+		"",
+		"Прочитать значение поля из стандартного ввода, имя поля — как в --field (например, password)",
+	)
+	cmd.Flags().StringVar(&edit.file, "file", "", "Заменить содержимое документа файлом")
+	cmd.Flags().StringArrayVar(&edit.removeFields, "remove-field", []string{}, "Удалить пользовательское поле")
+	cmd.Flags().StringArrayVar(&edit.attach, "attach", []string{}, "Прикрепить файл (учетные записи и карты)")
+	cmd.Flags().StringArrayVar(&edit.detach, "detach", []string{}, "Удалить прикрепленный файл")
+	cmd.Flags().StringVar(&kind, "generate", "", "Сгенерировать новый пароль: password (по умолчанию) или passphrase")
+	cmd.Flags().Lookup("generate").NoOptDefVal = generate.KindPassword
+	genOpts.RegisterPasswordFlags(cmd.Flags())
+	genOpts.RegisterPassphraseFlags(cmd.Flags())
+	cmd.Flags().SetNormalizeFunc(indexAlias)
 	_ = cmd.MarkFlagRequired("index")
 
 	return &cmd
 }
+
+// ask интерактивно запрашивает новые значения основных полей записи по описанию типа из реестра.
+// Пустой ввод оставляет текущее значение, секретные поля вводятся без отображения.
+// Многострочные поля интерактивно не изменяются.
+func ask(cmd *cobra.Command, d model.DataTypeable) error {
+	spec, ok := model.LookupType(d.Type())
+	if !ok {
+		return model.ErrUnknownDataType
+	}
+
+	p := prompt.New(cmd.InOrStdin(), cmd.OutOrStdout())
+	for _, field := range spec.Fields {
+		if field.Multiline {
+			continue
+		}
+
+		var (
+			value string
+			err   error
+		)
+
+		if field.Secret {
+			value, err = p.Secret(fmt.Sprintf("%s (Enter — оставить текущее значение) > ", field.Title))
+		} else {
+			value, err = p.Line(fmt.Sprintf("%s (текущее значение: %s) > ", field.Title, field.Get(d)))
+		}
+
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		if value == "" {
+			continue
+		}
+		if err = field.Set(d, value); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Операторы изменения поля флагом --field.
+const (
+	fieldOpSet    = "="
+	fieldOpAppend = "+="
+	fieldOpRemove = "-="
+)
+
+// Имена сведений о записи, изменяемых флагом --field.
+const (
+	fieldTitle    = "title"
+	fieldFolder   = "folder"
+	fieldFavorite = "favorite"
+	fieldTag      = "tag"
+	fieldMeta     = "meta"
+)
+
+// extrasEdit структура изменений записи, заданных флагами.
+type extrasEdit struct {
+	setFields    []string
+	stdinField   string
+	file         string
+	removeFields []string
+	attach       []string
+	detach       []string
+}
+
+// changed проверяет, заданы ли изменения флагами.
+func (e *extrasEdit) changed(cmd *cobra.Command) bool {
+	for _, name := range []string{"field", "stdin", "file", "remove-field", "attach", "detach", "generate"} {
+		if cmd.Flags().Changed(name) {
+			return true
+		}
+	}
+	return false
+}
+
+// apply применяет изменения к записи.
+func (e *extrasEdit) apply(cmd *cobra.Command, d model.DataTypeable) error {
+	for _, name := range e.removeFields {
+		if err := model.RemoveField(d, name); err != nil {
+			return err
+		}
+	}
+
+	secrets := prompt.NewSecrets(cmd)
+	for _, value := range e.setFields {
+		value, err := secretField(secrets, d, value)
+		if err != nil {
+			return err
+		}
+		if err = applyField(d, value); err != nil {
+			return err
+		}
+	}
+
+	if e.stdinField != "" {
+		content, err := io.ReadAll(cmd.InOrStdin())
+		if err != nil {
+			return err
+		}
+
+		value := strings.TrimSuffix(strings.TrimSuffix(string(content), "\n"), "\r")
+		if err = applyField(d, e.stdinField+fieldOpSet+value); err != nil {
+			return err
+		}
+	}
+
+	if e.file != "" {
+		document, ok := d.(*model.DataDocument)
+		if !ok {
+			return errors.New("--file is supported only for documents")
+		}
+
+		content, err := os.ReadFile(e.file)
+		if err != nil {
+			return err
+		}
+		document.Content = content
+	}
+
+	for _, name := range e.detach {
+		if err := model.Detach(d, name); err != nil {
+			return err
+		}
+	}
+
+	for _, file := range e.attach {
+		content, err := os.ReadFile(file)
+		if err != nil {
+			return err
+		}
+		if err := model.Attach(d, model.DataAttachment{Name: filepath.Base(file), Content: content}); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// applyField применяет к записи изменение в формате "имя=значение", "имя+=значение" или "имя-=значение".
+// Операторы += и -= добавляют и удаляют значения списков tag и meta.
+// Теги перечисляются через запятую, мета данные задаются по одному значению на флаг,
+// поэтому значение мета данных может содержать запятые.
+// Остальные имена без типа сначала ищутся среди основных полей записи, затем среди сведений о записи
+// (title, folder, favorite), иначе изменяется пользовательское поле.
+func applyField(d model.DataTypeable, s string) error {
+	if kind, _, ok := strings.Cut(s, ":"); ok && model.FieldType(strings.ToUpper(kind)).IsValid() {
+		return setCustomField(d, s)
+	}
+
+	position := strings.Index(s, fieldOpSet)
+	if position < 0 {
+		return setCustomField(d, s)
+	}
+
+	name, op, value := s[:position], fieldOpSet, s[position+len(fieldOpSet):]
+	if strings.HasSuffix(name, "+") {
+		name, op = strings.TrimSuffix(name, "+"), fieldOpAppend
+	} else if strings.HasSuffix(name, "-") {
+		name, op = strings.TrimSuffix(name, "-"), fieldOpRemove
+	}
+	name = strings.TrimSpace(name)
+
+	spec, ok := model.LookupType(d.Type())
+	if !ok {
+		return model.ErrUnknownDataType
+	}
+
+	info := d.Info()
+
+	switch {
+	case name == fieldMeta:
+		return spec.SetMeta(d, editList(spec.Meta(d), op, listValues(value)))
+	case name == fieldTag || name == "tags":
+		info.Tags = editList(info.Tags, op, listValues(strings.Split(value, ",")...))
+		return model.SetInfo(d, info)
+	case op != fieldOpSet:
+		return fmt.Errorf("%w: operator %s is supported only for %s and %s", model.ErrInvalidField, op, fieldTag, fieldMeta)
+	}
+
+	if field, ok := lookupField(spec, name); ok {
+		return field.Set(d, value)
+	}
+
+	switch name {
+	case fieldTitle:
+		info.Title = strings.TrimSpace(value)
+	case fieldFolder:
+		info.Folder = model.CleanFolder(value)
+	case fieldFavorite:
+		favorite, err := strconv.ParseBool(value)
+		if err != nil {
+			return fmt.Errorf("%w: %s must be true or false", model.ErrInvalidField, fieldFavorite)
+		}
+		info.Favorite = favorite
+	default:
+		return setCustomField(d, s)
+	}
+
+	return model.SetInfo(d, info)
+}
+
+// secretField запрашивает значение секретного поля (основного или скрытого пользовательского),
+// заданного флагом --field в виде "имя=-", и возвращает изменение с введенным значением.
+// Для секретного значения, переданного в командной строке, выводится предупреждение.
+func secretField(secrets *prompt.Secrets, d model.DataTypeable, s string) (string, error) {
+	name, value, ok := strings.Cut(s, fieldOpSet)
+	if !ok {
+		return s, nil
+	}
+
+	title := ""
+	if kind, rest, ok := strings.Cut(name, ":"); ok && model.FieldType(strings.ToUpper(kind)) == model.FieldTypeHidden {
+		title = strings.TrimSpace(rest)
+	} else if spec, ok := model.LookupType(d.Type()); ok {
+		if field, ok := lookupField(spec, strings.TrimSpace(name)); ok && field.Secret {
+			title = field.Title
+		}
+	}
+
+	switch {
+	case title == "" || value == "":
+		return s, nil
+	case value != prompt.Stdin:
+		secrets.Warn("--field " + name)
+		return s, nil
+	}
+
+	value, err := secrets.Ask("--field "+name, title)
+	if err != nil {
+		return "", err
+	}
+
+	return name + fieldOpSet + value, nil
+}
+
+// lookupField возвращает описание основного поля по имени или имени флага (например, month-year).
+func lookupField(spec model.TypeSpec, name string) (model.FieldSpec, bool) {
+	for _, field := range spec.Fields {
+		if field.Name == name || field.Flag() == name {
+			return field, true
+		}
+	}
+	return model.FieldSpec{}, false
+}
+
+// setCustomField добавляет или заменяет пользовательское поле в формате "[тип:]имя=значение".
+func setCustomField(d model.DataTypeable, s string) error {
+	field, err := model.ParseDataField(s)
+	if err != nil {
+		return err
+	}
+	return model.SetField(d, field)
+}
+
+// listValues возвращает непустые значения без окружающих пробелов.
+func listValues(values ...string) []string {
+	result := make([]string, 0, len(values))
+	for _, v := range values {
+		if v = strings.TrimSpace(v); v != "" {
+			result = append(result, v)
+		}
+	}
+	return result
+}
+
+// editList изменяет список значений: заменяет (=), дополняет (+=) или удаляет из него (-=) значения values.
+func editList(list []string, op string, values []string) []string {
+	switch op {
+	case fieldOpAppend:
+		for _, v := range values {
+			if !containsString(list, v) {
+				list = append(list, v)
+			}
+		}
+		return list
+	case fieldOpRemove:
+		kept := make([]string, 0, len(list))
+		for _, v := range list {
+			if !containsString(values, v) {
+				kept = append(kept, v)
+			}
+		}
+		return kept
+	}
+
+	return values
+}
+
+// containsString проверяет, есть ли строка в слайсе.
+func containsString(list []string, value string) bool {
+	for _, v := range list {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
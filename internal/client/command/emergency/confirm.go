@@ -0,0 +1,26 @@
+package emergency
+
+import (
+	"bufio"
+	"strings"
+
+	"github.com/spf13/cobra"
+)
+
+// confirm запрашивает у пользователя подтверждение действия.
+// Положительными считаются ответы "y", "yes", "д", "да" в любом регистре.
+func confirm(cmd *cobra.Command, question string) bool {
+	cmd.Printf("%s [y/N] > ", question)
+
+	answer, err := bufio.NewReader(cmd.InOrStdin()).ReadString('\n')
+	if err != nil && answer == "" {
+		return false
+	}
+
+	switch strings.ToLower(strings.TrimSpace(answer)) {
+	case "y", "yes", "д", "да":
+		return true
+	}
+
+	return false
+}
@@ -0,0 +1,97 @@
+package emergency
+
+import (
+	"github.com/casnerano/seckeep/internal/client/service/data/print"
+	"github.com/spf13/cobra"
+)
+
+// NewGrantsCmd конструктор команды вывода экстренных доступов, выданных пользователю.
+func NewGrantsCmd(emergencyService Service) *cobra.Command {
+	cmd := cobra.Command{
+		Use:   "grants",
+		Short: "Список выданных мне экстренных доступов",
+		Run: func(cmd *cobra.Command, args []string) {
+			accesses, err := emergencyService.Grants()
+			if err != nil {
+				printError(cmd, err)
+				return
+			}
+
+			if len(accesses) == 0 {
+				cmd.Println("Экстренные доступы не выдавались.")
+				return
+			}
+
+			for _, access := range accesses {
+				printAccess(cmd, access.OwnerLogin, access)
+			}
+		},
+	}
+
+	return &cmd
+}
+
+// NewRequestCmd конструктор команды запроса экстренного доступа к данным владельца.
+func NewRequestCmd(emergencyService Service) *cobra.Command {
+	var owner string
+
+	cmd := cobra.Command{
+		Use:   "request",
+		Short: "Запросить экстренный доступ",
+		Run: func(cmd *cobra.Command, args []string) {
+			access, err := emergencyService.Request(owner)
+			if err != nil {
+				printError(cmd, err)
+				return
+			}
+			printAccess(cmd, owner, access)
+		},
+	}
+
+	cmd.Flags().StringVarP(&owner, "owner", "o", "", "Логин владельца данных")
+
+	_ = cmd.MarkFlagRequired("owner")
+
+	return &cmd
+}
+
+// NewViewCmd конструктор команды просмотра данных владельца по открытому экстренному доступу.
+func NewViewCmd(emergencyService Service) *cobra.Command {
+	var (
+		owner  string
+		reveal bool
+	)
+
+	cmd := cobra.Command{
+		Use:   "view",
+		Short: "Просмотреть данные по открытому экстренному доступу",
+		Run: func(cmd *cobra.Command, args []string) {
+			data, err := emergencyService.Data(owner)
+			if err != nil {
+				printError(cmd, err)
+				return
+			}
+
+			if len(data) == 0 {
+				cmd.Println("Данные владельца отсутствуют.")
+				return
+			}
+
+			p := print.New(cmd.OutOrStdout())
+			p.SetReveal(reveal)
+			for index, dt := range data {
+				if index > 0 {
+					cmd.Println()
+				}
+				p.Content(dt)
+			}
+		},
+	}
+
+	cmd.Flags().StringVarP(&owner, "owner", "o", "", "Логин владельца данных")
+	cmd.Flags().BoolVar(&reveal, "reveal", false, "Показать скрытые значения (пользовательские поля, номер и CVV карты)")
+
+	_ = cmd.MarkFlagRequired("owner")
+
+	return &cmd
+}
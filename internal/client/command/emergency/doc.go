@@ -0,0 +1,2 @@
+// Package emergency содержит команды для управления экстренным доступом доверенных лиц.
+package emergency
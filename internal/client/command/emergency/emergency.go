@@ -0,0 +1,85 @@
+package emergency
+
+//go:generate mockgen -destination=mock/emergency.go -source=emergency.go
+
+import (
+	"errors"
+	"time"
+
+	"github.com/casnerano/seckeep/internal/client/model"
+	"github.com/casnerano/seckeep/internal/client/service/emergency"
+	vmodel "github.com/casnerano/seckeep/internal/server/model"
+	"github.com/spf13/cobra"
+)
+
+// timeLayout формат вывода времени открытия доступа.
+const timeLayout = "02.01.2006 15:04"
+
+// Service интерфейс управления экстренным доступом.
+type Service interface {
+	Fingerprint(login string) (string, error)
+	Grant(login, fingerprint string, waitPeriod time.Duration) (*vmodel.EmergencyAccess, error)
+	Contacts() ([]*vmodel.EmergencyAccess, error)
+	Revoke(login string) error
+	Deny(login string) error
+	Grants() ([]*vmodel.EmergencyAccess, error)
+	Request(login string) (*vmodel.EmergencyAccess, error)
+	Data(login string) ([]model.DataTypeable, error)
+}
+
+// NewCmd конструктор базовой команды управления экстренным доступом.
+// Содердит инициализацию дочерних команд.
+func NewCmd(emergencyService Service) *cobra.Command {
+	cmd := cobra.Command{
+		Use:   "emergency",
+		Short: "Экстренный доступ доверенных лиц",
+	}
+
+	cmd.AddCommand(NewGrantCmd(emergencyService))
+	cmd.AddCommand(NewContactsCmd(emergencyService))
+	cmd.AddCommand(NewRevokeCmd(emergencyService))
+	cmd.AddCommand(NewDenyCmd(emergencyService))
+	cmd.AddCommand(NewGrantsCmd(emergencyService))
+	cmd.AddCommand(NewRequestCmd(emergencyService))
+	cmd.AddCommand(NewViewCmd(emergencyService))
+
+	return &cmd
+}
+
+// printAccess выводит строку с состоянием доступа пользователя login.
+func printAccess(cmd *cobra.Command, login string, access *vmodel.EmergencyAccess) {
+	waitPeriod := time.Duration(access.WaitPeriod) * time.Second
+
+	switch access.Status {
+	case vmodel.EmergencyStatusRequested:
+		releaseAt := ""
+		if access.ReleaseAt != nil {
+			releaseAt = access.ReleaseAt.Local().Format(timeLayout)
+		}
+		cmd.Printf("%-20s запрошен, откроется %s\n", login, releaseAt)
+	case vmodel.EmergencyStatusReleased:
+		cmd.Printf("%-20s открыт\n", login)
+	default:
+		cmd.Printf("%-20s выдан, период ожидания %s\n", login, waitPeriod)
+	}
+}
+
+// printError выводит понятное пользователю описание ошибки.
+func printError(cmd *cobra.Command, err error) {
+	switch {
+	case errors.Is(err, emergency.ErrUnauthorized):
+		cmd.Println("Необходима авторизация.")
+	case errors.Is(err, emergency.ErrUserNotFound):
+		cmd.Println("Пользователь не найден или еще ни разу не авторизовался.")
+	case errors.Is(err, emergency.ErrFingerprintMismatch):
+		cmd.Println("Отпечаток ключей доверенного лица не совпадает с подтвержденным, доступ не выдан.")
+	case errors.Is(err, emergency.ErrNotFound):
+		cmd.Println("Экстренный доступ не найден.")
+	case errors.Is(err, emergency.ErrNotReleased):
+		cmd.Println("Период ожидания еще не истек.")
+	case errors.Is(err, emergency.ErrNotRequested):
+		cmd.Println("Доступ не запрашивался.")
+	default:
+		cmd.Println(err)
+	}
+}
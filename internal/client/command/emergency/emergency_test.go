@@ -0,0 +1,159 @@
+package emergency
+
+import (
+	"bytes"
+	"io"
+	"testing"
+	"time"
+
+	mock_emergency "github.com/casnerano/seckeep/internal/client/command/emergency/mock"
+	"github.com/casnerano/seckeep/internal/client/model"
+	"github.com/casnerano/seckeep/internal/client/service/emergency"
+	vmodel "github.com/casnerano/seckeep/internal/server/model"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/suite"
+)
+
+type EmergencyCmdTestSuite struct {
+	suite.Suite
+	emergencyService *mock_emergency.MockService
+}
+
+func (s *EmergencyCmdTestSuite) SetupSuite() {
+	ctrl := gomock.NewController(s.T())
+	defer ctrl.Finish()
+
+	s.emergencyService = mock_emergency.NewMockService(ctrl)
+}
+
+func (s *EmergencyCmdTestSuite) TestEmergencyCmd() {
+	cmd := NewCmd(s.emergencyService)
+	s.True(cmd.HasSubCommands())
+}
+
+func (s *EmergencyCmdTestSuite) TestGrant() {
+	fingerprint := "ABCD 0123"
+
+	s.Run("Fingerprint flag", func() {
+		cmd := NewGrantCmd(s.emergencyService)
+		cmdBuf := bytes.NewBufferString("")
+		cmd.SetOut(cmdBuf)
+
+		s.emergencyService.EXPECT().Grant("petr", fingerprint, 48*time.Hour).
+			Return(&vmodel.EmergencyAccess{ContactLogin: "petr", Status: vmodel.EmergencyStatusGranted}, nil)
+
+		cmd.SetArgs([]string{"--login", "petr", "--wait", "48h", "--fingerprint", fingerprint})
+		s.Require().NoError(cmd.Execute())
+
+		out, err := io.ReadAll(cmdBuf)
+		s.Require().NoError(err)
+
+		s.Contains(string(out), "Экстренный доступ выдан «petr»")
+	})
+
+	s.Run("Confirmed fingerprint", func() {
+		cmd := NewGrantCmd(s.emergencyService)
+		cmdBuf := bytes.NewBufferString("")
+		cmd.SetOut(cmdBuf)
+		cmd.SetIn(bytes.NewBufferString("да\n"))
+
+		s.emergencyService.EXPECT().Fingerprint("petr").Return(fingerprint, nil)
+		s.emergencyService.EXPECT().Grant("petr", fingerprint, defaultWaitPeriod).
+			Return(&vmodel.EmergencyAccess{ContactLogin: "petr", Status: vmodel.EmergencyStatusGranted}, nil)
+
+		cmd.SetArgs([]string{"--login", "petr"})
+		s.Require().NoError(cmd.Execute())
+
+		out, err := io.ReadAll(cmdBuf)
+		s.Require().NoError(err)
+
+		s.Contains(string(out), fingerprint)
+		s.Contains(string(out), "Экстренный доступ выдан «petr»")
+	})
+
+	s.Run("Rejected fingerprint", func() {
+		cmd := NewGrantCmd(s.emergencyService)
+		cmdBuf := bytes.NewBufferString("")
+		cmd.SetOut(cmdBuf)
+		cmd.SetIn(bytes.NewBufferString("n\n"))
+
+		s.emergencyService.EXPECT().Fingerprint("petr").Return(fingerprint, nil)
+
+		cmd.SetArgs([]string{"--login", "petr"})
+		s.Require().NoError(cmd.Execute())
+
+		out, err := io.ReadAll(cmdBuf)
+		s.Require().NoError(err)
+
+		s.Contains(string(out), "Экстренный доступ не выдан.")
+	})
+
+	s.Run("Fingerprint mismatch", func() {
+		cmd := NewGrantCmd(s.emergencyService)
+		cmdBuf := bytes.NewBufferString("")
+		cmd.SetOut(cmdBuf)
+
+		s.emergencyService.EXPECT().Grant("petr", "FFFF", defaultWaitPeriod).
+			Return(nil, emergency.ErrFingerprintMismatch)
+
+		cmd.SetArgs([]string{"--login", "petr", "--fingerprint", "FFFF"})
+		s.Require().NoError(cmd.Execute())
+
+		out, err := io.ReadAll(cmdBuf)
+		s.Require().NoError(err)
+
+		s.Contains(string(out), "Отпечаток ключей доверенного лица не совпадает")
+	})
+}
+
+func (s *EmergencyCmdTestSuite) TestRequest() {
+	cmd := NewRequestCmd(s.emergencyService)
+	cmdBuf := bytes.NewBufferString("")
+	cmd.SetOut(cmdBuf)
+
+	releaseAt := time.Now().Add(72 * time.Hour)
+	s.emergencyService.EXPECT().Request("ivan").
+		Return(&vmodel.EmergencyAccess{OwnerLogin: "ivan", Status: vmodel.EmergencyStatusRequested, ReleaseAt: &releaseAt}, nil)
+
+	cmd.SetArgs([]string{"--owner", "ivan"})
+	s.Require().NoError(cmd.Execute())
+
+	out, err := io.ReadAll(cmdBuf)
+	s.Require().NoError(err)
+
+	s.Contains(string(out), "запрошен, откроется "+releaseAt.Format(timeLayout))
+}
+
+func (s *EmergencyCmdTestSuite) TestView() {
+	cmd := NewViewCmd(s.emergencyService)
+	cmdBuf := bytes.NewBufferString("")
+	cmd.SetOut(cmdBuf)
+
+	s.Run("Released access", func() {
+		s.emergencyService.EXPECT().Data("ivan").Return([]model.DataTypeable{&model.DataText{Value: "last will"}}, nil)
+
+		cmd.SetArgs([]string{"--owner", "ivan"})
+		s.Require().NoError(cmd.Execute())
+
+		out, err := io.ReadAll(cmdBuf)
+		s.Require().NoError(err)
+
+		s.Contains(string(out), "last will")
+	})
+
+	s.Run("Waiting period", func() {
+		s.emergencyService.EXPECT().Data("ivan").Return(nil, emergency.ErrNotReleased)
+
+		cmd.SetArgs([]string{"--owner", "ivan"})
+		s.Require().NoError(cmd.Execute())
+
+		out, err := io.ReadAll(cmdBuf)
+		s.Require().NoError(err)
+
+		s.Contains(string(out), "Период ожидания еще не истек")
+	})
+}
+
+func TestEmergencyCmdTestSuite(t *testing.T) {
+	suite.Run(t, new(EmergencyCmdTestSuite))
+}
@@ -0,0 +1,155 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: emergency.go
+
+// Package mock_emergency is a generated GoMock package.
+package mock_emergency
+
+import (
+	reflect "reflect"
+	time "time"
+
+	model "github.com/casnerano/seckeep/internal/client/model"
+	model0 "github.com/casnerano/seckeep/internal/server/model"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockService is a mock of Service interface.
+type MockService struct {
+	ctrl     *gomock.Controller
+	recorder *MockServiceMockRecorder
+}
+
+// MockServiceMockRecorder is the mock recorder for MockService.
+type MockServiceMockRecorder struct {
+	mock *MockService
+}
+
+// NewMockService creates a new mock instance.
+func NewMockService(ctrl *gomock.Controller) *MockService {
+	mock := &MockService{ctrl: ctrl}
+	mock.recorder = &MockServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockService) EXPECT() *MockServiceMockRecorder {
+	return m.recorder
+}
+
+// Contacts mocks base method.
+func (m *MockService) Contacts() ([]*model0.EmergencyAccess, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Contacts")
+	ret0, _ := ret[0].([]*model0.EmergencyAccess)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Contacts indicates an expected call of Contacts.
+func (mr *MockServiceMockRecorder) Contacts() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Contacts", reflect.TypeOf((*MockService)(nil).Contacts))
+}
+
+// Data mocks base method.
+func (m *MockService) Data(login string) ([]model.DataTypeable, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Data", login)
+	ret0, _ := ret[0].([]model.DataTypeable)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Data indicates an expected call of Data.
+func (mr *MockServiceMockRecorder) Data(login interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Data", reflect.TypeOf((*MockService)(nil).Data), login)
+}
+
+// Deny mocks base method.
+func (m *MockService) Deny(login string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Deny", login)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Deny indicates an expected call of Deny.
+func (mr *MockServiceMockRecorder) Deny(login interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Deny", reflect.TypeOf((*MockService)(nil).Deny), login)
+}
+
+// Fingerprint mocks base method.
+func (m *MockService) Fingerprint(login string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Fingerprint", login)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Fingerprint indicates an expected call of Fingerprint.
+func (mr *MockServiceMockRecorder) Fingerprint(login interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Fingerprint", reflect.TypeOf((*MockService)(nil).Fingerprint), login)
+}
+
+// Grant mocks base method.
+func (m *MockService) Grant(login, fingerprint string, waitPeriod time.Duration) (*model0.EmergencyAccess, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Grant", login, fingerprint, waitPeriod)
+	ret0, _ := ret[0].(*model0.EmergencyAccess)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Grant indicates an expected call of Grant.
+func (mr *MockServiceMockRecorder) Grant(login, fingerprint, waitPeriod interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Grant", reflect.TypeOf((*MockService)(nil).Grant), login, fingerprint, waitPeriod)
+}
+
+// Grants mocks base method.
+func (m *MockService) Grants() ([]*model0.EmergencyAccess, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Grants")
+	ret0, _ := ret[0].([]*model0.EmergencyAccess)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Grants indicates an expected call of Grants.
+func (mr *MockServiceMockRecorder) Grants() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Grants", reflect.TypeOf((*MockService)(nil).Grants))
+}
+
+// Request mocks base method.
+func (m *MockService) Request(login string) (*model0.EmergencyAccess, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Request", login)
+	ret0, _ := ret[0].(*model0.EmergencyAccess)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Request indicates an expected call of Request.
+func (mr *MockServiceMockRecorder) Request(login interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Request", reflect.TypeOf((*MockService)(nil).Request), login)
+}
+
+// Revoke mocks base method.
+func (m *MockService) Revoke(login string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Revoke", login)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Revoke indicates an expected call of Revoke.
+func (mr *MockServiceMockRecorder) Revoke(login interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Revoke", reflect.TypeOf((*MockService)(nil).Revoke), login)
+}
@@ -0,0 +1,128 @@
+package emergency
+
+import (
+	"time"
+
+	"github.com/spf13/cobra"
+)
+
+// defaultWaitPeriod период ожидания по умолчанию.
+const defaultWaitPeriod = 72 * time.Hour
+
+// NewGrantCmd конструктор команды выдачи экстренного доступа доверенному лицу.
+// Ключ данных шифруется открытым ключом доверенного лица,
+// поэтому доверенное лицо должно хотя бы раз авторизоваться в клиенте.
+// Перед шифрованием отпечаток ключей доверенного лица подтверждается пользователем
+// или передается флагом --fingerprint.
+func NewGrantCmd(emergencyService Service) *cobra.Command {
+	var login, fingerprint string
+	var wait time.Duration
+
+	cmd := cobra.Command{
+		Use:   "grant",
+		Short: "Выдать экстренный доступ доверенному лицу",
+		Run: func(cmd *cobra.Command, args []string) {
+			if fingerprint == "" {
+				var err error
+				if fingerprint, err = emergencyService.Fingerprint(login); err != nil {
+					printError(cmd, err)
+					return
+				}
+
+				cmd.Printf("Отпечаток ключей пользователя «%s»:\n%s\n", login, fingerprint)
+				cmd.Println("Сверьте его с отпечатком, который покажет доверенному лицу команда «account fingerprint».")
+				if !confirm(cmd, "Отпечаток совпадает?") {
+					cmd.Println("Экстренный доступ не выдан.")
+					return
+				}
+			}
+
+			access, err := emergencyService.Grant(login, fingerprint, wait)
+			if err != nil {
+				printError(cmd, err)
+				return
+			}
+			cmd.Printf("Экстренный доступ выдан «%s», период ожидания %s.\n", access.ContactLogin, wait)
+		},
+	}
+
+	cmd.Flags().StringVarP(&login, "login", "l", "", "Логин доверенного лица")
+	cmd.Flags().DurationVarP(&wait, "wait", "w", defaultWaitPeriod, "Период ожидания, в течение которого можно отклонить запрос")
+	cmd.Flags().StringVar(&fingerprint, "fingerprint", "", "Сверенный отпечаток ключей доверенного лица (по умолчанию — запросить подтверждение)")
+
+	_ = cmd.MarkFlagRequired("login")
+
+	return &cmd
+}
+
+// NewContactsCmd конструктор команды вывода доверенных лиц.
+func NewContactsCmd(emergencyService Service) *cobra.Command {
+	cmd := cobra.Command{
+		Use:   "contacts",
+		Short: "Список доверенных лиц",
+		Run: func(cmd *cobra.Command, args []string) {
+			accesses, err := emergencyService.Contacts()
+			if err != nil {
+				printError(cmd, err)
+				return
+			}
+
+			if len(accesses) == 0 {
+				cmd.Println("Список доверенных лиц пуст.")
+				return
+			}
+
+			for _, access := range accesses {
+				printAccess(cmd, access.ContactLogin, access)
+			}
+		},
+	}
+
+	return &cmd
+}
+
+// NewRevokeCmd конструктор команды отзыва экстренного доступа.
+func NewRevokeCmd(emergencyService Service) *cobra.Command {
+	var login string
+
+	cmd := cobra.Command{
+		Use:   "revoke",
+		Short: "Отозвать экстренный доступ",
+		Run: func(cmd *cobra.Command, args []string) {
+			if err := emergencyService.Revoke(login); err != nil {
+				printError(cmd, err)
+				return
+			}
+			cmd.Printf("Экстренный доступ «%s» отозван.\n", login)
+		},
+	}
+
+	cmd.Flags().StringVarP(&login, "login", "l", "", "Логин доверенного лица")
+
+	_ = cmd.MarkFlagRequired("login")
+
+	return &cmd
+}
+
+// NewDenyCmd конструктор команды отклонения запроса экстренного доступа.
+func NewDenyCmd(emergencyService Service) *cobra.Command {
+	var login string
+
+	cmd := cobra.Command{
+		Use:   "deny",
+		Short: "Отклонить запрос экстренного доступа",
+		Run: func(cmd *cobra.Command, args []string) {
+			if err := emergencyService.Deny(login); err != nil {
+				printError(cmd, err)
+				return
+			}
+			cmd.Printf("Запрос экстренного доступа «%s» отклонен.\n", login)
+		},
+	}
+
+	cmd.Flags().StringVarP(&login, "login", "l", "", "Логин доверенного лица")
+
+	_ = cmd.MarkFlagRequired("login")
+
+	return &cmd
+}
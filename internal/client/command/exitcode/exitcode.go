@@ -0,0 +1,20 @@
+// Package exitcode содержит ошибку, с которой команда завершает клиент с заданным кодом.
+package exitcode
+
+import "fmt"
+
+// Error ошибка завершения клиента с кодом Code.
+// Команда сама выводит результат, поэтому сообщение об ошибке не печатается.
+type Error struct {
+	Code int
+}
+
+// New конструктор.
+func New(code int) *Error {
+	return &Error{Code: code}
+}
+
+// Error возвращает описание ошибки.
+func (e *Error) Error() string {
+	return fmt.Sprintf("exit code %d", e.Code)
+}
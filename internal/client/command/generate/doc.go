@@ -0,0 +1,2 @@
+// Package generate содержит команды генерации паролей и парольных фраз.
+package generate
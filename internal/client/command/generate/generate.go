@@ -0,0 +1,86 @@
+package generate
+
+import (
+	"errors"
+
+	"github.com/casnerano/seckeep/pkg/generator"
+	"github.com/spf13/cobra"
+)
+
+// NewCmd конструктор базовой команды генерации секретов.
+// Содердит инициализацию дочерних команд. Генерация работает без соединения с сервером.
+func NewCmd() *cobra.Command {
+	cmd := cobra.Command{
+		Use:              "generate",
+		Short:            "Генерация паролей и парольных фраз",
+		PersistentPreRun: func(cmd *cobra.Command, args []string) {},
+	}
+
+	cmd.AddCommand(NewPasswordCmd())
+	cmd.AddCommand(NewPassphraseCmd())
+
+	return &cmd
+}
+
+// NewPasswordCmd конструктор команды генерации пароля.
+func NewPasswordCmd() *cobra.Command {
+	opts := NewOptions()
+
+	cmd := cobra.Command{
+		Use:   "password",
+		Short: "Пароль",
+		Run: func(cmd *cobra.Command, args []string) {
+			password, err := opts.Generate(KindPassword)
+			if err != nil {
+				cmd.Println(Message(err))
+				return
+			}
+
+			cmd.Println(password)
+			cmd.Printf("Энтропия: %.0f бит.\n", generator.PasswordEntropy(opts.PasswordOptions()))
+		},
+	}
+
+	opts.RegisterPasswordFlags(cmd.Flags())
+
+	return &cmd
+}
+
+// NewPassphraseCmd конструктор команды генерации парольной фразы.
+func NewPassphraseCmd() *cobra.Command {
+	opts := NewOptions()
+
+	cmd := cobra.Command{
+		Use:   "passphrase",
+		Short: "Парольная фраза (diceware)",
+		Run: func(cmd *cobra.Command, args []string) {
+			passphrase, err := opts.Generate(KindPassphrase)
+			if err != nil {
+				cmd.Println(Message(err))
+				return
+			}
+
+			cmd.Println(passphrase)
+			cmd.Printf("Энтропия: %.0f бит.\n", generator.PassphraseEntropy(opts.Passphrase))
+		},
+	}
+
+	opts.RegisterPassphraseFlags(cmd.Flags())
+
+	return &cmd
+}
+
+// Message возвращает сообщение об ошибке генерации для пользователя.
+func Message(err error) string {
+	switch {
+	case errors.Is(err, generator.ErrNoCharClasses):
+		return "Не выбран ни один класс символов."
+	case errors.Is(err, generator.ErrInvalidLength):
+		return "Недопустимая длина пароля."
+	case errors.Is(err, generator.ErrInvalidWords):
+		return "Недопустимое кол-во слов."
+	case errors.Is(err, ErrUnknownKind):
+		return "Неизвестный вид секрета, ожидается password или passphrase."
+	}
+	return err.Error()
+}
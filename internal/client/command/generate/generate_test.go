@@ -0,0 +1,71 @@
+package generate
+
+import (
+	"bytes"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type GenerateTestSuite struct {
+	suite.Suite
+}
+
+func (s *GenerateTestSuite) TestGenerateCmd() {
+	cmd := NewCmd()
+	s.True(cmd.HasSubCommands())
+}
+
+func (s *GenerateTestSuite) TestPassword() {
+	cmd := NewPasswordCmd()
+	cmdBuf := bytes.NewBufferString("")
+	cmd.SetOut(cmdBuf)
+
+	cmd.SetArgs([]string{"--length", "16", "--no-symbols", "--no-upper", "--no-lower"})
+	s.Require().NoError(cmd.Execute())
+
+	out, err := io.ReadAll(cmdBuf)
+	s.Require().NoError(err)
+
+	lines := strings.Split(strings.TrimSpace(string(out)), "\n")
+	s.Require().Len(lines, 2)
+	s.Regexp(`^[0-9]{16}$`, lines[0])
+	s.Equal("Энтропия: 53 бит.", lines[1])
+}
+
+func (s *GenerateTestSuite) TestPassphrase() {
+	cmd := NewPassphraseCmd()
+	cmdBuf := bytes.NewBufferString("")
+	cmd.SetOut(cmdBuf)
+
+	cmd.SetArgs([]string{"--words", "5", "--separator", " ", "--capitalize"})
+	s.Require().NoError(cmd.Execute())
+
+	out, err := io.ReadAll(cmdBuf)
+	s.Require().NoError(err)
+
+	lines := strings.Split(strings.TrimSpace(string(out)), "\n")
+	s.Require().Len(lines, 2)
+	s.Regexp(`^([A-Z][a-z]+ ){4}[A-Z][a-z]+$`, lines[0])
+	s.Equal("Энтропия: 55 бит.", lines[1])
+}
+
+func (s *GenerateTestSuite) TestInvalidOptions() {
+	cmd := NewPassphraseCmd()
+	cmdBuf := bytes.NewBufferString("")
+	cmd.SetOut(cmdBuf)
+
+	cmd.SetArgs([]string{"--words", "0"})
+	s.Require().NoError(cmd.Execute())
+
+	out, err := io.ReadAll(cmdBuf)
+	s.Require().NoError(err)
+
+	s.Contains(string(out), "Недопустимое кол-во слов")
+}
+
+func TestGenerateTestSuite(t *testing.T) {
+	suite.Run(t, new(GenerateTestSuite))
+}
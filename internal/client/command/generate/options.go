@@ -0,0 +1,72 @@
+package generate
+
+import (
+	"errors"
+
+	"github.com/casnerano/seckeep/pkg/generator"
+	"github.com/spf13/pflag"
+)
+
+// Виды генерируемых секретов.
+const (
+	KindPassword   = "password"
+	KindPassphrase = "passphrase"
+)
+
+// ErrUnknownKind неизвестный вид генерируемого секрета.
+var ErrUnknownKind = errors.New("unknown kind, expected password or passphrase")
+
+// Options параметры генерации пароля или парольной фразы, задаваемые флагами.
+type Options struct {
+	Password   generator.PasswordOptions
+	Passphrase generator.PassphraseOptions
+
+	noLower, noUpper, noDigits, noSymbols bool
+}
+
+// NewOptions конструктор параметров генерации со значениями по умолчанию.
+func NewOptions() *Options {
+	return &Options{
+		Password:   generator.DefaultPasswordOptions(),
+		Passphrase: generator.DefaultPassphraseOptions(),
+	}
+}
+
+// RegisterPasswordFlags регистрирует флаги параметров пароля.
+func (o *Options) RegisterPasswordFlags(flagSet *pflag.FlagSet) {
+	flagSet.IntVar(&o.Password.Length, "length", o.Password.Length, "Длина пароля")
+	flagSet.BoolVar(&o.noLower, "no-lower", false, "Без строчных букв")
+	flagSet.BoolVar(&o.noUpper, "no-upper", false, "Без заглавных букв")
+	flagSet.BoolVar(&o.noDigits, "no-digits", false, "Без цифр")
+	flagSet.BoolVar(&o.noSymbols, "no-symbols", false, "Без спецсимволов")
+	flagSet.BoolVar(&o.Password.ExcludeAmbiguous, "exclude-ambiguous", false, "Исключить похожие символы (I, l, 1, O, 0, o)")
+	flagSet.BoolVar(&o.Password.Required, "require-each", o.Password.Required, "Хотя бы по одному символу каждого класса")
+}
+
+// RegisterPassphraseFlags регистрирует флаги параметров парольной фразы.
+func (o *Options) RegisterPassphraseFlags(flagSet *pflag.FlagSet) {
+	flagSet.IntVar(&o.Passphrase.Words, "words", o.Passphrase.Words, "Кол-во слов")
+	flagSet.StringVar(&o.Passphrase.Separator, "separator", o.Passphrase.Separator, "Разделитель слов")
+	flagSet.BoolVar(&o.Passphrase.Capitalize, "capitalize", false, "Слова с заглавной буквы")
+}
+
+// PasswordOptions возвращает параметры пароля с учетом флагов исключения классов символов.
+func (o *Options) PasswordOptions() generator.PasswordOptions {
+	opts := o.Password
+	opts.Lower = opts.Lower && !o.noLower
+	opts.Upper = opts.Upper && !o.noUpper
+	opts.Digits = opts.Digits && !o.noDigits
+	opts.Symbols = opts.Symbols && !o.noSymbols
+	return opts
+}
+
+// Generate генерирует пароль или парольную фразу в зависимости от вида.
+func (o *Options) Generate(kind string) (string, error) {
+	switch kind {
+	case KindPassword:
+		return generator.Password(o.PasswordOptions())
+	case KindPassphrase:
+		return generator.Passphrase(o.Passphrase)
+	}
+	return "", ErrUnknownKind
+}
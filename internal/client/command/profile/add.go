@@ -0,0 +1,53 @@
+package profile
+
+import (
+	"errors"
+
+	"github.com/casnerano/seckeep/internal/client/command/prompt"
+	"github.com/casnerano/seckeep/internal/client/config"
+	"github.com/spf13/cobra"
+)
+
+// NewAddCmd конструктор команды добавления профиля.
+// Если ключ шифрования не указан, генерируется случайный, если равен «-» — вводится без отображения.
+func NewAddCmd(profileService Service) *cobra.Command {
+	var serverURL, storeFile, tokenFile string
+
+	cmd := cobra.Command{
+		Use:   "add NAME",
+		Short: "Добавить профиль",
+		Args:  cobra.ExactArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			secret, err := prompt.NewSecrets(cmd).Value("secret", "Ключ шифрования", false)
+			if err != nil {
+				cmd.Println(prompt.Message(err))
+				return
+			}
+
+			profile, err := config.NewProfile(serverURL, secret)
+			if err != nil {
+				cmd.Println(err)
+				return
+			}
+			profile.StoreFile = storeFile
+			profile.TokenFile = tokenFile
+
+			if err = profileService.Add(args[0], profile); err != nil {
+				if errors.Is(err, config.ErrProfileExists) {
+					cmd.Printf("Профиль «%s» уже существует.\n", args[0])
+					return
+				}
+				cmd.Println(err)
+				return
+			}
+			cmd.Printf("Профиль «%s» добавлен.\n", args[0])
+		},
+	}
+
+	cmd.Flags().StringVarP(&serverURL, "server", "s", config.DefaultServerURL, "Адрес сервера")
+	cmd.Flags().String("secret", "", "Ключ шифрования (по умолчанию — случайный, «-» — ввести без отображения)")
+	cmd.Flags().StringVar(&storeFile, "store-file", "", "Путь к файлу локального хранилища")
+	cmd.Flags().StringVar(&tokenFile, "token-file", "", "Путь к файлу токена")
+
+	return &cmd
+}
@@ -0,0 +1,2 @@
+// Package profile содержит команды для управления профилями клиента.
+package profile
@@ -0,0 +1,26 @@
+package profile
+
+import (
+	"github.com/spf13/cobra"
+)
+
+// NewListCmd конструктор команды вывода списка профилей.
+// Текущий профиль отмечается звездочкой.
+func NewListCmd(profileService Service) *cobra.Command {
+	cmd := cobra.Command{
+		Use:   "list",
+		Short: "Список профилей",
+		Run: func(cmd *cobra.Command, args []string) {
+			current := profileService.CurrentProfile()
+			for _, name := range profileService.ProfileNames() {
+				if name == current {
+					cmd.Println("*", name)
+					continue
+				}
+				cmd.Println(" ", name)
+			}
+		},
+	}
+
+	return &cmd
+}
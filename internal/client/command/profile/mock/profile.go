@@ -0,0 +1,91 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: profile.go
+
+// Package mock_profile is a generated GoMock package.
+package mock_profile
+
+import (
+	reflect "reflect"
+
+	config "github.com/casnerano/seckeep/internal/client/config"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockService is a mock of Service interface.
+type MockService struct {
+	ctrl     *gomock.Controller
+	recorder *MockServiceMockRecorder
+}
+
+// MockServiceMockRecorder is the mock recorder for MockService.
+type MockServiceMockRecorder struct {
+	mock *MockService
+}
+
+// NewMockService creates a new mock instance.
+func NewMockService(ctrl *gomock.Controller) *MockService {
+	mock := &MockService{ctrl: ctrl}
+	mock.recorder = &MockServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockService) EXPECT() *MockServiceMockRecorder {
+	return m.recorder
+}
+
+// Add mocks base method.
+func (m *MockService) Add(name string, profile *config.Profile) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Add", name, profile)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Add indicates an expected call of Add.
+func (mr *MockServiceMockRecorder) Add(name, profile interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Add", reflect.TypeOf((*MockService)(nil).Add), name, profile)
+}
+
+// CurrentProfile mocks base method.
+func (m *MockService) CurrentProfile() string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CurrentProfile")
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// CurrentProfile indicates an expected call of CurrentProfile.
+func (mr *MockServiceMockRecorder) CurrentProfile() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CurrentProfile", reflect.TypeOf((*MockService)(nil).CurrentProfile))
+}
+
+// ProfileNames mocks base method.
+func (m *MockService) ProfileNames() []string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ProfileNames")
+	ret0, _ := ret[0].([]string)
+	return ret0
+}
+
+// ProfileNames indicates an expected call of ProfileNames.
+func (mr *MockServiceMockRecorder) ProfileNames() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ProfileNames", reflect.TypeOf((*MockService)(nil).ProfileNames))
+}
+
+// Use mocks base method.
+func (m *MockService) Use(name string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Use", name)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Use indicates an expected call of Use.
+func (mr *MockServiceMockRecorder) Use(name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Use", reflect.TypeOf((*MockService)(nil).Use), name)
+}
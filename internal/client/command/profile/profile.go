@@ -0,0 +1,32 @@
+package profile
+
+//go:generate mockgen -destination=mock/profile.go -source=profile.go
+
+import (
+	"github.com/casnerano/seckeep/internal/client/config"
+	"github.com/spf13/cobra"
+)
+
+// Service интерфейс управления профилями.
+type Service interface {
+	CurrentProfile() string
+	ProfileNames() []string
+	Use(name string) error
+	Add(name string, profile *config.Profile) error
+}
+
+// NewCmd конструктор базовой команды управления профилями.
+// Содердит инициализацию дочерних команд.
+func NewCmd(profileService Service) *cobra.Command {
+	cmd := cobra.Command{
+		Use:              "profile",
+		Short:            "Управление профилями",
+		PersistentPreRun: func(cmd *cobra.Command, args []string) {},
+	}
+
+	cmd.AddCommand(NewListCmd(profileService))
+	cmd.AddCommand(NewUseCmd(profileService))
+	cmd.AddCommand(NewAddCmd(profileService))
+
+	return &cmd
+}
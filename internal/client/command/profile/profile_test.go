@@ -0,0 +1,135 @@
+package profile
+
+import (
+	"bytes"
+	"io"
+	"strings"
+	"testing"
+
+	mock_profile "github.com/casnerano/seckeep/internal/client/command/profile/mock"
+	"github.com/casnerano/seckeep/internal/client/config"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/suite"
+)
+
+type ProfileTestSuite struct {
+	suite.Suite
+	profileService *mock_profile.MockService
+}
+
+func (s *ProfileTestSuite) SetupSuite() {
+	ctrl := gomock.NewController(s.T())
+	defer ctrl.Finish()
+
+	s.profileService = mock_profile.NewMockService(ctrl)
+}
+
+func (s *ProfileTestSuite) TestProfileCmd() {
+	cmd := NewCmd(s.profileService)
+	s.True(cmd.HasSubCommands())
+}
+
+func (s *ProfileTestSuite) TestList() {
+	cmd := NewListCmd(s.profileService)
+	cmdBuf := bytes.NewBufferString("")
+	cmd.SetOut(cmdBuf)
+
+	s.profileService.EXPECT().CurrentProfile().Return("work")
+	s.profileService.EXPECT().ProfileNames().Return([]string{"default", "work"})
+
+	cmd.SetArgs([]string{})
+	s.Require().NoError(cmd.Execute())
+
+	out, err := io.ReadAll(cmdBuf)
+	s.Require().NoError(err)
+
+	s.Equal("  default\n* work\n", string(out))
+}
+
+func (s *ProfileTestSuite) TestUse() {
+	cmd := NewUseCmd(s.profileService)
+	cmdBuf := bytes.NewBufferString("")
+	cmd.SetOut(cmdBuf)
+
+	s.Run("Success", func() {
+		s.profileService.EXPECT().Use("work").Return(nil)
+
+		cmd.SetArgs([]string{"work"})
+		s.Require().NoError(cmd.Execute())
+
+		out, err := io.ReadAll(cmdBuf)
+		s.Require().NoError(err)
+
+		s.Contains(string(out), "Текущий профиль — «work»")
+	})
+
+	s.Run("Not found", func() {
+		s.profileService.EXPECT().Use("home").Return(config.ErrProfileNotFound)
+
+		cmd.SetArgs([]string{"home"})
+		s.Require().NoError(cmd.Execute())
+
+		out, err := io.ReadAll(cmdBuf)
+		s.Require().NoError(err)
+
+		s.Contains(string(out), "Профиль «home» не найден")
+	})
+}
+
+func (s *ProfileTestSuite) TestAdd() {
+	cmd := NewAddCmd(s.profileService)
+	cmdBuf := bytes.NewBufferString("")
+	cmd.SetOut(cmdBuf)
+
+	s.Run("Success", func() {
+		s.profileService.EXPECT().Add("work", gomock.Any()).DoAndReturn(func(name string, profile *config.Profile) error {
+			s.Equal("https://vault.example.com", profile.Server.URL)
+			s.Equal("example secret", profile.Encryptor.Secret)
+			return nil
+		})
+
+		cmd.SetArgs([]string{"work", "-s", "https://vault.example.com", "--secret", "example secret"})
+		s.Require().NoError(cmd.Execute())
+
+		out, err := io.ReadAll(cmdBuf)
+		s.Require().NoError(err)
+
+		s.Contains(string(out), "Профиль «work» добавлен")
+	})
+
+	s.Run("Already exists", func() {
+		s.profileService.EXPECT().Add("work", gomock.Any()).Return(config.ErrProfileExists)
+
+		cmd.SetArgs([]string{"work"})
+		s.Require().NoError(cmd.Execute())
+
+		out, err := io.ReadAll(cmdBuf)
+		s.Require().NoError(err)
+
+		s.Contains(string(out), "Профиль «work» уже существует")
+	})
+
+	s.Run("Secret from stdin", func() {
+		cmd := NewAddCmd(s.profileService)
+		cmdBuf := bytes.NewBufferString("")
+		cmd.SetOut(cmdBuf)
+		cmd.SetIn(strings.NewReader("stdin secret\n"))
+
+		s.profileService.EXPECT().Add("home", gomock.Any()).DoAndReturn(func(name string, profile *config.Profile) error {
+			s.Equal("stdin secret", profile.Encryptor.Secret)
+			return nil
+		})
+
+		cmd.SetArgs([]string{"home", "--secret", "-"})
+		s.Require().NoError(cmd.Execute())
+
+		out, err := io.ReadAll(cmdBuf)
+		s.Require().NoError(err)
+
+		s.Contains(string(out), "Профиль «home» добавлен")
+	})
+}
+
+func TestProfileTestSuite(t *testing.T) {
+	suite.Run(t, new(ProfileTestSuite))
+}
@@ -0,0 +1,30 @@
+package profile
+
+import (
+	"errors"
+
+	"github.com/casnerano/seckeep/internal/client/config"
+	"github.com/spf13/cobra"
+)
+
+// NewUseCmd конструктор команды выбора текущего профиля.
+func NewUseCmd(profileService Service) *cobra.Command {
+	cmd := cobra.Command{
+		Use:   "use NAME",
+		Short: "Сделать профиль текущим",
+		Args:  cobra.ExactArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			if err := profileService.Use(args[0]); err != nil {
+				if errors.Is(err, config.ErrProfileNotFound) {
+					cmd.Printf("Профиль «%s» не найден.\n", args[0])
+					return
+				}
+				cmd.Println(err)
+				return
+			}
+			cmd.Printf("Текущий профиль — «%s».\n", args[0])
+		},
+	}
+
+	return &cmd
+}
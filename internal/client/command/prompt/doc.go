@@ -0,0 +1,5 @@
+// Package prompt содержит построчный ввод значений в интерактивных командах.
+// Для терминала используется редактор строки (стрелки, удаление слов, история ввода),
+// секретные значения вводятся без отображения. Если ввод не терминал (скрипт, канал),
+// значения читаются построчно.
+package prompt
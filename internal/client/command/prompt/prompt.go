@@ -0,0 +1,105 @@
+package prompt
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"golang.org/x/term"
+)
+
+// Prompter структура построчного ввода.
+type Prompter struct {
+	in       io.Reader
+	out      io.Writer
+	fd       int
+	terminal *term.Terminal
+	reader   *bufio.Reader
+}
+
+// New конструктор.
+// Редактор строки и скрытый ввод включаются, только если in — терминал.
+func New(in io.Reader, out io.Writer) *Prompter {
+	p := Prompter{in: in, out: out, fd: -1}
+
+	if file, ok := in.(*os.File); ok && term.IsTerminal(int(file.Fd())) {
+		p.fd = int(file.Fd())
+		p.terminal = term.NewTerminal(struct {
+			io.Reader
+			io.Writer
+		}{in, out}, "")
+	} else {
+		p.reader = bufio.NewReader(in)
+	}
+
+	return &p
+}
+
+// IsTerminal метод проверяет, что ввод выполняется с терминала.
+func (p *Prompter) IsTerminal() bool {
+	return p.terminal != nil
+}
+
+// Line метод выводит приглашение prompt и возвращает введенную строку без завершающего перевода строки.
+// Если ввод закончился до ввода строки, возвращает io.EOF.
+func (p *Prompter) Line(prompt string) (string, error) {
+	if p.terminal == nil {
+		fmt.Fprint(p.out, prompt)
+		return p.readLine()
+	}
+
+	state, err := term.MakeRaw(p.fd)
+	if err != nil {
+		return "", err
+	}
+	defer func() { _ = term.Restore(p.fd, state) }()
+
+	p.terminal.SetPrompt(prompt)
+	return p.terminal.ReadLine()
+}
+
+// Secret метод выводит приглашение prompt и возвращает введенное значение, не отображая его на экране.
+// Если ввод не терминал, значение читается как обычная строка.
+func (p *Prompter) Secret(prompt string) (string, error) {
+	fmt.Fprint(p.out, prompt)
+
+	if p.terminal == nil {
+		return p.readLine()
+	}
+
+	value, err := term.ReadPassword(p.fd)
+	fmt.Fprintln(p.out)
+	if err != nil {
+		return "", err
+	}
+
+	return string(value), nil
+}
+
+// All метод возвращает весь оставшийся ввод, на терминале — до сочетания Ctrl+D.
+func (p *Prompter) All() (string, error) {
+	var (
+		content []byte
+		err     error
+	)
+
+	if p.terminal == nil {
+		content, err = io.ReadAll(p.reader)
+	} else {
+		content, err = io.ReadAll(p.in)
+	}
+
+	return string(content), err
+}
+
+// readLine читает строку из неинтерактивного ввода.
+func (p *Prompter) readLine() (string, error) {
+	line, err := p.reader.ReadString('\n')
+	if err != nil && !(errors.Is(err, io.EOF) && line != "") {
+		return "", err
+	}
+	return strings.TrimRight(line, "\r\n"), nil
+}
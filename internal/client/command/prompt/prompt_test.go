@@ -0,0 +1,105 @@
+package prompt
+
+import (
+	"bytes"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/suite"
+)
+
+type PromptTestSuite struct {
+	suite.Suite
+}
+
+func (s *PromptTestSuite) TestNotTerminal() {
+	out := bytes.NewBufferString("")
+	p := New(strings.NewReader("Иван Иванов\r\nsecret with spaces\nlast"), out)
+	s.False(p.IsTerminal())
+
+	value, err := p.Line("Держатель > ")
+	s.Require().NoError(err)
+	s.Equal("Иван Иванов", value)
+
+	value, err = p.Secret("Пароль > ")
+	s.Require().NoError(err)
+	s.Equal("secret with spaces", value)
+
+	value, err = p.Line("Заметка > ")
+	s.Require().NoError(err)
+	s.Equal("last", value)
+
+	_, err = p.Line("Заметка > ")
+	s.ErrorIs(err, io.EOF)
+
+	s.Equal("Держатель > Пароль > Заметка > Заметка > ", out.String())
+}
+
+func (s *PromptTestSuite) TestSecrets() {
+	newCmd := func(in string, args ...string) (*cobra.Command, *bytes.Buffer) {
+		cmd := &cobra.Command{Run: func(cmd *cobra.Command, args []string) {}}
+		cmd.Flags().String("password", "", "")
+		cmd.Flags().String("key", "", "")
+		cmd.Flags().String("passphrase", "default", "")
+
+		errBuf := bytes.NewBufferString("")
+		cmd.SetIn(strings.NewReader(in))
+		cmd.SetOut(bytes.NewBufferString(""))
+		cmd.SetErr(errBuf)
+		cmd.SetArgs(args)
+		s.Require().NoError(cmd.Execute())
+
+		return cmd, errBuf
+	}
+
+	s.Run("From stdin", func() {
+		cmd, errBuf := newCmd("secret value\n-----BEGIN KEY-----\nline\n-----END KEY-----\n", "--password", "-", "--key=-")
+		secrets := NewSecrets(cmd)
+
+		value, err := secrets.Value("password", "Пароль", false)
+		s.Require().NoError(err)
+		s.Equal("secret value", value)
+
+		value, err = secrets.MultilineValue("key", "Ключ", false)
+		s.Require().NoError(err)
+		s.Equal("-----BEGIN KEY-----\nline\n-----END KEY-----", value)
+
+		value, err = secrets.Value("passphrase", "Парольная фраза", false)
+		s.Require().NoError(err)
+		s.Equal("default", value)
+
+		s.Empty(errBuf.String())
+	})
+
+	s.Run("Omitted required value", func() {
+		cmd, _ := newCmd("1234\n")
+
+		value, err := NewSecrets(cmd).Value("password", "Пароль", true)
+		s.Require().NoError(err)
+		s.Equal("1234", value)
+	})
+
+	s.Run("Command line warning", func() {
+		cmd, errBuf := newCmd("", "--password", "1234")
+
+		value, err := NewSecrets(cmd).Value("password", "Пароль", true)
+		s.Require().NoError(err)
+		s.Equal("1234", value)
+		s.Contains(errBuf.String(), "значение --password передано в командной строке")
+	})
+
+	s.Run("No input", func() {
+		cmd, _ := newCmd("", "--password", "-")
+
+		_, err := NewSecrets(cmd).Value("password", "Пароль", false)
+		var noInputErr *NoInputError
+		s.Require().ErrorAs(err, &noInputErr)
+		s.Equal("Значение --password не задано.", Message(err))
+	})
+}
+
+func TestPromptTestSuite(t *testing.T) {
+	suite.Run(t, new(PromptTestSuite))
+}
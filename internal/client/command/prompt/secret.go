@@ -0,0 +1,134 @@
+package prompt
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/spf13/cobra"
+)
+
+// Stdin значение секретного флага, при котором значение вводится без отображения или читается из стандартного ввода.
+const Stdin = "-"
+
+// NoInputError ошибка: секретное значение Name не введено.
+type NoInputError struct {
+	Name string
+}
+
+// Error возвращает описание ошибки.
+func (e *NoInputError) Error() string {
+	return fmt.Sprintf("secret value %s is not provided", e.Name)
+}
+
+// Message возвращает текст ошибки ввода секретного значения для пользователя.
+func Message(err error) string {
+	var noInputErr *NoInputError
+	if errors.As(err, &noInputErr) {
+		return fmt.Sprintf("Значение %s не задано.", noInputErr.Name)
+	}
+	return err.Error()
+}
+
+// Secrets структура чтения секретных значений флагов команды.
+// Значение, переданное в командной строке, сохраняется в истории команд и видно в списке процессов,
+// поэтому для него выводится предупреждение.
+type Secrets struct {
+	cmd      *cobra.Command
+	prompter *Prompter
+}
+
+// NewSecrets конструктор.
+func NewSecrets(cmd *cobra.Command) *Secrets {
+	return &Secrets{cmd: cmd}
+}
+
+// Value метод возвращает значение строкового флага name.
+// Если флаг равен «-» или не задан, но значение обязательно (required), значение вводится без отображения
+// с приглашением title, а если ввод не терминал — читается строкой из стандартного ввода.
+// Если значение не введено, возвращает NoInputError.
+func (s *Secrets) Value(name, title string, required bool) (string, error) {
+	value, ask := s.lookup(name, required)
+	if !ask {
+		return value, nil
+	}
+
+	return s.Ask("--"+name, title)
+}
+
+// Ask метод запрашивает секретное значение name без отображения с приглашением title,
+// если ввод не терминал — читает его строкой из стандартного ввода.
+// Если значение не введено, возвращает NoInputError.
+func (s *Secrets) Ask(name, title string) (string, error) {
+	value, err := s.input().Secret(title + " > ")
+	if errors.Is(err, io.EOF) || (err == nil && value == "") {
+		return "", &NoInputError{Name: name}
+	}
+
+	return value, err
+}
+
+// MultilineValue метод возвращает значение строкового флага name, как Value,
+// но при вводе читает стандартный ввод целиком (многострочные значения, например, закрытые ключи).
+func (s *Secrets) MultilineValue(name, title string, required bool) (string, error) {
+	value, ask := s.lookup(name, required)
+	if !ask {
+		return value, nil
+	}
+
+	in := s.input()
+	if in.IsTerminal() {
+		s.cmd.Printf("%s (завершите ввод сочетанием Ctrl+D) >\n", title)
+	}
+
+	value, err := in.All()
+	if err != nil {
+		return "", err
+	}
+
+	if value = strings.TrimSuffix(strings.TrimSuffix(value, "\n"), "\r"); value == "" {
+		return "", &NoInputError{Name: "--" + name}
+	}
+
+	return value, nil
+}
+
+// Warn метод выводит предупреждение о секретном значении name, переданном в командной строке.
+func (s *Secrets) Warn(name string) {
+	s.cmd.PrintErrf(
+		"Внимание: значение %s передано в командной строке и может сохраниться в истории команд, "+
+			"для ввода без отображения используйте «%s».\n",
+		name,
+		Stdin,
+	)
+}
+
+// lookup возвращает значение флага и признак того, что значение нужно запросить.
+func (s *Secrets) lookup(name string, required bool) (string, bool) {
+	flag := s.cmd.Flags().Lookup(name)
+	if flag == nil {
+		return "", required
+	}
+
+	value := flag.Value.String()
+	switch {
+	case value == Stdin:
+		return "", true
+	case flag.Changed:
+		if value != "" {
+			s.Warn("--" + name)
+		}
+		return value, false
+	}
+
+	return value, required
+}
+
+// input возвращает построчный ввод, создавая его при первом обращении.
+func (s *Secrets) input() *Prompter {
+	if s.prompter == nil {
+		s.prompter = New(s.cmd.InOrStdin(), s.cmd.OutOrStdout())
+	}
+	return s.prompter
+}
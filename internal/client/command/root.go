@@ -4,21 +4,44 @@ package command
 import (
 	"errors"
 	"fmt"
+	"io"
+	"os"
+	"path/filepath"
 	"strings"
 	"unicode/utf8"
 
 	"github.com/casnerano/seckeep/internal/client/command/account"
+	"github.com/casnerano/seckeep/internal/client/command/audit"
 	"github.com/casnerano/seckeep/internal/client/command/data"
+	"github.com/casnerano/seckeep/internal/client/command/emergency"
+	"github.com/casnerano/seckeep/internal/client/command/generate"
+	"github.com/casnerano/seckeep/internal/client/command/profile"
+	"github.com/casnerano/seckeep/internal/client/command/share"
+	"github.com/casnerano/seckeep/internal/client/command/vault"
 	"github.com/casnerano/seckeep/internal/client/config"
 	aService "github.com/casnerano/seckeep/internal/client/service/account"
+	auService "github.com/casnerano/seckeep/internal/client/service/audit"
 	dService "github.com/casnerano/seckeep/internal/client/service/data"
 	"github.com/casnerano/seckeep/internal/client/service/data/encryptor"
+	"github.com/casnerano/seckeep/internal/client/service/data/print"
+	eService "github.com/casnerano/seckeep/internal/client/service/emergency"
+	"github.com/casnerano/seckeep/internal/client/service/history"
+	"github.com/casnerano/seckeep/internal/client/service/keyring"
+	rService "github.com/casnerano/seckeep/internal/client/service/recovery"
+	"github.com/casnerano/seckeep/internal/client/service/search"
+	sService "github.com/casnerano/seckeep/internal/client/service/share"
 	"github.com/casnerano/seckeep/internal/client/service/storage"
 	"github.com/casnerano/seckeep/internal/client/service/syncer"
+	"github.com/casnerano/seckeep/internal/client/service/trash"
+	"github.com/casnerano/seckeep/internal/client/service/urimatch"
+	vService "github.com/casnerano/seckeep/internal/client/service/vault"
 	"github.com/casnerano/seckeep/pkg/cipher"
+	"github.com/casnerano/seckeep/pkg/clipboard"
 	"github.com/casnerano/seckeep/pkg/log"
+	"github.com/casnerano/seckeep/pkg/pwned"
 	"github.com/go-resty/resty/v2"
 	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
 )
 
 // Root структура коневой команды.
@@ -29,23 +52,99 @@ type Root struct {
 // RootCommandContext контекст команды.
 // Необходимо для удобной передачи дочерним командам.
 type RootCommandContext struct {
-	Config      *config.Config
+	Config      *config.Manager
+	Flags       GlobalFlags
+	ProfileName string
+	Profile     *config.Profile
 	Logger      log.Loggable
 	DataStorage *storage.Storage
 }
 
+// GlobalFlags глобальные флаги клиента.
+type GlobalFlags struct {
+	ConfigFile string
+	Profile    string
+	Output     string
+}
+
+// ParseGlobalFlags разбирает глобальные флаги из аргументов командной строки.
+// Остальные флаги и аргументы игнорируются, их разбирает cobra при запуске команды.
+func ParseGlobalFlags(args []string) GlobalFlags {
+	flags := GlobalFlags{}
+
+	flagSet := pflag.NewFlagSet("seckeep", pflag.ContinueOnError)
+	flagSet.ParseErrorsWhitelist.UnknownFlags = true
+	flagSet.SetOutput(io.Discard)
+	flagSet.Usage = func() {}
+	flags.register(flagSet)
+
+	_ = flagSet.Parse(args)
+
+	return flags
+}
+
+// register регистрирует глобальные флаги в наборе флагов.
+func (g *GlobalFlags) register(flagSet *pflag.FlagSet) {
+	flagSet.StringVar(&g.ConfigFile, "config", config.DefaultFileName(), "Путь к файлу конфигурации")
+	flagSet.StringVar(&g.Profile, "profile", "", "Имя профиля (по умолчанию — текущий)")
+	flagSet.StringVar(&g.Output, "output", string(print.FormatText), "Формат вывода данных: text, json, yaml или table")
+}
+
 // NewRoot конструктор корневой команды.
 func NewRoot(ctx *RootCommandContext) *Root {
 	httpClient := resty.New()
-	httpClient.SetBaseURL(ctx.Config.Server.URL + "/api")
+	httpClient.SetBaseURL(ctx.Profile.Server.URL + "/api")
+
+	vaultCipher := cipher.New([]byte(ctx.Profile.Encryptor.Secret))
+
+	// Ключи, кеш хранилищ и поисковый индекс хранятся в каталоге данных профиля:
+	// файлы хранилищ разных профилей могут находиться в одном каталоге.
+	profileDir := config.DataDir(ctx.ProfileName)
+
+	// Закрытые ключи пользователя хранятся зашифрованными ключом хранилища профиля.
+	userKeys := keyring.New(
+		httpClient,
+		vaultCipher,
+		[]byte(ctx.Profile.Encryptor.Secret),
+		filepath.Join(profileDir, "keys.enc"),
+	)
+
+	vaultService := vService.New(
+		httpClient,
+		userKeys,
+		filepath.Join(profileDir, "vaults.json"),
+	)
 
 	dataService := dService.New(
 		ctx.DataStorage,
-		encryptor.New(
-			cipher.New([]byte(ctx.Config.App.Encryptor.Secret)),
-		),
+		encryptor.New(vaultCipher),
+		vaultService,
+	)
+
+	shareService := sService.New(httpClient, ctx.Profile.Server.URL)
+
+	// Поисковый индекс содержит открытые значения полей и поэтому хранится зашифрованным.
+	searchService := search.New(
+		ctx.DataStorage,
+		dataService,
+		search.NewFileIndex(vaultCipher, filepath.Join(profileDir, "search.idx")),
 	)
 
+	// Доверенным лицам передается ключ хранилища профиля, которым зашифрованы личные данные.
+	emergencyService := eService.New(httpClient, userKeys, []byte(ctx.Profile.Encryptor.Secret))
+
+	recoveryService := rService.New(httpClient, ctx.Config, ctx.ProfileName)
+
+	tokenStore := aService.NewTokenStore(ctx.ProfileName, ctx.Profile.TokenFile, vaultCipher)
+
+	uriMatcher := urimatch.New(dataService)
+
+	historyService := history.New(httpClient, ctx.DataStorage, dataService)
+
+	trashService := trash.New(httpClient, ctx.DataStorage, dataService, vaultService)
+
+	auditService := auService.New(ctx.DataStorage, dataService)
+
 	sync := syncer.New(httpClient, ctx.DataStorage, ctx.Logger)
 
 	cmd := &cobra.Command{
@@ -55,6 +154,17 @@ func NewRoot(ctx *RootCommandContext) *Root {
 			"и синхронизировать между несколькими клиентами. \n" +
 			"Поджробная информация — https://github.com/casnerano/seckeep",
 		PersistentPreRun: func(cmd *cobra.Command, args []string) {
+			if token, err := tokenStore.ReadToken(); err == nil {
+				httpClient.SetAuthToken(token)
+			}
+
+			healthErr := sync.PingServerHealth()
+
+			// Машиночитаемый вывод не должен смешиваться с приветствием и статусом сервера.
+			if format, err := print.ParseFormat(ctx.Flags.Output); err == nil && format.Machine() {
+				return
+			}
+
 			welcome := "+  SecKeep — менеджер секретных данных  +"
 			length := utf8.RuneCountInString(welcome)
 
@@ -62,13 +172,8 @@ func NewRoot(ctx *RootCommandContext) *Root {
 			fmt.Println(welcome)
 			fmt.Println(strings.Repeat("+", length))
 
-			tj := aService.NewTokenJar()
-			if token, err := tj.ReadToken(); err == nil {
-				httpClient.SetAuthToken(token)
-			}
-
-			if err := sync.PingServerHealth(); err != nil {
-				if errors.Is(err, syncer.ErrUnauthorized) {
+			if healthErr != nil {
+				if errors.Is(healthErr, syncer.ErrUnauthorized) {
 					fmt.Println("Отсутсвует авторизация.")
 				} else {
 					fmt.Println("Отсутсвует соединение с сервером.")
@@ -85,14 +190,37 @@ func NewRoot(ctx *RootCommandContext) *Root {
 		},
 	}
 
-	cmd.AddCommand(account.NewCmd(httpClient))
-	cmd.AddCommand(data.NewCmd(dataService, sync))
+	ctx.Flags.register(cmd.PersistentFlags())
+
+	cmd.AddCommand(account.NewCmd(httpClient, tokenStore, userKeys, recoveryService))
+	cmd.AddCommand(data.NewCmd(
+		dataService,
+		shareService,
+		searchService,
+		uriMatcher,
+		historyService,
+		trashService,
+		clipboard.Detect(os.Stdout),
+		ctx.Config.ClipboardClearAfter(),
+		sync,
+	))
+	cmd.AddCommand(vault.NewCmd(vaultService))
+	cmd.AddCommand(share.NewCmd(shareService))
+	cmd.AddCommand(emergency.NewCmd(emergencyService))
+	cmd.AddCommand(profile.NewCmd(ctx.Config))
+	cmd.AddCommand(generate.NewCmd())
+	cmd.AddCommand(audit.NewCmd(auditService, openPwnedDataset, sync))
 
 	return &Root{
 		cmd: cmd,
 	}
 }
 
+// openPwnedDataset открывает локальную базу утечек Have I Been Pwned.
+func openPwnedDataset(fName string) (audit.Dataset, error) {
+	return pwned.Open(fName)
+}
+
 // Execute метод запуска команды.
 func (r Root) Execute() error {
 	return r.cmd.Execute()
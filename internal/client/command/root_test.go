@@ -0,0 +1,167 @@
+package command
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+
+	"github.com/casnerano/seckeep/internal/client/config"
+	aService "github.com/casnerano/seckeep/internal/client/service/account"
+	"github.com/casnerano/seckeep/internal/client/service/storage"
+	"github.com/casnerano/seckeep/pkg/cipher"
+	"github.com/casnerano/seckeep/pkg/log"
+	"github.com/stretchr/testify/suite"
+)
+
+const testToken = "eyJhbGci.e30.Et9HFtf9R3GEM"
+
+type RootTestSuite struct {
+	suite.Suite
+	server *httptest.Server
+
+	manager     *config.Manager
+	profileName string
+	profile     *config.Profile
+
+	mu             sync.Mutex
+	authorizations map[string]string
+	offline        bool
+}
+
+func (s *RootTestSuite) SetupTest() {
+	s.authorizations = make(map[string]string)
+	s.offline = false
+
+	s.server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		s.mu.Lock()
+		s.authorizations[r.Method+" "+r.URL.Path] = r.Header.Get("Authorization")
+		offline := s.offline
+		s.mu.Unlock()
+
+		if offline {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+
+		if r.Header.Get("Authorization") != "Bearer "+testToken {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+
+		switch r.Method + " " + r.URL.Path {
+		case "PUT /api/user/password":
+			w.Header().Set("Authorization", "Bearer "+testToken)
+		case "GET /api/user/export":
+			_, _ = w.Write([]byte(`{"user":{},"data":[]}`))
+		}
+	}))
+
+	s.T().Setenv("XDG_DATA_HOME", s.T().TempDir())
+
+	var err error
+	s.manager, err = config.Load(filepath.Join(s.T().TempDir(), config.FileName))
+	s.Require().NoError(err)
+
+	s.profileName = s.manager.CurrentProfile()
+	s.profile, err = s.manager.Profile(s.profileName)
+	s.Require().NoError(err)
+	s.profile.Server.URL = s.server.URL
+	s.profile.StoreFile = filepath.Join(s.T().TempDir(), "data.registry")
+	s.Require().NoError(os.MkdirAll(config.DataDir(s.profileName), 0700))
+
+	tokenJar := aService.NewTokenJar(s.profile.TokenFile, cipher.New([]byte(s.profile.Encryptor.Secret)))
+	s.Require().NoError(tokenJar.SetToken(testToken))
+}
+
+func (s *RootTestSuite) TearDownTest() {
+	s.server.Close()
+}
+
+// execute запускает корневую команду с аргументами от имени авторизованного пользователя.
+func (s *RootTestSuite) execute(args ...string) string {
+	dataStorage, err := storage.New(s.profile.StoreFile)
+	s.Require().NoError(err)
+	defer dataStorage.Close()
+
+	root := NewRoot(&RootCommandContext{
+		Config:      s.manager,
+		Flags:       GlobalFlags{Output: "text"},
+		ProfileName: s.profileName,
+		Profile:     s.profile,
+		Logger:      log.New("test"),
+		DataStorage: dataStorage,
+	})
+
+	out := bytes.NewBufferString("")
+	root.cmd.SetOut(out)
+	root.cmd.SetArgs(args)
+	s.Require().NoError(root.Execute())
+
+	return out.String()
+}
+
+// authorization возвращает заголовок авторизации запроса к серверу.
+func (s *RootTestSuite) authorization(request string) string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.authorizations[request]
+}
+
+func (s *RootTestSuite) TestAccountAuthorization() {
+	s.Run("Export", func() {
+		out := s.execute("account", "export", "--file", filepath.Join(s.T().TempDir(), "export.json"))
+
+		s.Equal("Bearer "+testToken, s.authorization("GET /api/user/export"))
+		s.Contains(out, "Данные аккаунта выгружены")
+	})
+
+	s.Run("Change password", func() {
+		out := s.execute("account", "passwd", "--old-password", "old", "--new-password", "new", "--yes")
+
+		s.Equal("Bearer "+testToken, s.authorization("PUT /api/user/password"))
+		s.Contains(out, "Пароль успешно изменен.")
+	})
+
+	s.Run("Delete", func() {
+		out := s.execute("account", "delete", "--password", "secret", "--yes")
+
+		s.Equal("Bearer "+testToken, s.authorization("DELETE /api/user"))
+		s.Contains(out, "Аккаунт успешно удален.")
+	})
+}
+
+func (s *RootTestSuite) TestSignOut() {
+	out := s.execute("account", "sign-out")
+
+	s.Equal("Bearer "+testToken, s.authorization("POST /api/user/logout"))
+	s.Contains(out, "Выход выполнен, сессия завершена.")
+}
+
+func (s *RootTestSuite) TestRecoveryKeyGenerate() {
+	out := s.execute("account", "recovery-key", "generate")
+
+	s.Equal("Bearer "+testToken, s.authorization("PUT /api/user/recovery-key"))
+	s.Contains(out, "Ключ восстановления:")
+	s.NotContains(out, "Необходима авторизация.")
+}
+
+func (s *RootTestSuite) TestProfileFiles() {
+	// Без сервера записи не синхронизируются и остаются в локальном хранилище.
+	s.offline = true
+
+	s.execute("data", "create", "text", "--value", "example")
+	s.execute("data", "search", "example", "--index")
+
+	// Каталог файла хранилища может быть общим для нескольких профилей.
+	s.FileExists(filepath.Join(config.DataDir(s.profileName), "search.idx"))
+	s.NoFileExists(filepath.Join(filepath.Dir(s.profile.StoreFile), "search.idx"))
+}
+
+func TestRootTestSuite(t *testing.T) {
+	suite.Run(t, new(RootTestSuite))
+}
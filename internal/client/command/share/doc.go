@@ -0,0 +1,2 @@
+// Package share содержит команды для работы со ссылками на секреты.
+package share
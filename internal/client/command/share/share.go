@@ -0,0 +1,63 @@
+package share
+
+//go:generate mockgen -destination=mock/share.go -source=share.go
+
+import (
+	"errors"
+
+	"github.com/casnerano/seckeep/internal/client/model"
+	"github.com/casnerano/seckeep/internal/client/service/data/print"
+	"github.com/casnerano/seckeep/internal/client/service/share"
+	"github.com/spf13/cobra"
+)
+
+// Service интерфейс открытия ссылок на секреты.
+type Service interface {
+	Open(link string) (model.DataTypeable, error)
+}
+
+// NewCmd конструктор базовой команды работы со ссылками на секреты.
+// Содердит инициализацию дочерних команд.
+func NewCmd(shareService Service) *cobra.Command {
+	cmd := cobra.Command{
+		Use:              "share",
+		Short:            "Ссылки на секреты",
+		PersistentPreRun: func(cmd *cobra.Command, args []string) {},
+	}
+
+	cmd.AddCommand(NewOpenCmd(shareService))
+
+	return &cmd
+}
+
+// NewOpenCmd конструктор команды открытия ссылки на секрет.
+// Открытие ссылки расходует один просмотр, авторизация не требуется.
+// Скрытые значения печатаются полностью: повторно открыть ссылку может быть нельзя.
+func NewOpenCmd(shareService Service) *cobra.Command {
+	cmd := cobra.Command{
+		Use:   "open URL",
+		Short: "Открыть ссылку на секрет",
+		Args:  cobra.ExactArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			d, err := shareService.Open(args[0])
+			if err != nil {
+				switch {
+				case errors.Is(err, share.ErrNotFound):
+					cmd.Println("Ссылка не найдена, истекла или уже просмотрена.")
+				case errors.Is(err, share.ErrInvalidLink):
+					cmd.Println("Некорректная ссылка.")
+				default:
+					cmd.Println(err)
+				}
+				return
+			}
+
+			p := print.New(cmd.OutOrStdout())
+			p.SetReveal(true)
+			p.Content(d)
+			cmd.Println()
+		},
+	}
+
+	return &cmd
+}
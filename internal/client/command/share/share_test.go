@@ -0,0 +1,67 @@
+package share
+
+import (
+	"bytes"
+	"io"
+	"testing"
+
+	mock_share "github.com/casnerano/seckeep/internal/client/command/share/mock"
+	"github.com/casnerano/seckeep/internal/client/model"
+	"github.com/casnerano/seckeep/internal/client/service/share"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/suite"
+)
+
+type ShareCmdTestSuite struct {
+	suite.Suite
+	shareService *mock_share.MockService
+}
+
+func (s *ShareCmdTestSuite) SetupSuite() {
+	ctrl := gomock.NewController(s.T())
+	defer ctrl.Finish()
+
+	s.shareService = mock_share.NewMockService(ctrl)
+}
+
+func (s *ShareCmdTestSuite) TestShareCmd() {
+	cmd := NewCmd(s.shareService)
+	s.True(cmd.HasSubCommands())
+}
+
+func (s *ShareCmdTestSuite) TestOpen() {
+	cmd := NewOpenCmd(s.shareService)
+	cmdBuf := bytes.NewBufferString("")
+	cmd.SetOut(cmdBuf)
+
+	link := "https://vault.example.com/share/0b8e5c1a#AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA"
+
+	s.Run("Available share", func() {
+		s.shareService.EXPECT().Open(link).Return(&model.DataText{Value: "Wi-Fi: 12345678"}, nil)
+
+		cmd.SetArgs([]string{link})
+		s.Require().NoError(cmd.Execute())
+
+		out, err := io.ReadAll(cmdBuf)
+		s.Require().NoError(err)
+
+		s.Contains(string(out), "Wi-Fi: 12345678")
+		s.NotContains(string(out), "Индекс")
+	})
+
+	s.Run("Already viewed", func() {
+		s.shareService.EXPECT().Open(link).Return(nil, share.ErrNotFound)
+
+		cmd.SetArgs([]string{link})
+		s.Require().NoError(cmd.Execute())
+
+		out, err := io.ReadAll(cmdBuf)
+		s.Require().NoError(err)
+
+		s.Contains(string(out), "уже просмотрена")
+	})
+}
+
+func TestShareCmdTestSuite(t *testing.T) {
+	suite.Run(t, new(ShareCmdTestSuite))
+}
@@ -0,0 +1,24 @@
+package vault
+
+import (
+	"github.com/spf13/cobra"
+)
+
+// NewCreateCmd конструктор команды создания общего хранилища.
+func NewCreateCmd(vaultService Service) *cobra.Command {
+	cmd := cobra.Command{
+		Use:   "create NAME",
+		Short: "Создать общее хранилище",
+		Args:  cobra.ExactArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			v, err := vaultService.Create(args[0])
+			if err != nil {
+				printError(cmd, err)
+				return
+			}
+			cmd.Printf("Хранилище «%s» создано (%s).\n", v.Name, v.UUID)
+		},
+	}
+
+	return &cmd
+}
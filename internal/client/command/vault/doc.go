@@ -0,0 +1,2 @@
+// Package vault содержит команды для управления общими хранилищами.
+package vault
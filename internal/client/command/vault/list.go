@@ -0,0 +1,31 @@
+package vault
+
+import (
+	"github.com/spf13/cobra"
+)
+
+// NewListCmd конструктор команды вывода списка общих хранилищ.
+func NewListCmd(vaultService Service) *cobra.Command {
+	cmd := cobra.Command{
+		Use:   "list",
+		Short: "Список общих хранилищ",
+		Run: func(cmd *cobra.Command, args []string) {
+			vaults, err := vaultService.List()
+			if err != nil {
+				printError(cmd, err)
+				return
+			}
+
+			if len(vaults) == 0 {
+				cmd.Println("Список хранилищ пуст.")
+				return
+			}
+
+			for _, v := range vaults {
+				cmd.Printf("%s  %-20s %s\n", v.UUID, v.Name, v.Role)
+			}
+		},
+	}
+
+	return &cmd
+}
@@ -0,0 +1,87 @@
+package vault
+
+import (
+	"strings"
+
+	"github.com/casnerano/seckeep/internal/server/model"
+	"github.com/spf13/cobra"
+)
+
+// NewMembersCmd конструктор команды вывода участников общего хранилища.
+func NewMembersCmd(vaultService Service) *cobra.Command {
+	cmd := cobra.Command{
+		Use:   "members VAULT",
+		Short: "Участники общего хранилища",
+		Args:  cobra.ExactArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			members, err := vaultService.Members(args[0])
+			if err != nil {
+				printError(cmd, err)
+				return
+			}
+
+			for _, m := range members {
+				cmd.Printf("%-20s %s\n", m.Login, m.Role)
+			}
+		},
+	}
+
+	return &cmd
+}
+
+// NewAddMemberCmd конструктор команды добавления участника (или смены его роли).
+// Ключ хранилища шифруется открытым ключом участника,
+// поэтому участник должен хотя бы раз авторизоваться в клиенте.
+func NewAddMemberCmd(vaultService Service) *cobra.Command {
+	var login, role string
+
+	cmd := cobra.Command{
+		Use:   "add-member VAULT",
+		Short: "Добавить участника или изменить его роль",
+		Args:  cobra.ExactArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			vaultRole := model.VaultRole(strings.ToUpper(role))
+			if !vaultRole.IsValid() {
+				cmd.Println("Неизвестная роль, допустимые значения: owner, editor, viewer.")
+				return
+			}
+
+			if err := vaultService.SaveMember(args[0], login, vaultRole); err != nil {
+				printError(cmd, err)
+				return
+			}
+			cmd.Printf("Участник «%s» добавлен с ролью %s.\n", login, vaultRole)
+		},
+	}
+
+	cmd.Flags().StringVarP(&login, "login", "l", "", "Логин участника")
+	cmd.Flags().StringVarP(&role, "role", "r", "viewer", "Роль: owner, editor, viewer")
+
+	_ = cmd.MarkFlagRequired("login")
+
+	return &cmd
+}
+
+// NewRemoveMemberCmd конструктор команды исключения участника из общего хранилища.
+func NewRemoveMemberCmd(vaultService Service) *cobra.Command {
+	var login string
+
+	cmd := cobra.Command{
+		Use:   "remove-member VAULT",
+		Short: "Исключить участника",
+		Args:  cobra.ExactArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			if err := vaultService.RemoveMember(args[0], login); err != nil {
+				printError(cmd, err)
+				return
+			}
+			cmd.Printf("Участник «%s» исключен из хранилища.\n", login)
+		},
+	}
+
+	cmd.Flags().StringVarP(&login, "login", "l", "", "Логин участника")
+
+	_ = cmd.MarkFlagRequired("login")
+
+	return &cmd
+}
@@ -0,0 +1,55 @@
+package vault
+
+//go:generate mockgen -destination=mock/vault.go -source=vault.go
+
+import (
+	"errors"
+
+	"github.com/casnerano/seckeep/internal/client/service/vault"
+	"github.com/casnerano/seckeep/internal/server/model"
+	"github.com/spf13/cobra"
+)
+
+// Service интерфейс управления общими хранилищами.
+type Service interface {
+	Create(name string) (*model.Vault, error)
+	List() ([]*model.Vault, error)
+	Members(nameOrUUID string) ([]*model.VaultMember, error)
+	SaveMember(nameOrUUID, login string, role model.VaultRole) error
+	RemoveMember(nameOrUUID, login string) error
+}
+
+// NewCmd конструктор базовой команды управления общими хранилищами.
+// Содердит инициализацию дочерних команд.
+func NewCmd(vaultService Service) *cobra.Command {
+	cmd := cobra.Command{
+		Use:   "vault",
+		Short: "Управление общими хранилищами",
+	}
+
+	cmd.AddCommand(NewCreateCmd(vaultService))
+	cmd.AddCommand(NewListCmd(vaultService))
+	cmd.AddCommand(NewMembersCmd(vaultService))
+	cmd.AddCommand(NewAddMemberCmd(vaultService))
+	cmd.AddCommand(NewRemoveMemberCmd(vaultService))
+
+	return &cmd
+}
+
+// printError выводит понятное пользователю описание ошибки.
+func printError(cmd *cobra.Command, err error) {
+	switch {
+	case errors.Is(err, vault.ErrUnauthorized):
+		cmd.Println("Необходима авторизация.")
+	case errors.Is(err, vault.ErrNotFound):
+		cmd.Println("Хранилище не найдено.")
+	case errors.Is(err, vault.ErrForbidden):
+		cmd.Println("Недостаточно прав для операции.")
+	case errors.Is(err, vault.ErrUserNotFound):
+		cmd.Println("Пользователь не найден или еще ни разу не авторизовался.")
+	case errors.Is(err, vault.ErrLastOwner):
+		cmd.Println("В хранилище должен остаться хотя бы один владелец.")
+	default:
+		cmd.Println(err)
+	}
+}
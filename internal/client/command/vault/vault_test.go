@@ -0,0 +1,106 @@
+package vault
+
+import (
+	"bytes"
+	"io"
+	"testing"
+
+	mock_vault "github.com/casnerano/seckeep/internal/client/command/vault/mock"
+	"github.com/casnerano/seckeep/internal/client/service/vault"
+	"github.com/casnerano/seckeep/internal/server/model"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/suite"
+)
+
+type VaultCmdTestSuite struct {
+	suite.Suite
+	vaultService *mock_vault.MockService
+}
+
+func (s *VaultCmdTestSuite) SetupSuite() {
+	ctrl := gomock.NewController(s.T())
+	defer ctrl.Finish()
+
+	s.vaultService = mock_vault.NewMockService(ctrl)
+}
+
+func (s *VaultCmdTestSuite) TestVaultCmd() {
+	cmd := NewCmd(s.vaultService)
+	s.True(cmd.HasSubCommands())
+}
+
+func (s *VaultCmdTestSuite) TestCreate() {
+	cmd := NewCreateCmd(s.vaultService)
+	cmdBuf := bytes.NewBufferString("")
+	cmd.SetOut(cmdBuf)
+
+	s.vaultService.EXPECT().Create("family").Return(&model.Vault{UUID: "4d1f", Name: "family"}, nil)
+
+	cmd.SetArgs([]string{"family"})
+	s.Require().NoError(cmd.Execute())
+
+	out, err := io.ReadAll(cmdBuf)
+	s.Require().NoError(err)
+
+	s.Contains(string(out), "Хранилище «family» создано")
+}
+
+func (s *VaultCmdTestSuite) TestAddMember() {
+	cmd := NewAddMemberCmd(s.vaultService)
+	cmdBuf := bytes.NewBufferString("")
+	cmd.SetOut(cmdBuf)
+
+	s.Run("Success", func() {
+		s.vaultService.EXPECT().SaveMember("family", "anna", model.VaultRoleEditor).Return(nil)
+
+		cmd.SetArgs([]string{"family", "--login", "anna", "--role", "editor"})
+		s.Require().NoError(cmd.Execute())
+
+		out, err := io.ReadAll(cmdBuf)
+		s.Require().NoError(err)
+
+		s.Contains(string(out), "Участник «anna» добавлен с ролью EDITOR")
+	})
+
+	s.Run("Unknown role", func() {
+		cmd.SetArgs([]string{"family", "--login", "anna", "--role", "admin"})
+		s.Require().NoError(cmd.Execute())
+
+		out, err := io.ReadAll(cmdBuf)
+		s.Require().NoError(err)
+
+		s.Contains(string(out), "Неизвестная роль")
+	})
+
+	s.Run("Member without public key", func() {
+		s.vaultService.EXPECT().SaveMember("family", "anna", model.VaultRoleViewer).Return(vault.ErrUserNotFound)
+
+		cmd.SetArgs([]string{"family", "--login", "anna", "--role", "viewer"})
+		s.Require().NoError(cmd.Execute())
+
+		out, err := io.ReadAll(cmdBuf)
+		s.Require().NoError(err)
+
+		s.Contains(string(out), "Пользователь не найден")
+	})
+}
+
+func (s *VaultCmdTestSuite) TestRemoveMember() {
+	cmd := NewRemoveMemberCmd(s.vaultService)
+	cmdBuf := bytes.NewBufferString("")
+	cmd.SetOut(cmdBuf)
+
+	s.vaultService.EXPECT().RemoveMember("family", "anna").Return(vault.ErrLastOwner)
+
+	cmd.SetArgs([]string{"family", "--login", "anna"})
+	s.Require().NoError(cmd.Execute())
+
+	out, err := io.ReadAll(cmdBuf)
+	s.Require().NoError(err)
+
+	s.Contains(string(out), "хотя бы один владелец")
+}
+
+func TestVaultCmdTestSuite(t *testing.T) {
+	suite.Run(t, new(VaultCmdTestSuite))
+}
@@ -1,16 +1,260 @@
+// Package config содержит конфигурацию клиента и управление профилями.
+// Каждый профиль описывает отдельное хранилище: сервер, ключ шифрования, файлы хранилища и токена.
 package config
 
-// FileName дефолтный путь к файлу конфигурации клиента.
-const FileName = "./configs/client.yml"
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"time"
+
+	"github.com/casnerano/seckeep/pkg/config/yaml"
+)
+
+const (
+	// FileName имя файла конфигурации клиента.
+	FileName = "client.yml"
+
+	// DefaultProfile имя профиля по умолчанию.
+	DefaultProfile = "default"
+
+	// DefaultServerURL адрес сервера по умолчанию.
+	DefaultServerURL = "http://127.0.0.1:8081"
+
+	// storeFileName имя файла локального хранилища в каталоге данных профиля.
+	storeFileName = "data.registry"
+
+	// tokenFileName имя файла токена в каталоге данных профиля.
+	tokenFileName = "token.jar"
+
+	// legacyStoreFile путь к локальному хранилищу в устаревшем формате (относительно рабочего каталога).
+	legacyStoreFile = "./cmd/client/var/store/data.registry"
+
+	// secretLength длина (в байтах) генерируемого ключа шифрования.
+	secretLength = 32
+
+	// DefaultClipboardClearAfter время, через которое по умолчанию очищается буфер обмена.
+	DefaultClipboardClearAfter = 45 * time.Second
+)
+
+// Основные ошибки при работе с конфигурацией.
+var (
+	// ErrProfileNotFound профиль не найден.
+	ErrProfileNotFound = errors.New("profile not found")
+
+	// ErrProfileExists профиль уже существует.
+	ErrProfileExists = errors.New("profile already exists")
+)
+
+// Profile профиль клиента.
+type Profile struct {
+	Encryptor struct {
+		Secret string `yaml:"secret"`
+	} `yaml:"encryptor"`
+	Server struct {
+		URL string `yaml:"url"`
+	} `yaml:"server"`
+	StoreFile string `yaml:"store_file,omitempty"`
+	TokenFile string `yaml:"token_file,omitempty"`
+}
 
 // Config конфигурация клиента.
 type Config struct {
+	CurrentProfile string              `yaml:"current_profile"`
+	Profiles       map[string]*Profile `yaml:"profiles"`
+
+	// Clipboard настройки копирования значений в буфер обмена.
+	Clipboard struct {
+		ClearAfter time.Duration `yaml:"clear_after,omitempty"`
+	} `yaml:"clipboard,omitempty"`
+
+	// App и Server — конфигурация в устаревшем формате (один профиль без имени).
+	// При загрузке переносится в профиль по умолчанию.
 	App struct {
 		Encryptor struct {
 			Secret string `yaml:"secret"`
 		} `yaml:"encryptor"`
-	} `yaml:"app"`
+	} `yaml:"app,omitempty"`
 	Server struct {
 		URL string `yaml:"url"`
-	} `yaml:"server"`
+	} `yaml:"server,omitempty"`
+}
+
+// Manager структура управления файлом конфигурации и профилями.
+type Manager struct {
+	fName  string
+	config *Config
+}
+
+// Load загружает конфигурацию из файла.
+// Если файл отсутствует, он создается с профилем по умолчанию и случайным ключом шифрования.
+func Load(fName string) (*Manager, error) {
+	m := &Manager{fName: fName, config: &Config{}}
+
+	err := yaml.LoadFromFile(fName, m.config)
+	if err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return nil, err
+	}
+
+	if errors.Is(err, fs.ErrNotExist) {
+		profile, err := NewProfile(DefaultServerURL, "")
+		if err != nil {
+			return nil, err
+		}
+
+		m.config.CurrentProfile = DefaultProfile
+		m.config.Profiles = map[string]*Profile{DefaultProfile: profile}
+
+		if err = m.save(); err != nil {
+			return nil, err
+		}
+
+		return m, nil
+	}
+
+	m.migrateLegacy()
+
+	return m, nil
+}
+
+// NewProfile конструктор профиля.
+// Если ключ шифрования не задан, генерируется случайный.
+func NewProfile(serverURL, secret string) (*Profile, error) {
+	if secret == "" {
+		bSecret := make([]byte, secretLength)
+		if _, err := rand.Read(bSecret); err != nil {
+			return nil, err
+		}
+		secret = hex.EncodeToString(bSecret)
+	}
+
+	profile := &Profile{}
+	profile.Server.URL = serverURL
+	profile.Encryptor.Secret = secret
+
+	return profile, nil
+}
+
+// FileName возвращает путь к файлу конфигурации.
+func (m *Manager) FileName() string {
+	return m.fName
+}
+
+// CurrentProfile возвращает имя текущего профиля.
+func (m *Manager) CurrentProfile() string {
+	if m.config.CurrentProfile == "" {
+		return DefaultProfile
+	}
+	return m.config.CurrentProfile
+}
+
+// ClipboardClearAfter возвращает время, через которое очищается скопированное в буфер обмена значение.
+func (m *Manager) ClipboardClearAfter() time.Duration {
+	if m.config.Clipboard.ClearAfter <= 0 {
+		return DefaultClipboardClearAfter
+	}
+	return m.config.Clipboard.ClearAfter
+}
+
+// ProfileNames возвращает отсортированный список имен профилей.
+func (m *Manager) ProfileNames() []string {
+	names := make([]string, 0, len(m.config.Profiles))
+	for name := range m.config.Profiles {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Profile возвращает профиль по имени (пустое имя — текущий профиль).
+// Незаданные пути к файлам профиля заполняются значениями по умолчанию.
+func (m *Manager) Profile(name string) (*Profile, error) {
+	if name == "" {
+		name = m.CurrentProfile()
+	}
+
+	p, ok := m.config.Profiles[name]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrProfileNotFound, name)
+	}
+
+	profile := *p
+	if profile.StoreFile == "" {
+		profile.StoreFile = filepath.Join(DataDir(name), storeFileName)
+	}
+	if profile.TokenFile == "" {
+		profile.TokenFile = filepath.Join(DataDir(name), tokenFileName)
+	}
+
+	return &profile, nil
+}
+
+// Use делает профиль текущим и сохраняет конфигурацию.
+func (m *Manager) Use(name string) error {
+	if _, ok := m.config.Profiles[name]; !ok {
+		return fmt.Errorf("%w: %s", ErrProfileNotFound, name)
+	}
+
+	m.config.CurrentProfile = name
+	return m.save()
+}
+
+// Add добавляет профиль и сохраняет конфигурацию.
+func (m *Manager) Add(name string, profile *Profile) error {
+	if _, ok := m.config.Profiles[name]; ok {
+		return fmt.Errorf("%w: %s", ErrProfileExists, name)
+	}
+
+	if m.config.Profiles == nil {
+		m.config.Profiles = make(map[string]*Profile)
+	}
+
+	m.config.Profiles[name] = profile
+	return m.save()
+}
+
+// SetSecret заменяет ключ шифрования профиля и сохраняет конфигурацию.
+func (m *Manager) SetSecret(name, secret string) error {
+	p, ok := m.config.Profiles[name]
+	if !ok {
+		return fmt.Errorf("%w: %s", ErrProfileNotFound, name)
+	}
+
+	p.Encryptor.Secret = secret
+	return m.save()
+}
+
+// migrateLegacy переносит конфигурацию устаревшего формата в профиль по умолчанию.
+// Если существует локальное хранилище устаревшего формата, профиль продолжает использовать его (по абсолютному пути).
+func (m *Manager) migrateLegacy() {
+	if len(m.config.Profiles) > 0 || m.config.App.Encryptor.Secret == "" {
+		return
+	}
+
+	profile := &Profile{}
+	profile.Encryptor.Secret = m.config.App.Encryptor.Secret
+	profile.Server.URL = m.config.Server.URL
+
+	if storeFile, err := filepath.Abs(legacyStoreFile); err == nil {
+		if _, err = os.Stat(storeFile); err == nil {
+			profile.StoreFile = storeFile
+		}
+	}
+
+	m.config.Profiles = map[string]*Profile{DefaultProfile: profile}
+	m.config.App.Encryptor.Secret = ""
+	m.config.Server.URL = ""
+}
+
+// save сохраняет конфигурацию в файл, доступный только владельцу (содержит ключи шифрования).
+func (m *Manager) save() error {
+	if err := os.MkdirAll(filepath.Dir(m.fName), 0700); err != nil {
+		return err
+	}
+	return yaml.SaveToFile(m.fName, m.config, 0600)
 }
@@ -0,0 +1,142 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type ConfigTestSuite struct {
+	suite.Suite
+	dir string
+}
+
+func (s *ConfigTestSuite) SetupTest() {
+	s.dir = s.T().TempDir()
+	s.T().Setenv("XDG_DATA_HOME", filepath.Join(s.dir, "data"))
+}
+
+func (s *ConfigTestSuite) TestFirstRun() {
+	fName := filepath.Join(s.dir, "seckeep", FileName)
+
+	m, err := Load(fName)
+	s.Require().NoError(err)
+
+	info, err := os.Stat(fName)
+	s.Require().NoError(err)
+	s.Equal(os.FileMode(0600), info.Mode().Perm())
+
+	s.Equal(DefaultProfile, m.CurrentProfile())
+
+	profile, err := m.Profile("")
+	s.Require().NoError(err)
+	s.Equal(DefaultServerURL, profile.Server.URL)
+	s.Len(profile.Encryptor.Secret, 2*secretLength)
+	s.Equal(filepath.Join(s.dir, "data", "seckeep", DefaultProfile, storeFileName), profile.StoreFile)
+	s.Equal(filepath.Join(s.dir, "data", "seckeep", DefaultProfile, tokenFileName), profile.TokenFile)
+
+	reloaded, err := Load(fName)
+	s.Require().NoError(err)
+
+	reloadedProfile, err := reloaded.Profile(DefaultProfile)
+	s.Require().NoError(err)
+	s.Equal(profile.Encryptor.Secret, reloadedProfile.Encryptor.Secret)
+}
+
+func (s *ConfigTestSuite) TestLegacyFormat() {
+	fName := filepath.Join(s.dir, FileName)
+	s.Require().NoError(os.WriteFile(
+		fName,
+		[]byte("app:\n  encryptor:\n    secret: example\nserver:\n  url: http://127.0.0.1:8081\n"),
+		0600,
+	))
+
+	m, err := Load(fName)
+	s.Require().NoError(err)
+
+	profile, err := m.Profile("")
+	s.Require().NoError(err)
+	s.Equal("example", profile.Encryptor.Secret)
+	s.Equal("http://127.0.0.1:8081", profile.Server.URL)
+	s.Equal(filepath.Join(DataDir(DefaultProfile), storeFileName), profile.StoreFile)
+
+	s.Run("Legacy store", func() {
+		wd, err := os.Getwd()
+		s.Require().NoError(err)
+		s.Require().NoError(os.Chdir(s.dir))
+		defer func() { _ = os.Chdir(wd) }()
+
+		storeFile := filepath.Join(s.dir, legacyStoreFile)
+		s.Require().NoError(os.MkdirAll(filepath.Dir(storeFile), 0700))
+		s.Require().NoError(os.WriteFile(storeFile, []byte("{}"), 0600))
+
+		m, err := Load(fName)
+		s.Require().NoError(err)
+
+		profile, err := m.Profile("")
+		s.Require().NoError(err)
+		s.Equal(storeFile, profile.StoreFile)
+	})
+}
+
+func (s *ConfigTestSuite) TestClipboard() {
+	fName := filepath.Join(s.dir, FileName)
+
+	m, err := Load(fName)
+	s.Require().NoError(err)
+	s.Equal(DefaultClipboardClearAfter, m.ClipboardClearAfter())
+
+	s.Require().NoError(os.WriteFile(fName, []byte("clipboard:\n  clear_after: 10s\n"), 0600))
+
+	m, err = Load(fName)
+	s.Require().NoError(err)
+	s.Equal(10*time.Second, m.ClipboardClearAfter())
+}
+
+func (s *ConfigTestSuite) TestProfiles() {
+	fName := filepath.Join(s.dir, FileName)
+
+	m, err := Load(fName)
+	s.Require().NoError(err)
+
+	work, err := NewProfile("https://vault.example.com", "")
+	s.Require().NoError(err)
+	work.StoreFile = filepath.Join(s.dir, "work.registry")
+
+	s.Require().NoError(m.Add("work", work))
+	s.ErrorIs(m.Add("work", work), ErrProfileExists)
+
+	s.ErrorIs(m.Use("home"), ErrProfileNotFound)
+	s.Require().NoError(m.Use("work"))
+
+	reloaded, err := Load(fName)
+	s.Require().NoError(err)
+	s.Equal("work", reloaded.CurrentProfile())
+	s.Equal([]string{DefaultProfile, "work"}, reloaded.ProfileNames())
+
+	profile, err := reloaded.Profile("")
+	s.Require().NoError(err)
+	s.Equal("https://vault.example.com", profile.Server.URL)
+	s.Equal(work.StoreFile, profile.StoreFile)
+	s.NotEqual(work.Encryptor.Secret, "")
+
+	_, err = reloaded.Profile("home")
+	s.ErrorIs(err, ErrProfileNotFound)
+
+	s.Require().NoError(reloaded.SetSecret("work", "recovered"))
+	s.ErrorIs(reloaded.SetSecret("home", "recovered"), ErrProfileNotFound)
+
+	reloaded, err = Load(fName)
+	s.Require().NoError(err)
+
+	profile, err = reloaded.Profile("work")
+	s.Require().NoError(err)
+	s.Equal("recovered", profile.Encryptor.Secret)
+}
+
+func TestConfigTestSuite(t *testing.T) {
+	suite.Run(t, new(ConfigTestSuite))
+}
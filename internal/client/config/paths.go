@@ -0,0 +1,44 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+)
+
+// appDirName имя каталога приложения в пользовательских каталогах.
+const appDirName = "seckeep"
+
+// ConfigDir возвращает каталог конфигурации клиента согласно XDG Base Directory:
+// $XDG_CONFIG_HOME/seckeep, по умолчанию ~/.config/seckeep.
+func ConfigDir() string {
+	return filepath.Join(xdgDir("XDG_CONFIG_HOME", ".config"), appDirName)
+}
+
+// DataDir возвращает каталог данных профиля согласно XDG Base Directory:
+// $XDG_DATA_HOME/seckeep/<profile>, по умолчанию ~/.local/share/seckeep/<profile>.
+func DataDir(profile string) string {
+	return filepath.Join(xdgDir("XDG_DATA_HOME", filepath.Join(".local", "share")), appDirName, profile)
+}
+
+// DefaultFileName возвращает дефолтный путь к файлу конфигурации клиента.
+func DefaultFileName() string {
+	if fName := os.Getenv("SECKEEP_CONFIG"); fName != "" {
+		return fName
+	}
+	return filepath.Join(ConfigDir(), FileName)
+}
+
+// xdgDir возвращает каталог из переменной окружения env,
+// или подкаталог fallback в домашнем каталоге пользователя.
+func xdgDir(env, fallback string) string {
+	if dir := os.Getenv(env); filepath.IsAbs(dir) {
+		return dir
+	}
+
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return fallback
+	}
+
+	return filepath.Join(home, fallback)
+}
@@ -1,19 +1,135 @@
 package model
 
 import (
+	"errors"
+	"strings"
+
 	"github.com/casnerano/seckeep/internal/pkg/model"
+	"github.com/casnerano/seckeep/pkg/card"
 )
 
+// ErrUnknownDataType неизвестный тип данных.
+var ErrUnknownDataType = errors.New("unknown data type")
+
 // DataTypeable интерфейс секретных данных.
 type DataTypeable interface {
 	Type() model.DataType
+	Info() DataInfo
+}
+
+// DataInfo общие сведения о записи: заголовок, теги, папка, отметка избранного и пользовательские поля.
+// Встраивается во все типы данных и шифруется вместе со значением.
+type DataInfo struct {
+	Title    string      `json:"title,omitempty"`
+	Tags     []string    `json:"tags,omitempty"`
+	Folder   string      `json:"folder,omitempty"`
+	Favorite bool        `json:"favorite,omitempty"`
+	Fields   []DataField `json:"fields,omitempty" validate:"dive"`
+}
+
+// NewDataInfo конструктор сведений о записи.
+// Путь папки приводится к виду "a/b/c", пустые теги отбрасываются.
+func NewDataInfo(title string, tags []string, folder string, favorite bool) DataInfo {
+	info := DataInfo{
+		Title:    strings.TrimSpace(title),
+		Folder:   CleanFolder(folder),
+		Favorite: favorite,
+	}
+
+	for _, tag := range tags {
+		if tag = strings.TrimSpace(tag); tag != "" {
+			info.Tags = append(info.Tags, tag)
+		}
+	}
+
+	return info
+}
+
+// Info возвращает сведения о записи.
+func (i DataInfo) Info() DataInfo {
+	return i
+}
+
+// HasTag проверяет наличие тега (без учета регистра).
+func (i DataInfo) HasTag(tag string) bool {
+	for _, t := range i.Tags {
+		if strings.EqualFold(t, tag) {
+			return true
+		}
+	}
+	return false
+}
+
+// InFolder проверяет, что запись лежит в папке folder или в одной из её вложенных папок.
+func (i DataInfo) InFolder(folder string) bool {
+	folder = CleanFolder(folder)
+	if folder == "" {
+		return true
+	}
+	return i.Folder == folder || strings.HasPrefix(i.Folder, folder+"/")
+}
+
+// CleanFolder приводит путь папки к виду "a/b/c": без пустых сегментов и крайних разделителей.
+func CleanFolder(folder string) string {
+	segments := make([]string, 0)
+	for _, segment := range strings.Split(folder, "/") {
+		if segment = strings.TrimSpace(segment); segment != "" {
+			segments = append(segments, segment)
+		}
+	}
+	return strings.Join(segments, "/")
+}
+
+// DataFilter фильтр записей по тегу, папке и отметке избранного.
+// Пустые условия не учитываются.
+type DataFilter struct {
+	Tag       string
+	Folder    string
+	Favorites bool
+}
+
+// Match проверяет, что запись удовлетворяет фильтру.
+func (f DataFilter) Match(dt DataTypeable) bool {
+	info := dt.Info()
+
+	if f.Tag != "" && !info.HasTag(f.Tag) {
+		return false
+	}
+
+	if f.Favorites && !info.Favorite {
+		return false
+	}
+
+	return info.InFolder(f.Folder)
+}
+
+// Apply возвращает записи набора, удовлетворяющие фильтру.
+func (f DataFilter) Apply(dt map[int]DataTypeable) map[int]DataTypeable {
+	filtered := make(map[int]DataTypeable, len(dt))
+	for index := range dt {
+		if f.Match(dt[index]) {
+			filtered[index] = dt[index]
+		}
+	}
+	return filtered
+}
+
+// NewData возвращает пустую структуру данных указанного типа для декодирования.
+func NewData(dataType model.DataType) (DataTypeable, error) {
+	if spec, ok := LookupType(dataType); ok {
+		return spec.New(), nil
+	}
+	return nil, ErrUnknownDataType
 }
 
 // DataCredential структура учетной записи.
 type DataCredential struct {
-	Login    string   `json:"login" validate:"required"`
-	Password string   `json:"password" validate:"required"`
-	Meta     []string `json:"meta"`
+	DataInfo
+	Login       string           `json:"login" validate:"required" field:"Логин,search"`
+	Password    string           `json:"password" validate:"required" field:"Пароль,secret"`
+	URIs        []DataURI        `json:"uris,omitempty" validate:"dive"`
+	Attachments []DataAttachment `json:"attachments,omitempty" validate:"dive"`
+	Meta        []string         `json:"meta"`
 }
 
 // Type возвращает тип структуры.
@@ -23,7 +139,8 @@ func (c DataCredential) Type() model.DataType {
 
 // DataText структура простого текста.
 type DataText struct {
-	Value string   `json:"value" validate:"required"`
+	DataInfo
+	Value string   `json:"value" validate:"required" field:"Значение,search"`
 	Meta  []string `json:"meta"`
 }
 
@@ -34,11 +151,13 @@ func (c DataText) Type() model.DataType {
 
 // DataCard структура банковской карты.
 type DataCard struct {
-	Number    string   `json:"number" validate:"required,credit_card"`
-	MonthYear string   `json:"month_year" validate:"required,datetime=01.02"`
-	CVV       string   `json:"cvv" validate:"required"`
-	Owner     string   `json:"owner"`
-	Meta      []string `json:"meta"`
+	DataInfo
+	Number      string           `json:"number" validate:"required,card_number" field:"Номер"`
+	MonthYear   string           `json:"month_year" validate:"required,card_expiry" field:"Месяц/Год"`
+	CVV         string           `json:"cvv" validate:"required" field:"CVV,secret"`
+	Owner       string           `json:"owner" field:"Держатель,search"`
+	Attachments []DataAttachment `json:"attachments,omitempty" validate:"dive"`
+	Meta        []string         `json:"meta"`
 }
 
 // Type возвращает тип структуры.
@@ -46,9 +165,20 @@ func (c DataCard) Type() model.DataType {
 	return model.DataTypeCard
 }
 
+// Brand возвращает платежную систему карты.
+func (c DataCard) Brand() card.Brand {
+	return card.DetectBrand(c.Number)
+}
+
+// Expiry возвращает срок действия карты.
+func (c DataCard) Expiry() (card.Expiry, error) {
+	return card.ParseExpiry(c.MonthYear)
+}
+
 // DataDocument структура документа.
 type DataDocument struct {
-	Name    string   `json:"name" validate:"required"`
+	DataInfo
+	Name    string   `json:"name" validate:"required" field:"Название,search"`
 	Content []byte   `json:"content" validate:"required"`
 	Meta    []string `json:"meta"`
 }
@@ -1,9 +1,12 @@
 package model
 
 import (
+	"sort"
 	"testing"
+	"time"
 
 	"github.com/casnerano/seckeep/internal/pkg/model"
+	"github.com/casnerano/seckeep/pkg/card"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -12,6 +15,19 @@ func TestDataCard_Type(t *testing.T) {
 	assert.Equal(t, model.DataTypeCard, card.Type())
 }
 
+func TestDataCard_BrandExpiry(t *testing.T) {
+	c := DataCard{Number: "2200000000000004", MonthYear: "06.28"}
+	assert.Equal(t, card.BrandMir, c.Brand())
+
+	expiry, err := c.Expiry()
+	assert.NoError(t, err)
+	assert.Equal(t, card.Expiry{Month: time.June, Year: 2028}, expiry)
+
+	c.MonthYear = "June"
+	_, err = c.Expiry()
+	assert.ErrorIs(t, err, card.ErrInvalidExpiry)
+}
+
 func TestDataCredential_Type(t *testing.T) {
 	credential := DataCredential{}
 	assert.Equal(t, model.DataTypeCredential, credential.Type())
@@ -26,3 +42,62 @@ func TestDataText_Type(t *testing.T) {
 	text := DataText{}
 	assert.Equal(t, model.DataTypeText, text.Type())
 }
+
+func TestNewData(t *testing.T) {
+	dt, err := NewData(model.DataTypeCard)
+	assert.NoError(t, err)
+	assert.Equal(t, model.DataTypeCard, dt.Type())
+
+	_, err = NewData("UNKNOWN")
+	assert.ErrorIs(t, err, ErrUnknownDataType)
+}
+
+func TestNewDataInfo(t *testing.T) {
+	info := NewDataInfo(" Notes ", []string{"work", " ", "ssh"}, "/work//servers/", true)
+	assert.Equal(t, DataInfo{Title: "Notes", Tags: []string{"work", "ssh"}, Folder: "work/servers", Favorite: true}, info)
+}
+
+func TestDataFilter_Apply(t *testing.T) {
+	dt := map[int]DataTypeable{
+		0: &DataText{DataInfo: DataInfo{Folder: "work/servers", Tags: []string{"ssh"}, Favorite: true}},
+		1: &DataText{DataInfo: DataInfo{Folder: "workshop", Tags: []string{"ssh"}}},
+		2: &DataCredential{DataInfo: DataInfo{Folder: "work"}},
+	}
+
+	assert.Len(t, DataFilter{}.Apply(dt), 3)
+	assert.Equal(t, []int{0, 2}, keys(DataFilter{Folder: "work"}.Apply(dt)))
+	assert.Equal(t, []int{0, 1}, keys(DataFilter{Tag: "SSH"}.Apply(dt)))
+	assert.Equal(t, []int{0}, keys(DataFilter{Folder: "work", Favorites: true}.Apply(dt)))
+}
+
+func keys(dt map[int]DataTypeable) []int {
+	result := make([]int, 0, len(dt))
+	for index := range dt {
+		result = append(result, index)
+	}
+	sort.Ints(result)
+	return result
+}
+
+func TestDiff(t *testing.T) {
+	older := &DataCredential{
+		DataInfo: DataInfo{Title: "Mail", Fields: []DataField{{Name: "PIN", Type: FieldTypeHidden, Value: "1234"}}},
+		Login:    "ivan",
+		Password: "old",
+	}
+	newer := &DataCredential{
+		DataInfo: DataInfo{Title: "Mail", Favorite: true},
+		Login:    "ivan",
+		Password: "new",
+		URIs:     []DataURI{{URI: "example.com"}},
+	}
+
+	assert.Equal(t, []DataChange{
+		{Name: "favorite", Title: "Избранное", New: "да"},
+		{Name: "password", Title: "Пароль", Old: "old", New: "new", Secret: true},
+		{Name: "uris", Title: "Адреса", New: "example.com"},
+		{Name: "field:PIN", Title: "PIN", Old: "1234", Secret: true},
+	}, Diff(older, newer))
+
+	assert.Empty(t, Diff(newer, newer))
+}
@@ -0,0 +1,149 @@
+package model
+
+import (
+	"crypto/sha256"
+	"fmt"
+	"strings"
+)
+
+// DataChange структура изменения значения записи между двумя версиями.
+// Пустое значение Old означает, что значение добавлено, пустое New — что удалено.
+type DataChange struct {
+	Name   string
+	Title  string
+	Old    string
+	New    string
+	Secret bool
+}
+
+// dataValue структура значения записи для сравнения версий.
+type dataValue struct {
+	name   string
+	title  string
+	value  string
+	secret bool
+}
+
+// Diff возвращает изменения значений записи от версии older к версии newer:
+// сведений, основных полей, адресов, мета данных, пользовательских полей и вложений.
+// Содержимое файлов сравнивается по размеру и контрольной сумме.
+func Diff(older, newer DataTypeable) []DataChange {
+	oldValues, newValues := dataValues(older), dataValues(newer)
+
+	newByName := make(map[string]dataValue, len(newValues))
+	for _, value := range newValues {
+		newByName[value.name] = value
+	}
+
+	changes := make([]DataChange, 0)
+	seen := make(map[string]struct{}, len(oldValues))
+
+	for _, oldValue := range oldValues {
+		seen[oldValue.name] = struct{}{}
+
+		newValue := newByName[oldValue.name]
+		if newValue.value != oldValue.value {
+			changes = append(changes, DataChange{
+				Name:   oldValue.name,
+				Title:  oldValue.title,
+				Old:    oldValue.value,
+				New:    newValue.value,
+				Secret: oldValue.secret || newValue.secret,
+			})
+		}
+	}
+
+	for _, newValue := range newValues {
+		if _, ok := seen[newValue.name]; ok || newValue.value == "" {
+			continue
+		}
+
+		changes = append(changes, DataChange{
+			Name:   newValue.name,
+			Title:  newValue.title,
+			New:    newValue.value,
+			Secret: newValue.secret,
+		})
+	}
+
+	return changes
+}
+
+// dataValues возвращает сравниваемые значения записи в порядке печати.
+func dataValues(dt DataTypeable) []dataValue {
+	info := dt.Info()
+	values := []dataValue{
+		{name: "title", title: "Заголовок", value: info.Title},
+		{name: "folder", title: "Папка", value: info.Folder},
+		{name: "tags", title: "Теги", value: strings.Join(info.Tags, ", ")},
+		{name: "favorite", title: "Избранное"},
+	}
+
+	if info.Favorite {
+		values[3].value = "да"
+	}
+
+	spec, ok := LookupType(dt.Type())
+	if ok {
+		for _, field := range spec.Fields {
+			values = append(values, dataValue{
+				name:   field.Name,
+				title:  field.Title,
+				value:  field.Get(dt),
+				secret: field.Secret,
+			})
+		}
+	}
+
+	switch data := dt.(type) {
+	case *DataCredential:
+		values = append(values, uriValue(data.URIs))
+	case DataCredential:
+		values = append(values, uriValue(data.URIs))
+	case *DataDocument:
+		values = append(values, dataValue{name: "content", title: "Содержимое", value: fileValue(data.Content)})
+	case DataDocument:
+		values = append(values, dataValue{name: "content", title: "Содержимое", value: fileValue(data.Content)})
+	}
+
+	if ok {
+		values = append(values, dataValue{name: "meta", title: "Мета", value: strings.Join(spec.Meta(dt), ", ")})
+	}
+
+	for _, field := range info.Fields {
+		values = append(values, dataValue{
+			name:   "field:" + field.Name,
+			title:  field.Name,
+			value:  field.Value,
+			secret: field.Type == FieldTypeHidden,
+		})
+	}
+
+	for _, attachment := range Attachments(dt) {
+		values = append(values, dataValue{
+			name:  "attachment:" + attachment.Name,
+			title: "Вложение " + attachment.Name,
+			value: fileValue(attachment.Content),
+		})
+	}
+
+	return values
+}
+
+// uriValue возвращает сравниваемое значение адресов учетной записи.
+func uriValue(uris []DataURI) dataValue {
+	values := make([]string, 0, len(uris))
+	for _, uri := range uris {
+		values = append(values, uri.String())
+	}
+	return dataValue{name: "uris", title: "Адреса", value: strings.Join(values, ", ")}
+}
+
+// fileValue возвращает сравниваемое значение содержимого файла: размер и начало контрольной суммы.
+func fileValue(content []byte) string {
+	if len(content) == 0 {
+		return ""
+	}
+	sum := sha256.Sum256(content)
+	return fmt.Sprintf("%d Б, sha256 %x", len(content), sum[:4])
+}
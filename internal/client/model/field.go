@@ -0,0 +1,282 @@
+package model
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/casnerano/seckeep/internal/pkg/model"
+)
+
+// Основные ошибки при работе с пользовательскими полями и вложениями.
+var (
+	// ErrInvalidField некорректное пользовательское поле.
+	ErrInvalidField = errors.New("invalid custom field")
+
+	// ErrNotAttachable к записи этого типа нельзя прикреплять файлы.
+	ErrNotAttachable = errors.New("attachments are not supported for this data type")
+
+	// ErrNotEditable запись нельзя изменить (передана по значению).
+	ErrNotEditable = errors.New("data is not editable")
+)
+
+// FieldType тип пользовательского поля.
+type FieldType string
+
+// Варианты типов пользовательских полей.
+const (
+	// FieldTypeText текстовое поле.
+	FieldTypeText FieldType = "TEXT"
+
+	// FieldTypeHidden скрытое поле (PIN, ответ на секретный вопрос), при печати маскируется.
+	FieldTypeHidden FieldType = "HIDDEN"
+
+	// FieldTypeBoolean логическое поле со значением "true" или "false".
+	FieldTypeBoolean FieldType = "BOOLEAN"
+
+	// FieldTypeLinked ссылка на основное поле записи (например, password), значение — имя этого поля.
+	FieldTypeLinked FieldType = "LINKED"
+)
+
+// IsValid проверяет на валидность тип поля.
+func (t FieldType) IsValid() bool {
+	switch t {
+	case FieldTypeText, FieldTypeHidden, FieldTypeBoolean, FieldTypeLinked:
+		return true
+	}
+	return false
+}
+
+// DataField структура пользовательского поля записи.
+type DataField struct {
+	Name  string    `json:"name" validate:"required"`
+	Type  FieldType `json:"type" validate:"required,enum"`
+	Value string    `json:"value"`
+}
+
+// ParseDataField разбирает поле в формате "[тип:]имя=значение", например "hidden:PIN=1234".
+// Если тип не указан, используется FieldTypeText.
+func ParseDataField(s string) (DataField, error) {
+	field := DataField{Type: FieldTypeText}
+
+	if kind, rest, ok := strings.Cut(s, ":"); ok {
+		if fieldType := FieldType(strings.ToUpper(kind)); fieldType.IsValid() {
+			field.Type = fieldType
+			s = rest
+		}
+	}
+
+	name, value, ok := strings.Cut(s, "=")
+	if !ok || strings.TrimSpace(name) == "" {
+		return DataField{}, fmt.Errorf("%w: expected [type:]name=value, got %q", ErrInvalidField, s)
+	}
+
+	field.Name = strings.TrimSpace(name)
+	field.Value = value
+
+	return field, nil
+}
+
+// CheckFields проверяет пользовательские поля записи: уникальность имен,
+// значения логических полей и существование основных полей, на которые ссылаются поля-ссылки.
+func CheckFields(dt DataTypeable) error {
+	names := make(map[string]struct{})
+
+	for _, field := range dt.Info().Fields {
+		if _, ok := names[field.Name]; ok {
+			return fmt.Errorf("%w: duplicate name %q", ErrInvalidField, field.Name)
+		}
+		names[field.Name] = struct{}{}
+
+		switch field.Type {
+		case FieldTypeBoolean:
+			if _, err := strconv.ParseBool(field.Value); err != nil {
+				return fmt.Errorf("%w: %q is not a boolean", ErrInvalidField, field.Name)
+			}
+		case FieldTypeLinked:
+			if !isLinkTarget(dt.Type(), field.Value) {
+				return fmt.Errorf(
+					"%w: %q links to unknown field %q, available: %s",
+					ErrInvalidField,
+					field.Name,
+					field.Value,
+					strings.Join(linkTargets(dt.Type()), ", "),
+				)
+			}
+		}
+	}
+
+	return nil
+}
+
+// linkTargets возвращает имена основных полей записи типа dataType,
+// на которые могут ссылаться поля типа FieldTypeLinked.
+func linkTargets(dataType model.DataType) []string {
+	spec, ok := LookupType(dataType)
+	if !ok {
+		return nil
+	}
+
+	names := make([]string, 0, len(spec.Fields))
+	for _, field := range spec.Fields {
+		names = append(names, field.Name)
+	}
+	return names
+}
+
+// isLinkTarget проверяет, что на основное поле target записи типа dataType можно сослаться.
+func isLinkTarget(dataType model.DataType, target string) bool {
+	for _, name := range linkTargets(dataType) {
+		if name == target {
+			return true
+		}
+	}
+	return false
+}
+
+// fieldEditor интерфейс изменения пользовательских полей записи.
+type fieldEditor interface {
+	setField(field DataField)
+	removeField(name string) bool
+}
+
+// infoEditor интерфейс замены сведений о записи.
+type infoEditor interface {
+	setInfo(info DataInfo)
+}
+
+// setInfo метод заменяет сведения о записи.
+func (i *DataInfo) setInfo(info DataInfo) {
+	*i = info
+}
+
+// SetInfo заменяет сведения о записи (заголовок, теги, папку, отметку избранного и пользовательские поля).
+func SetInfo(dt DataTypeable, info DataInfo) error {
+	editor, ok := dt.(infoEditor)
+	if !ok {
+		return ErrNotEditable
+	}
+
+	editor.setInfo(info)
+	return nil
+}
+
+// setField метод добавляет поле или заменяет поле с тем же именем.
+func (i *DataInfo) setField(field DataField) {
+	for index := range i.Fields {
+		if i.Fields[index].Name == field.Name {
+			i.Fields[index] = field
+			return
+		}
+	}
+	i.Fields = append(i.Fields, field)
+}
+
+// removeField метод удаляет поле по имени, возвращает false, если поля нет.
+func (i *DataInfo) removeField(name string) bool {
+	for index := range i.Fields {
+		if i.Fields[index].Name == name {
+			i.Fields = append(i.Fields[:index], i.Fields[index+1:]...)
+			return true
+		}
+	}
+	return false
+}
+
+// SetField добавляет в запись пользовательское поле или заменяет поле с тем же именем.
+func SetField(dt DataTypeable, field DataField) error {
+	editor, ok := dt.(fieldEditor)
+	if !ok {
+		return ErrNotEditable
+	}
+
+	editor.setField(field)
+	return nil
+}
+
+// RemoveField удаляет из записи пользовательское поле по имени.
+func RemoveField(dt DataTypeable, name string) error {
+	editor, ok := dt.(fieldEditor)
+	if !ok {
+		return ErrNotEditable
+	}
+
+	if !editor.removeField(name) {
+		return fmt.Errorf("%w: field %q not found", ErrInvalidField, name)
+	}
+	return nil
+}
+
+// DataAttachment структура файла, прикрепленного к записи.
+type DataAttachment struct {
+	Name    string `json:"name" validate:"required"`
+	Content []byte `json:"content"`
+}
+
+// Attachments возвращает файлы, прикрепленные к записи.
+func Attachments(dt DataTypeable) []DataAttachment {
+	switch data := dt.(type) {
+	case *DataCredential:
+		return data.Attachments
+	case DataCredential:
+		return data.Attachments
+	case *DataCard:
+		return data.Attachments
+	case DataCard:
+		return data.Attachments
+	}
+	return nil
+}
+
+// Attach прикрепляет к записи файл или заменяет файл с тем же именем.
+// Файлы можно прикреплять к учетным записям и банковским картам.
+func Attach(dt DataTypeable, attachment DataAttachment) error {
+	var attachments *[]DataAttachment
+
+	switch data := dt.(type) {
+	case *DataCredential:
+		attachments = &data.Attachments
+	case *DataCard:
+		attachments = &data.Attachments
+	case DataCredential, DataCard:
+		return ErrNotEditable
+	default:
+		return ErrNotAttachable
+	}
+
+	for index := range *attachments {
+		if (*attachments)[index].Name == attachment.Name {
+			(*attachments)[index] = attachment
+			return nil
+		}
+	}
+
+	*attachments = append(*attachments, attachment)
+	return nil
+}
+
+// Detach удаляет из записи прикрепленный файл по имени.
+func Detach(dt DataTypeable, name string) error {
+	var attachments *[]DataAttachment
+
+	switch data := dt.(type) {
+	case *DataCredential:
+		attachments = &data.Attachments
+	case *DataCard:
+		attachments = &data.Attachments
+	case DataCredential, DataCard:
+		return ErrNotEditable
+	default:
+		return ErrNotAttachable
+	}
+
+	for index := range *attachments {
+		if (*attachments)[index].Name == name {
+			*attachments = append((*attachments)[:index], (*attachments)[index+1:]...)
+			return nil
+		}
+	}
+
+	return fmt.Errorf("attachment %q not found", name)
+}
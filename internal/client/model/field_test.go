@@ -0,0 +1,73 @@
+package model
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseDataField(t *testing.T) {
+	field, err := ParseDataField("hidden:PIN=12=34")
+	assert.NoError(t, err)
+	assert.Equal(t, DataField{Name: "PIN", Type: FieldTypeHidden, Value: "12=34"}, field)
+
+	field, err = ParseDataField("Question: first pet=Rex")
+	assert.NoError(t, err)
+	assert.Equal(t, DataField{Name: "Question: first pet", Type: FieldTypeText, Value: "Rex"}, field)
+
+	_, err = ParseDataField("boolean:=true")
+	assert.ErrorIs(t, err, ErrInvalidField)
+}
+
+func TestCheckFields(t *testing.T) {
+	tests := []struct {
+		name    string
+		fields  []DataField
+		wantErr bool
+	}{
+		{"Valid", []DataField{{Name: "2FA", Type: FieldTypeBoolean, Value: "false"}, {Name: "user", Type: FieldTypeLinked, Value: "login"}}, false},
+		{"Duplicate name", []DataField{{Name: "a", Type: FieldTypeText}, {Name: "a", Type: FieldTypeHidden}}, true},
+		{"Invalid boolean", []DataField{{Name: "2FA", Type: FieldTypeBoolean, Value: "maybe"}}, true},
+		{"Unknown link", []DataField{{Name: "card", Type: FieldTypeLinked, Value: "cvv"}}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := CheckFields(&DataCredential{DataInfo: DataInfo{Fields: tt.fields}})
+			if tt.wantErr {
+				assert.ErrorIs(t, err, ErrInvalidField)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestSetRemoveField(t *testing.T) {
+	text := &DataText{}
+	assert.NoError(t, SetField(text, DataField{Name: "a", Type: FieldTypeText, Value: "1"}))
+	assert.NoError(t, SetField(text, DataField{Name: "a", Type: FieldTypeText, Value: "2"}))
+	assert.Equal(t, []DataField{{Name: "a", Type: FieldTypeText, Value: "2"}}, text.Fields)
+
+	assert.NoError(t, RemoveField(text, "a"))
+	assert.ErrorIs(t, RemoveField(text, "a"), ErrInvalidField)
+	assert.ErrorIs(t, SetField(DataText{}, DataField{Name: "a"}), ErrNotEditable)
+}
+
+func TestSetInfo(t *testing.T) {
+	note := &DataNote{Body: "text"}
+	assert.NoError(t, SetInfo(note, DataInfo{Title: "Note", Tags: []string{"a"}}))
+	assert.Equal(t, &DataNote{DataInfo: DataInfo{Title: "Note", Tags: []string{"a"}}, Body: "text"}, note)
+	assert.ErrorIs(t, SetInfo(DataNote{}, DataInfo{}), ErrNotEditable)
+}
+
+func TestAttachDetach(t *testing.T) {
+	card := &DataCard{}
+	assert.NoError(t, Attach(card, DataAttachment{Name: "a.pdf", Content: []byte("1")}))
+	assert.NoError(t, Attach(card, DataAttachment{Name: "a.pdf", Content: []byte("2")}))
+	assert.Equal(t, []DataAttachment{{Name: "a.pdf", Content: []byte("2")}}, Attachments(card))
+
+	assert.NoError(t, Detach(card, "a.pdf"))
+	assert.Error(t, Detach(card, "a.pdf"))
+	assert.ErrorIs(t, Attach(&DataDocument{}, DataAttachment{Name: "a.pdf"}), ErrNotAttachable)
+}
@@ -0,0 +1,214 @@
+package model
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/casnerano/seckeep/internal/pkg/model"
+)
+
+// ErrTypeRegistered тип данных уже зарегистрирован.
+var ErrTypeRegistered = errors.New("data type is already registered")
+
+// Опции тега field основного поля типа данных.
+const (
+	// fieldOptionSecret значение скрывается в списке записей.
+	fieldOptionSecret = "secret"
+
+	// fieldOptionMultiline многострочное значение, печатается блоком и не задается интерактивно.
+	fieldOptionMultiline = "multiline"
+
+	// fieldOptionFile значение можно прочитать из файла (флаг --<имя>-file).
+	fieldOptionFile = "file"
+
+	// fieldOptionMarkdown значение в формате Markdown.
+	fieldOptionMarkdown = "markdown"
+
+	// fieldOptionSearch значение участвует в поиске.
+	fieldOptionSearch = "search"
+)
+
+// FieldSpec описание основного поля типа данных.
+// Описание строится по тегам строкового поля структуры:
+// json — имя поля, validate — правила проверки, field — название и опции, например
+// `field:"Закрытый ключ,secret,multiline,file"`.
+type FieldSpec struct {
+	Name      string
+	Title     string
+	Required  bool
+	Secret    bool
+	Multiline bool
+	File      bool
+	Markdown  bool
+	Search    bool
+	index     []int
+}
+
+// Flag возвращает имя флага командной строки для поля.
+func (f FieldSpec) Flag() string {
+	return strings.ReplaceAll(f.Name, "_", "-")
+}
+
+// Get возвращает значение поля записи.
+func (f FieldSpec) Get(dt DataTypeable) string {
+	return reflect.Indirect(reflect.ValueOf(dt)).FieldByIndex(f.index).String()
+}
+
+// Set устанавливает значение поля записи, запись должна быть передана по указателю.
+func (f FieldSpec) Set(dt DataTypeable, value string) error {
+	v := reflect.ValueOf(dt)
+	if v.Kind() != reflect.Pointer {
+		return ErrNotEditable
+	}
+
+	v.Elem().FieldByIndex(f.index).SetString(value)
+	return nil
+}
+
+// TypeSpec описание типа данных в реестре.
+// Структура типа должна встраивать DataInfo и содержать поле Meta []string.
+type TypeSpec struct {
+	// Type тип данных.
+	Type model.DataType
+
+	// Command имя подкоманды создания записи.
+	Command string
+
+	// Title название группы записей в списке.
+	Title string
+
+	// New конструктор пустой записи (по указателю).
+	New func() DataTypeable
+
+	// Fields основные поля, заполняется при регистрации по тегам структуры.
+	Fields []FieldSpec
+}
+
+// Field возвращает описание основного поля по имени.
+func (s TypeSpec) Field(name string) (FieldSpec, bool) {
+	for _, field := range s.Fields {
+		if field.Name == name {
+			return field, true
+		}
+	}
+	return FieldSpec{}, false
+}
+
+// Meta возвращает мета данные записи.
+func (s TypeSpec) Meta(dt DataTypeable) []string {
+	meta, _ := reflect.Indirect(reflect.ValueOf(dt)).FieldByName("Meta").Interface().([]string)
+	return meta
+}
+
+// SetMeta устанавливает мета данные записи, запись должна быть передана по указателю.
+func (s TypeSpec) SetMeta(dt DataTypeable, meta []string) error {
+	v := reflect.ValueOf(dt)
+	if v.Kind() != reflect.Pointer {
+		return ErrNotEditable
+	}
+
+	v.Elem().FieldByName("Meta").Set(reflect.ValueOf(meta))
+	return nil
+}
+
+// Build создает запись со сведениями info, мета данными meta и значениями основных полей values.
+func (s TypeSpec) Build(info DataInfo, meta []string, values map[string]string) (DataTypeable, error) {
+	dt := s.New()
+
+	v := reflect.ValueOf(dt).Elem()
+	v.FieldByName("DataInfo").Set(reflect.ValueOf(info))
+	v.FieldByName("Meta").Set(reflect.ValueOf(meta))
+
+	for name, value := range values {
+		field, ok := s.Field(name)
+		if !ok {
+			return nil, fmt.Errorf("unknown field %q of data type %s", name, s.Type)
+		}
+		if err := field.Set(dt, value); err != nil {
+			return nil, err
+		}
+	}
+
+	return dt, nil
+}
+
+// registry реестр типов данных в порядке регистрации.
+var registry []TypeSpec
+
+// RegisterType регистрирует тип данных.
+// Описания основных полей строятся по тегам структуры, возвращаемой spec.New.
+func RegisterType(spec TypeSpec) error {
+	if _, ok := LookupType(spec.Type); ok {
+		return fmt.Errorf("%w: %s", ErrTypeRegistered, spec.Type)
+	}
+
+	spec.Fields = fieldSpecs(reflect.TypeOf(spec.New()).Elem())
+	registry = append(registry, spec)
+
+	return nil
+}
+
+// LookupType возвращает описание зарегистрированного типа данных.
+func LookupType(dataType model.DataType) (TypeSpec, bool) {
+	for _, spec := range registry {
+		if spec.Type == dataType {
+			return spec, true
+		}
+	}
+	return TypeSpec{}, false
+}
+
+// Types возвращает описания зарегистрированных типов данных в порядке регистрации.
+func Types() []TypeSpec {
+	return append([]TypeSpec{}, registry...)
+}
+
+// fieldSpecs строит описания основных полей по тегам строковых полей структуры t.
+func fieldSpecs(t reflect.Type) []FieldSpec {
+	specs := make([]FieldSpec, 0)
+
+	for i := 0; i < t.NumField(); i++ {
+		structField := t.Field(i)
+
+		tag, ok := structField.Tag.Lookup("field")
+		if !ok || structField.Type.Kind() != reflect.String {
+			continue
+		}
+
+		options := strings.Split(tag, ",")
+		spec := FieldSpec{
+			Name:     strings.Split(structField.Tag.Get("json"), ",")[0],
+			Title:    options[0],
+			Required: strings.Contains(structField.Tag.Get("validate"), "required"),
+			index:    structField.Index,
+		}
+
+		for _, option := range options[1:] {
+			switch option {
+			case fieldOptionSecret:
+				spec.Secret = true
+			case fieldOptionMultiline:
+				spec.Multiline = true
+			case fieldOptionFile:
+				spec.File = true
+			case fieldOptionMarkdown:
+				spec.Markdown = true
+			case fieldOptionSearch:
+				spec.Search = true
+			}
+		}
+
+		specs = append(specs, spec)
+	}
+
+	return specs
+}
+
+// mustRegisterType регистрирует встроенный тип данных, повторная регистрация — ошибка программы.
+func mustRegisterType(spec TypeSpec) {
+	if err := RegisterType(spec); err != nil {
+		panic(err)
+	}
+}
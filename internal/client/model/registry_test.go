@@ -0,0 +1,61 @@
+package model
+
+import (
+	"testing"
+
+	"github.com/casnerano/seckeep/internal/pkg/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRegisterType(t *testing.T) {
+	err := RegisterType(TypeSpec{Type: model.DataTypeCredential, New: func() DataTypeable { return &DataCredential{} }})
+	assert.ErrorIs(t, err, ErrTypeRegistered)
+}
+
+func TestLookupType(t *testing.T) {
+	spec, ok := LookupType(model.DataTypeSSHKey)
+	require.True(t, ok)
+	assert.Equal(t, "ssh-key", spec.Command)
+
+	field, ok := spec.Field("private_key")
+	require.True(t, ok)
+	assert.Equal(t, "private-key", field.Flag())
+	assert.True(t, field.Required)
+	assert.True(t, field.Secret)
+	assert.True(t, field.Multiline)
+	assert.True(t, field.File)
+	assert.False(t, field.Search)
+
+	_, ok = LookupType("UNKNOWN")
+	assert.False(t, ok)
+}
+
+func TestTypes(t *testing.T) {
+	for _, spec := range Types() {
+		dt, err := NewData(spec.Type)
+		require.NoError(t, err)
+		assert.Equal(t, spec.Type, dt.Type())
+		assert.NotEmpty(t, spec.Fields, spec.Type)
+	}
+}
+
+func TestTypeSpec_Build(t *testing.T) {
+	spec, ok := LookupType(model.DataTypeWiFi)
+	require.True(t, ok)
+
+	dt, err := spec.Build(DataInfo{Title: "Home"}, []string{"router"}, map[string]string{"ssid": "home-5g", "password": "secret"})
+	require.NoError(t, err)
+	assert.Equal(t, &DataWiFi{DataInfo: DataInfo{Title: "Home"}, SSID: "home-5g", Password: "secret", Meta: []string{"router"}}, dt)
+	assert.Equal(t, []string{"router"}, spec.Meta(dt))
+
+	require.NoError(t, spec.SetMeta(dt, []string{"office"}))
+	assert.Equal(t, []string{"office"}, spec.Meta(dt))
+	assert.ErrorIs(t, spec.SetMeta(DataWiFi{}, nil), ErrNotEditable)
+
+	_, err = spec.Build(DataInfo{}, nil, map[string]string{"unknown": "value"})
+	assert.Error(t, err)
+
+	field, _ := spec.Field("ssid")
+	assert.ErrorIs(t, field.Set(DataWiFi{}, "other"), ErrNotEditable)
+}
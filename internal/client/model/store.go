@@ -9,6 +9,7 @@ import (
 // StoreData структура записи в локальном хранилище.
 type StoreData struct {
 	UUID      string         `json:"uuid,omitempty"`
+	VaultUUID string         `json:"vault_uuid,omitempty"`
 	Type      model.DataType `json:"type"`
 	Value     []byte         `json:"value"`
 	Version   time.Time      `json:"version"`
@@ -0,0 +1,159 @@
+package model
+
+import "github.com/casnerano/seckeep/internal/pkg/model"
+
+func init() {
+	mustRegisterType(TypeSpec{
+		Type:    model.DataTypeCredential,
+		Command: "credential",
+		Title:   "Учетные записи",
+		New:     func() DataTypeable { return &DataCredential{} },
+	})
+	mustRegisterType(TypeSpec{
+		Type:    model.DataTypeText,
+		Command: "text",
+		Title:   "Текстовые данные",
+		New:     func() DataTypeable { return &DataText{} },
+	})
+	mustRegisterType(TypeSpec{
+		Type:    model.DataTypeCard,
+		Command: "card",
+		Title:   "Данные кредитных карт",
+		New:     func() DataTypeable { return &DataCard{} },
+	})
+	mustRegisterType(TypeSpec{
+		Type:    model.DataTypeDocument,
+		Command: "document",
+		Title:   "Документы",
+		New:     func() DataTypeable { return &DataDocument{} },
+	})
+	mustRegisterType(TypeSpec{
+		Type:    model.DataTypeSSHKey,
+		Command: "ssh-key",
+		Title:   "SSH-ключи",
+		New:     func() DataTypeable { return &DataSSHKey{} },
+	})
+	mustRegisterType(TypeSpec{
+		Type:    model.DataTypeAPIToken,
+		Command: "api-token",
+		Title:   "API-токены",
+		New:     func() DataTypeable { return &DataAPIToken{} },
+	})
+	mustRegisterType(TypeSpec{
+		Type:    model.DataTypeIdentity,
+		Command: "identity",
+		Title:   "Личные данные",
+		New:     func() DataTypeable { return &DataIdentity{} },
+	})
+	mustRegisterType(TypeSpec{
+		Type:    model.DataTypeNote,
+		Command: "note",
+		Title:   "Заметки",
+		New:     func() DataTypeable { return &DataNote{} },
+	})
+	mustRegisterType(TypeSpec{
+		Type:    model.DataTypeWiFi,
+		Command: "wifi",
+		Title:   "Сети Wi-Fi",
+		New:     func() DataTypeable { return &DataWiFi{} },
+	})
+	mustRegisterType(TypeSpec{
+		Type:    model.DataTypeCryptoWallet,
+		Command: "crypto-wallet",
+		Title:   "Криптокошельки",
+		New:     func() DataTypeable { return &DataCryptoWallet{} },
+	})
+}
+
+// DataSSHKey структура SSH-ключа.
+type DataSSHKey struct {
+	DataInfo
+	Host       string   `json:"host" field:"Хост,search"`
+	User       string   `json:"user" field:"Пользователь,search"`
+	PrivateKey string   `json:"private_key" validate:"required" field:"Закрытый ключ,secret,multiline,file"`
+	PublicKey  string   `json:"public_key" field:"Открытый ключ,multiline,file"`
+	Passphrase string   `json:"passphrase" field:"Парольная фраза,secret"`
+	Meta       []string `json:"meta"`
+}
+
+// Type возвращает тип структуры.
+func (c DataSSHKey) Type() model.DataType {
+	return model.DataTypeSSHKey
+}
+
+// DataAPIToken структура токена доступа к API.
+type DataAPIToken struct {
+	DataInfo
+	Service   string   `json:"service" validate:"required" field:"Сервис,search"`
+	Token     string   `json:"token" validate:"required" field:"Токен,secret,file"`
+	Scopes    string   `json:"scopes" field:"Права,search"`
+	ExpiresAt string   `json:"expires_at" validate:"omitempty,datetime=2006-01-02" field:"Действует до"`
+	Meta      []string `json:"meta"`
+}
+
+// Type возвращает тип структуры.
+func (c DataAPIToken) Type() model.DataType {
+	return model.DataTypeAPIToken
+}
+
+// DataIdentity структура личных данных.
+type DataIdentity struct {
+	DataInfo
+	FullName         string   `json:"full_name" validate:"required" field:"ФИО,search"`
+	BirthDate        string   `json:"birth_date" validate:"omitempty,datetime=2006-01-02" field:"Дата рождения"`
+	Email            string   `json:"email" validate:"omitempty,email" field:"E-mail,search"`
+	Phone            string   `json:"phone" validate:"omitempty,e164" field:"Телефон,search"`
+	Address          string   `json:"address" field:"Адрес,search"`
+	PassportNumber   string   `json:"passport_number" field:"Номер паспорта,secret"`
+	PassportIssuedBy string   `json:"passport_issued_by" field:"Кем выдан"`
+	PassportIssuedAt string   `json:"passport_issued_at" validate:"omitempty,datetime=2006-01-02" field:"Дата выдачи"`
+	Meta             []string `json:"meta"`
+}
+
+// Type возвращает тип структуры.
+func (c DataIdentity) Type() model.DataType {
+	return model.DataTypeIdentity
+}
+
+// DataNote структура защищенной заметки, текст в формате Markdown.
+// Название заметки — заголовок записи (DataInfo.Title).
+type DataNote struct {
+	DataInfo
+	Body string   `json:"body" validate:"required" field:"Текст,multiline,markdown,file,search"`
+	Meta []string `json:"meta"`
+}
+
+// Type возвращает тип структуры.
+func (c DataNote) Type() model.DataType {
+	return model.DataTypeNote
+}
+
+// DataWiFi структура сети Wi-Fi.
+type DataWiFi struct {
+	DataInfo
+	SSID     string   `json:"ssid" validate:"required" field:"Сеть (SSID),search"`
+	Password string   `json:"password" field:"Пароль,secret"`
+	Security string   `json:"security" validate:"omitempty,oneof=WPA3 WPA2 WPA WEP NONE" field:"Защита"`
+	Meta     []string `json:"meta"`
+}
+
+// Type возвращает тип структуры.
+func (c DataWiFi) Type() model.DataType {
+	return model.DataTypeWiFi
+}
+
+// DataCryptoWallet структура криптовалютного кошелька.
+type DataCryptoWallet struct {
+	DataInfo
+	Currency       string   `json:"currency" validate:"required" field:"Валюта,search"`
+	Address        string   `json:"address" field:"Адрес,search"`
+	SeedPhrase     string   `json:"seed_phrase" validate:"required" field:"Seed-фраза,secret,file"`
+	Passphrase     string   `json:"passphrase" field:"Дополнительная фраза,secret"`
+	DerivationPath string   `json:"derivation_path" field:"Путь деривации"`
+	Meta           []string `json:"meta"`
+}
+
+// Type возвращает тип структуры.
+func (c DataCryptoWallet) Type() model.DataType {
+	return model.DataTypeCryptoWallet
+}
@@ -0,0 +1,63 @@
+package model
+
+import "strings"
+
+// URIMatch режим сопоставления адреса учетной записи с адресом сайта.
+type URIMatch string
+
+// Варианты режимов сопоставления адресов.
+const (
+	// URIMatchDomain совпадает базовый домен (example.com для login.example.com). Режим по умолчанию.
+	URIMatchDomain URIMatch = "DOMAIN"
+
+	// URIMatchHost совпадает хост и порт.
+	URIMatchHost URIMatch = "HOST"
+
+	// URIMatchExact совпадает адрес целиком (без учета фрагмента и завершающего слеша).
+	URIMatchExact URIMatch = "EXACT"
+
+	// URIMatchRegex адрес сайта соответствует регулярному выражению.
+	URIMatchRegex URIMatch = "REGEX"
+)
+
+// IsValid проверяет на валидность режим сопоставления.
+func (m URIMatch) IsValid() bool {
+	switch m {
+	case URIMatchDomain, URIMatchHost, URIMatchExact, URIMatchRegex:
+		return true
+	}
+	return false
+}
+
+// DataURI структура адреса сайта учетной записи.
+type DataURI struct {
+	URI   string   `json:"uri" validate:"required"`
+	Match URIMatch `json:"match,omitempty" validate:"omitempty,enum"`
+}
+
+// ParseDataURI разбирает адрес в формате "[режим=]адрес", например "host=https://example.com".
+// Если режим не указан, используется URIMatchDomain.
+func ParseDataURI(s string) DataURI {
+	if mode, uri, ok := strings.Cut(s, "="); ok {
+		if match := URIMatch(strings.ToUpper(mode)); match.IsValid() {
+			return DataURI{URI: uri, Match: match}
+		}
+	}
+	return DataURI{URI: s, Match: URIMatchDomain}
+}
+
+// MatchMode возвращает режим сопоставления адреса, по умолчанию — URIMatchDomain.
+func (u DataURI) MatchMode() URIMatch {
+	if u.Match == "" {
+		return URIMatchDomain
+	}
+	return u.Match
+}
+
+// String возвращает адрес в формате "[режим=]адрес", обратном ParseDataURI.
+func (u DataURI) String() string {
+	if u.MatchMode() == URIMatchDomain {
+		return u.URI
+	}
+	return strings.ToLower(string(u.Match)) + "=" + u.URI
+}
@@ -0,0 +1,27 @@
+package model
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseDataURI(t *testing.T) {
+	tests := []struct {
+		name  string
+		value string
+		want  DataURI
+	}{
+		{"Default mode", "https://example.com/?a=b", DataURI{URI: "https://example.com/?a=b", Match: URIMatchDomain}},
+		{"Host mode", "host=https://example.com", DataURI{URI: "https://example.com", Match: URIMatchHost}},
+		{"Regex mode", `REGEX=^https://(www\.)?example\.com/`, DataURI{URI: `^https://(www\.)?example\.com/`, Match: URIMatchRegex}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			uri := ParseDataURI(tt.value)
+			assert.Equal(t, tt.want, uri)
+			assert.Equal(t, uri, ParseDataURI(uri.String()))
+		})
+	}
+}
@@ -4,7 +4,9 @@ import (
 	"errors"
 	"fmt"
 	"net/http"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/casnerano/seckeep/internal/server/model"
 	"github.com/go-resty/resty/v2"
@@ -17,19 +19,54 @@ var (
 
 	// ErrUserRegistered пользователь зарегистрирован.
 	ErrUserRegistered = errors.New("user is registered")
+
+	// ErrUnauthorized отсутствует авторизация.
+	ErrUnauthorized = errors.New("unauthorized")
+
+	// ErrSessionNotRevoked сессию не удалось отозвать на сервере.
+	ErrSessionNotRevoked = errors.New("session is not revoked on server")
+
+	// ErrTooManyRequests превышен лимит попыток.
+	ErrTooManyRequests = errors.New("too many requests")
+
+	// ErrKeysNotReady авторизация выполнена, но ключи пользователя не удалось получить или опубликовать.
+	ErrKeysNotReady = errors.New("signed in, but user keys are not ready")
 )
 
+// KeySetup интерфейс настройки ключей пользователя после авторизации.
+type KeySetup interface {
+	Setup() error
+}
+
+// TooManyRequestsError ошибка превышения лимита попыток с временем ожидания до следующей попытки.
+type TooManyRequestsError struct {
+	RetryAfter time.Duration
+}
+
+// Error возвращает текст ошибки.
+func (e *TooManyRequestsError) Error() string {
+	return fmt.Sprintf("%s, retry after %s", ErrTooManyRequests, e.RetryAfter)
+}
+
+// Is позволяет сравнивать ошибку с ErrTooManyRequests.
+func (e *TooManyRequestsError) Is(target error) bool {
+	return target == ErrTooManyRequests
+}
+
 // Account структура для авторизации и регистрации пользователя на сервере.
 type Account struct {
 	client   *resty.Client
-	tokenJar *TokenJar
+	tokenJar TokenStore
+	keys     KeySetup
 }
 
 // New конструктор.
-func New(client *resty.Client, tokenJar *TokenJar) *Account {
+// После авторизации ключи пользователя загружаются с сервера или генерируются и публикуются (keys).
+func New(client *resty.Client, tokenJar TokenStore, keys KeySetup) *Account {
 	return &Account{
 		client:   client,
 		tokenJar: tokenJar,
+		keys:     keys,
 	}
 }
 
@@ -50,8 +87,10 @@ func (a Account) SignUp(login, password, fullName string) error {
 		return fmt.Errorf("incorrect values: %w", errors.New(string(response.Body())))
 	case http.StatusConflict:
 		return ErrUserRegistered
+	case http.StatusTooManyRequests:
+		return newTooManyRequestsError(response.Header())
 	case http.StatusOK:
-		return a.flushHeaderToken(response.Header())
+		return a.signedIn(response.Header())
 	}
 
 	return fmt.Errorf("internal server error: %w", errors.New(response.Status()))
@@ -70,6 +109,69 @@ func (a Account) SignIn(login, password string) error {
 		return fmt.Errorf("incorrect values: %w", errors.New(string(response.Body())))
 	case http.StatusUnauthorized:
 		return ErrIncorrectCredentials
+	case http.StatusTooManyRequests:
+		return newTooManyRequestsError(response.Header())
+	case http.StatusOK:
+		return a.signedIn(response.Header())
+	}
+
+	return fmt.Errorf("internal server error: %w", errors.New(response.Status()))
+}
+
+// SignOut метод выхода из аккаунта.
+// Сессия отзывается на сервере с сохраненным токеном, без токена отзывать нечего.
+// Локальный токен удаляется в любом случае, даже если сервер недоступен или отклонил токен и сессию не удалось отозвать.
+func (a Account) SignOut() error {
+	token, err := a.tokenJar.ReadToken()
+	if errors.Is(err, ErrTokenNotFound) {
+		return a.clearToken()
+	}
+
+	request := a.client.R()
+	if err == nil {
+		request.SetAuthToken(token)
+	}
+	response, err := request.Post("/user/logout")
+
+	if clearErr := a.clearToken(); clearErr != nil {
+		return clearErr
+	}
+
+	if err != nil {
+		return fmt.Errorf("%w: %s", ErrSessionNotRevoked, err.Error())
+	}
+
+	if response.StatusCode() == http.StatusOK {
+		return nil
+	}
+
+	return fmt.Errorf("%w: %s", ErrSessionNotRevoked, response.Status())
+}
+
+// clearToken метод удаляет локальный токен.
+func (a Account) clearToken() error {
+	if err := a.tokenJar.Clear(); err != nil {
+		return fmt.Errorf("token jar error: %w", err)
+	}
+	return nil
+}
+
+// ChangePassword метод смены пароля.
+// Сервер инвалидирует ранее выданные токены, новый токен сохраняется локально.
+func (a Account) ChangePassword(oldPassword, newPassword string) error {
+	body := model.UserChangePasswordRequest{OldPassword: oldPassword, NewPassword: newPassword}
+	response, err := a.client.R().SetBody(body).Put("/user/password")
+	if err != nil {
+		return err
+	}
+
+	switch response.StatusCode() {
+	case http.StatusBadRequest:
+		return fmt.Errorf("incorrect values: %w", errors.New(string(response.Body())))
+	case http.StatusUnauthorized:
+		return ErrUnauthorized
+	case http.StatusForbidden:
+		return ErrIncorrectCredentials
 	case http.StatusOK:
 		return a.flushHeaderToken(response.Header())
 	}
@@ -77,6 +179,66 @@ func (a Account) SignIn(login, password string) error {
 	return fmt.Errorf("internal server error: %w", errors.New(response.Status()))
 }
 
+// Delete метод удаления аккаунта вместе со всеми данными на сервере.
+func (a Account) Delete(password string) error {
+	body := model.UserDeleteRequest{Password: password}
+	response, err := a.client.R().SetBody(body).Delete("/user")
+	if err != nil {
+		return err
+	}
+
+	switch response.StatusCode() {
+	case http.StatusBadRequest:
+		return fmt.Errorf("incorrect values: %w", errors.New(string(response.Body())))
+	case http.StatusUnauthorized:
+		return ErrUnauthorized
+	case http.StatusForbidden:
+		return ErrIncorrectCredentials
+	case http.StatusOK:
+		if err = a.tokenJar.Clear(); err != nil {
+			return fmt.Errorf("token jar error: %w", err)
+		}
+		return nil
+	}
+
+	return fmt.Errorf("internal server error: %w", errors.New(response.Status()))
+}
+
+// Export метод выгружает все данные аккаунта с сервера.
+func (a Account) Export() (*model.AccountExport, error) {
+	export := &model.AccountExport{}
+	response, err := a.client.R().SetResult(export).Get("/user/export")
+	if err != nil {
+		return nil, err
+	}
+
+	switch response.StatusCode() {
+	case http.StatusUnauthorized:
+		return nil, ErrUnauthorized
+	case http.StatusOK:
+		return export, nil
+	}
+
+	return nil, fmt.Errorf("internal server error: %w", errors.New(response.Status()))
+}
+
+// signedIn метод сохраняет токен из заголовков и настраивает ключи пользователя.
+// Токен сохраняется и при ошибке настройки ключей: они повторно запрашиваются при работе с общими хранилищами.
+func (a Account) signedIn(header http.Header) error {
+	if err := a.flushHeaderToken(header); err != nil {
+		return err
+	}
+
+	token, _ := a.tokenJar.ReadToken()
+	a.client.SetAuthToken(token)
+
+	if err := a.keys.Setup(); err != nil {
+		return fmt.Errorf("%w: %s", ErrKeysNotReady, err.Error())
+	}
+
+	return nil
+}
+
 // flushHeaderToken метод сбрасывает (сохраняет) токен из заголовков.
 func (a Account) flushHeaderToken(header http.Header) error {
 	parts := strings.Split(header.Get("Authorization"), " ")
@@ -88,3 +250,9 @@ func (a Account) flushHeaderToken(header http.Header) error {
 	}
 	return nil
 }
+
+// newTooManyRequestsError создает ошибку превышения лимита по заголовку Retry-After.
+func newTooManyRequestsError(header http.Header) error {
+	seconds, _ := strconv.Atoi(header.Get("Retry-After"))
+	return &TooManyRequestsError{RetryAfter: time.Duration(seconds) * time.Second}
+}
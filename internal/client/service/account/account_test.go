@@ -1 +1,215 @@
 package account
+
+import (
+	"errors"
+	"net/http"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/casnerano/seckeep/pkg/cipher"
+	"github.com/go-resty/resty/v2"
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/suite"
+)
+
+// keySetupFunc заглушка настройки ключей.
+type keySetupFunc func() error
+
+func (f keySetupFunc) Setup() error {
+	return f()
+}
+
+type AccountServiceTestSuite struct {
+	suite.Suite
+	client         *resty.Client
+	accountService *Account
+	keySetupErr    error
+}
+
+func (s *AccountServiceTestSuite) SetupSuite() {
+	s.client = resty.New()
+	s.client.SetBaseURL("http://127.0.0.1/api")
+
+	s.accountService = New(
+		s.client,
+		NewTokenJar(filepath.Join(s.T().TempDir(), "token.jar"), cipher.New([]byte("example key"))),
+		keySetupFunc(func() error { return s.keySetupErr }),
+	)
+}
+
+func (s *AccountServiceTestSuite) SetupTest() {
+	httpmock.ActivateNonDefault(s.client.GetClient())
+}
+
+func (s *AccountServiceTestSuite) TearDownTest() {
+	httpmock.DeactivateAndReset()
+}
+
+func (s *AccountServiceTestSuite) TestTooManyRequests() {
+	responder := httpmock.NewStringResponder(http.StatusTooManyRequests, "").
+		HeaderSet(http.Header{"Retry-After": []string{"90"}})
+
+	httpmock.RegisterResponder(http.MethodPost, s.client.BaseURL+"/user/login", responder)
+	httpmock.RegisterResponder(http.MethodPost, s.client.BaseURL+"/user/register", responder)
+
+	s.Run("Sign in", func() {
+		err := s.accountService.SignIn("ivan", "example")
+		s.ErrorIs(err, ErrTooManyRequests)
+
+		var tmrErr *TooManyRequestsError
+		s.Require().ErrorAs(err, &tmrErr)
+		s.Equal(90*time.Second, tmrErr.RetryAfter)
+	})
+
+	s.Run("Sign up", func() {
+		err := s.accountService.SignUp("ivan", "example", "Ivan Ivanov")
+		s.ErrorIs(err, ErrTooManyRequests)
+	})
+}
+
+func (s *AccountServiceTestSuite) TestSignInKeySetup() {
+	httpmock.RegisterResponder(
+		http.MethodPost, s.client.BaseURL+"/user/login",
+		httpmock.NewStringResponder(http.StatusOK, "").
+			HeaderSet(http.Header{"Authorization": []string{"Bearer eyJhbGci.e30.Et9HFtf9R3GEM"}}),
+	)
+
+	s.Run("Keys are ready", func() {
+		s.keySetupErr = nil
+		s.Require().NoError(s.accountService.SignIn("ivan", "example"))
+		s.Equal("eyJhbGci.e30.Et9HFtf9R3GEM", s.client.Token)
+	})
+
+	s.Run("Keys are not ready", func() {
+		s.keySetupErr = errors.New("private keys do not match published keys")
+		s.ErrorIs(s.accountService.SignIn("ivan", "example"), ErrKeysNotReady)
+
+		token, err := s.accountService.tokenJar.ReadToken()
+		s.Require().NoError(err)
+		s.Equal("eyJhbGci.e30.Et9HFtf9R3GEM", token)
+	})
+
+	s.keySetupErr = nil
+}
+
+func (s *AccountServiceTestSuite) TestSignOut() {
+	s.Run("Revoked on server", func() {
+		httpmock.RegisterResponder(
+			http.MethodPost, s.client.BaseURL+"/user/logout",
+			httpmock.NewStringResponder(http.StatusOK, ""),
+		)
+
+		s.Require().NoError(s.accountService.tokenJar.SetToken("eyJhbGci.e30.Et9HFtf9R3GEM"))
+		s.NoError(s.accountService.SignOut())
+
+		_, err := s.accountService.tokenJar.ReadToken()
+		s.ErrorIs(err, ErrTokenNotFound)
+	})
+
+	s.Run("Server is unavailable", func() {
+		httpmock.RegisterResponder(
+			http.MethodPost, s.client.BaseURL+"/user/logout",
+			httpmock.NewStringResponder(http.StatusBadGateway, ""),
+		)
+
+		s.Require().NoError(s.accountService.tokenJar.SetToken("eyJhbGci.e30.Et9HFtf9R3GEM"))
+		s.ErrorIs(s.accountService.SignOut(), ErrSessionNotRevoked)
+
+		_, err := s.accountService.tokenJar.ReadToken()
+		s.ErrorIs(err, ErrTokenNotFound)
+	})
+
+	s.Run("Token is rejected", func() {
+		httpmock.RegisterResponder(
+			http.MethodPost, s.client.BaseURL+"/user/logout",
+			func(request *http.Request) (*http.Response, error) {
+				s.Equal("Bearer eyJhbGci.e30.Et9HFtf9R3GEM", request.Header.Get("Authorization"))
+				return httpmock.NewStringResponse(http.StatusUnauthorized, ""), nil
+			},
+		)
+
+		s.Require().NoError(s.accountService.tokenJar.SetToken("eyJhbGci.e30.Et9HFtf9R3GEM"))
+		s.ErrorIs(s.accountService.SignOut(), ErrSessionNotRevoked)
+
+		_, err := s.accountService.tokenJar.ReadToken()
+		s.ErrorIs(err, ErrTokenNotFound)
+	})
+
+	s.Run("Without token", func() {
+		httpmock.Reset()
+
+		s.NoError(s.accountService.SignOut())
+		s.Zero(httpmock.GetTotalCallCount())
+	})
+}
+
+func (s *AccountServiceTestSuite) TestChangePassword() {
+	s.Run("Incorrect old password", func() {
+		httpmock.RegisterResponder(
+			http.MethodPut, s.client.BaseURL+"/user/password",
+			httpmock.NewStringResponder(http.StatusForbidden, ""),
+		)
+
+		s.ErrorIs(s.accountService.ChangePassword("old", "new"), ErrIncorrectCredentials)
+	})
+
+	s.Run("Unauthorized", func() {
+		httpmock.RegisterResponder(
+			http.MethodPut, s.client.BaseURL+"/user/password",
+			httpmock.NewStringResponder(http.StatusUnauthorized, ""),
+		)
+
+		s.ErrorIs(s.accountService.ChangePassword("old", "new"), ErrUnauthorized)
+	})
+}
+
+func (s *AccountServiceTestSuite) TestDelete() {
+	s.Run("Incorrect password", func() {
+		httpmock.RegisterResponder(
+			http.MethodDelete, s.client.BaseURL+"/user",
+			httpmock.NewStringResponder(http.StatusForbidden, ""),
+		)
+
+		s.ErrorIs(s.accountService.Delete("example"), ErrIncorrectCredentials)
+	})
+
+	s.Run("Success", func() {
+		httpmock.RegisterResponder(
+			http.MethodDelete, s.client.BaseURL+"/user",
+			httpmock.NewStringResponder(http.StatusOK, ""),
+		)
+
+		s.NoError(s.accountService.Delete("example"))
+	})
+}
+
+func (s *AccountServiceTestSuite) TestExport() {
+	s.Run("Success", func() {
+		httpmock.RegisterResponder(
+			http.MethodGet, s.client.BaseURL+"/user/export",
+			httpmock.NewStringResponder(http.StatusOK, `{"user":{"login":"ivan"},"data":[{"uuid":"9b92672a"}]}`).
+				HeaderSet(http.Header{"Content-Type": []string{"application/json"}}),
+		)
+
+		export, err := s.accountService.Export()
+		s.Require().NoError(err)
+
+		s.Equal("ivan", export.User.Login)
+		s.Len(export.Data, 1)
+	})
+
+	s.Run("Unauthorized", func() {
+		httpmock.RegisterResponder(
+			http.MethodGet, s.client.BaseURL+"/user/export",
+			httpmock.NewStringResponder(http.StatusUnauthorized, ""),
+		)
+
+		_, err := s.accountService.Export()
+		s.ErrorIs(err, ErrUnauthorized)
+	})
+}
+
+func TestAccountServiceTestSuite(t *testing.T) {
+	suite.Run(t, new(AccountServiceTestSuite))
+}
@@ -0,0 +1,89 @@
+package account
+
+import (
+	"bytes"
+	"os"
+	"os/exec"
+	"strings"
+)
+
+const (
+	// secretToolBin утилита libsecret для работы с хранилищем Secret Service.
+	secretToolBin = "secret-tool"
+
+	// keyringService значение атрибута "service" записи в хранилище.
+	keyringService = "seckeep"
+)
+
+// commandRunner функция запуска внешней команды с заданным stdin.
+type commandRunner func(stdin string, name string, args ...string) ([]byte, error)
+
+// KeyringTokenJar структура для хранения токена в хранилище Secret Service (GNOME Keyring, KWallet).
+// Взаимодействие выполняется через утилиту secret-tool, без сторонних зависимостей.
+type KeyringTokenJar struct {
+	profile string
+	run     commandRunner
+}
+
+// NewKeyringTokenJar конструктор, profile — имя записи в хранилище.
+func NewKeyringTokenJar(profile string) *KeyringTokenJar {
+	return &KeyringTokenJar{
+		profile: profile,
+		run:     runCommand,
+	}
+}
+
+// KeyringAvailable проверяет доступность хранилища Secret Service.
+func KeyringAvailable() bool {
+	if os.Getenv("DBUS_SESSION_BUS_ADDRESS") == "" {
+		return false
+	}
+	_, err := exec.LookPath(secretToolBin)
+	return err == nil
+}
+
+// SetToken метод сохраняет токен в хранилище.
+func (k KeyringTokenJar) SetToken(token string) error {
+	_, err := k.run(
+		token,
+		secretToolBin, "store", "--label=SecKeep token",
+		"service", keyringService, "profile", k.profile,
+	)
+	return err
+}
+
+// ReadToken метод читает токен из хранилища.
+func (k KeyringTokenJar) ReadToken() (string, error) {
+	out, err := k.run("", secretToolBin, "lookup", "service", keyringService, "profile", k.profile)
+	if err != nil {
+		return "", err
+	}
+
+	token := strings.TrimSpace(string(out))
+	if token == "" {
+		return "", ErrTokenNotFound
+	}
+
+	return token, nil
+}
+
+// Clear метод удаляет токен из хранилища.
+func (k KeyringTokenJar) Clear() error {
+	_, err := k.run("", secretToolBin, "clear", "service", keyringService, "profile", k.profile)
+	return err
+}
+
+// runCommand запускает внешнюю команду.
+func runCommand(stdin string, name string, args ...string) ([]byte, error) {
+	cmd := exec.Command(name, args...)
+	cmd.Stdin = strings.NewReader(stdin)
+
+	var stdout bytes.Buffer
+	cmd.Stdout = &stdout
+
+	if err := cmd.Run(); err != nil {
+		return nil, err
+	}
+
+	return stdout.Bytes(), nil
+}
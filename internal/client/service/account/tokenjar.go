@@ -1,41 +1,105 @@
 package account
 
 import (
+	"errors"
+	"io/fs"
 	"os"
+	"path/filepath"
 )
 
-const tokenJarFileName = "./cmd/client/var/token.jar"
+// tokenJarFileMode права доступа к файлу хранилища токена.
+const tokenJarFileMode fs.FileMode = 0600
 
-// TokenJar структура для работы с хранилищем токена.
-type TokenJar struct{}
+// Cipher интерфейс шифровщика и дешифровщика.
+type Cipher interface {
+	Encrypt(src []byte) ([]byte, error)
+	Decrypt(dst []byte) ([]byte, error)
+}
+
+// TokenJar структура для работы с файловым хранилищем токена.
+// Токен хранится зашифрованным ключом хранилища, доступ к файлу есть только у владельца.
+type TokenJar struct {
+	fName  string
+	cipher Cipher
+}
 
 // NewTokenJar конструктор.
-func NewTokenJar() *TokenJar {
-	return &TokenJar{}
+func NewTokenJar(fName string, cipher Cipher) *TokenJar {
+	return &TokenJar{
+		fName:  fName,
+		cipher: cipher,
+	}
 }
 
 // SetToken метод устанавливает (сохраняет) токен в локальный файл.
 func (tj TokenJar) SetToken(token string) error {
-	file, err := os.OpenFile(tokenJarFileName, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0664)
+	encrypted, err := tj.cipher.Encrypt([]byte(token))
 	if err != nil {
-		return nil
+		return err
+	}
+
+	if err = os.MkdirAll(filepath.Dir(tj.fName), 0700); err != nil {
+		return err
+	}
+
+	file, err := os.OpenFile(tj.fName, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, tokenJarFileMode)
+	if err != nil {
+		return err
 	}
 	defer file.Close()
 
-	_, err = file.WriteString(token)
+	// Права существующего файла не меняются при открытии.
+	if err = file.Chmod(tokenJarFileMode); err != nil {
+		return err
+	}
+
+	_, err = file.Write(encrypted)
 	return err
 }
 
 // ReadToken метод читает токен из локального файла.
+// Слишком широкие права доступа к файлу ограничиваются до 0600.
 func (tj TokenJar) ReadToken() (string, error) {
-	if _, err := os.Stat(tokenJarFileName); err != nil {
+	info, err := os.Stat(tj.fName)
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return "", ErrTokenNotFound
+		}
 		return "", err
 	}
 
-	bToken, err := os.ReadFile(tokenJarFileName)
+	if info.Mode().Perm() != tokenJarFileMode {
+		if err = os.Chmod(tj.fName, tokenJarFileMode); err != nil {
+			return "", err
+		}
+	}
+
+	encrypted, err := os.ReadFile(tj.fName)
+	if err != nil {
+		return "", err
+	}
+
+	bToken, err := tj.cipher.Decrypt(encrypted)
 	if err != nil {
 		return "", err
 	}
 
 	return string(bToken), nil
 }
+
+// Clear метод затирает и удаляет файл с токеном.
+func (tj TokenJar) Clear() error {
+	info, err := os.Stat(tj.fName)
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return nil
+		}
+		return err
+	}
+
+	if err = os.WriteFile(tj.fName, make([]byte, info.Size()), tokenJarFileMode); err != nil {
+		return err
+	}
+
+	return os.Remove(tj.fName)
+}
@@ -1 +1,131 @@
 package account
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/casnerano/seckeep/pkg/cipher"
+	"github.com/stretchr/testify/suite"
+)
+
+var (
+	errKeyring = errors.New("keyring is unavailable")
+)
+
+type TokenJarTestSuite struct {
+	suite.Suite
+	fName    string
+	tokenJar *TokenJar
+}
+
+func (s *TokenJarTestSuite) SetupTest() {
+	s.fName = filepath.Join(s.T().TempDir(), "token.jar")
+	s.tokenJar = NewTokenJar(s.fName, cipher.New([]byte("example key")))
+}
+
+func (s *TokenJarTestSuite) TestSetReadToken() {
+	token := "eyJhbGci.e30.Et9HFtf9R3GEM"
+
+	s.Require().NoError(s.tokenJar.SetToken(token))
+
+	content, err := os.ReadFile(s.fName)
+	s.Require().NoError(err)
+	s.NotContains(string(content), token)
+
+	info, err := os.Stat(s.fName)
+	s.Require().NoError(err)
+	s.Equal(tokenJarFileMode, info.Mode().Perm())
+
+	gotToken, err := s.tokenJar.ReadToken()
+	s.Require().NoError(err)
+	s.Equal(token, gotToken)
+}
+
+func (s *TokenJarTestSuite) TestEnforcePermissions() {
+	s.Require().NoError(s.tokenJar.SetToken("eyJhbGci.e30.Et9HFtf9R3GEM"))
+	s.Require().NoError(os.Chmod(s.fName, 0664))
+
+	_, err := s.tokenJar.ReadToken()
+	s.Require().NoError(err)
+
+	info, err := os.Stat(s.fName)
+	s.Require().NoError(err)
+	s.Equal(tokenJarFileMode, info.Mode().Perm())
+}
+
+func (s *TokenJarTestSuite) TestForeignKey() {
+	s.Require().NoError(s.tokenJar.SetToken("eyJhbGci.e30.Et9HFtf9R3GEM"))
+
+	_, err := NewTokenJar(s.fName, cipher.New([]byte("another key"))).ReadToken()
+	s.Error(err)
+}
+
+func (s *TokenJarTestSuite) TestClear() {
+	s.Require().NoError(s.tokenJar.SetToken("eyJhbGci.e30.Et9HFtf9R3GEM"))
+	s.Require().NoError(s.tokenJar.Clear())
+
+	_, err := os.Stat(s.fName)
+	s.ErrorIs(err, os.ErrNotExist)
+
+	_, err = s.tokenJar.ReadToken()
+	s.ErrorIs(err, ErrTokenNotFound)
+
+	s.NoError(s.tokenJar.Clear())
+}
+
+func (s *TokenJarTestSuite) TestKeyring() {
+	secrets := make(map[string]string)
+	keyring := &KeyringTokenJar{
+		profile: "default",
+		run: func(stdin string, name string, args ...string) ([]byte, error) {
+			switch args[0] {
+			case "store":
+				secrets[args[len(args)-1]] = stdin
+			case "lookup":
+				return []byte(secrets[args[len(args)-1]]), nil
+			case "clear":
+				delete(secrets, args[len(args)-1])
+			}
+			return nil, nil
+		},
+	}
+
+	s.Require().NoError(keyring.SetToken("eyJhbGci.e30.Et9HFtf9R3GEM"))
+
+	gotToken, err := keyring.ReadToken()
+	s.Require().NoError(err)
+	s.Equal("eyJhbGci.e30.Et9HFtf9R3GEM", gotToken)
+
+	s.Require().NoError(keyring.Clear())
+
+	_, err = keyring.ReadToken()
+	s.ErrorIs(err, ErrTokenNotFound)
+}
+
+func (s *TokenJarTestSuite) TestFallbackStore() {
+	brokenKeyring := &KeyringTokenJar{
+		profile: "default",
+		run: func(stdin string, name string, args ...string) ([]byte, error) {
+			return nil, errKeyring
+		},
+	}
+
+	store := NewFallbackTokenStore(brokenKeyring, s.tokenJar)
+
+	s.Require().NoError(store.SetToken("eyJhbGci.e30.Et9HFtf9R3GEM"))
+
+	gotToken, err := store.ReadToken()
+	s.Require().NoError(err)
+	s.Equal("eyJhbGci.e30.Et9HFtf9R3GEM", gotToken)
+
+	s.ErrorIs(store.Clear(), errKeyring)
+
+	_, err = os.Stat(s.fName)
+	s.ErrorIs(err, os.ErrNotExist)
+}
+
+func TestTokenJarTestSuite(t *testing.T) {
+	suite.Run(t, new(TokenJarTestSuite))
+}
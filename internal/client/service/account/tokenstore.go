@@ -0,0 +1,67 @@
+package account
+
+import (
+	"errors"
+)
+
+// ErrTokenNotFound токен не найден.
+var ErrTokenNotFound = errors.New("token not found")
+
+// TokenStore интерфейс хранилища токена авторизации.
+type TokenStore interface {
+	SetToken(token string) error
+	ReadToken() (string, error)
+	Clear() error
+}
+
+// NewTokenStore конструктор хранилища токена.
+// Если доступно хранилище Secret Service, токен сохраняется в нем,
+// иначе (или при ошибке) — в зашифрованный файл fName.
+func NewTokenStore(profile, fName string, cipher Cipher) TokenStore {
+	file := NewTokenJar(fName, cipher)
+	if !KeyringAvailable() {
+		return file
+	}
+
+	return &FallbackTokenStore{
+		primary:  NewKeyringTokenJar(profile),
+		fallback: file,
+	}
+}
+
+// FallbackTokenStore структура хранилища токена с резервным хранилищем.
+type FallbackTokenStore struct {
+	primary  TokenStore
+	fallback TokenStore
+}
+
+// NewFallbackTokenStore конструктор.
+func NewFallbackTokenStore(primary, fallback TokenStore) *FallbackTokenStore {
+	return &FallbackTokenStore{primary: primary, fallback: fallback}
+}
+
+// SetToken метод сохраняет токен в основное хранилище, при ошибке — в резервное.
+// Устаревший токен в другом хранилище удаляется.
+func (f FallbackTokenStore) SetToken(token string) error {
+	if err := f.primary.SetToken(token); err != nil {
+		return f.fallback.SetToken(token)
+	}
+	return f.fallback.Clear()
+}
+
+// ReadToken метод читает токен из основного хранилища, при ошибке — из резервного.
+func (f FallbackTokenStore) ReadToken() (string, error) {
+	if token, err := f.primary.ReadToken(); err == nil {
+		return token, nil
+	}
+	return f.fallback.ReadToken()
+}
+
+// Clear метод удаляет токен из обоих хранилищ.
+func (f FallbackTokenStore) Clear() error {
+	primaryErr := f.primary.Clear()
+	if err := f.fallback.Clear(); err != nil {
+		return err
+	}
+	return primaryErr
+}
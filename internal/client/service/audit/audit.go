@@ -0,0 +1,249 @@
+// Package audit содержит методы проверки паролей учетных записей:
+// слабые, повторяющиеся и давно не менявшиеся пароли, учетные записи без адреса сайта,
+// пароли из утечек (по базе, заданной интерфейсом BreachChecker). Записи расшифровываются только в памяти, пароли в отчет не попадают.
+package audit
+
+//go:generate mockgen -destination=mock/audit.go -source=audit.go
+
+import (
+	"sort"
+	"time"
+
+	"github.com/casnerano/seckeep/internal/client/model"
+	"github.com/casnerano/seckeep/pkg/strength"
+)
+
+// Параметры проверки по умолчанию.
+const (
+	// DefaultMinScore минимальная оценка стойкости, ниже которой пароль считается слабым.
+	DefaultMinScore = strength.ScoreStrong
+
+	// DefaultMaxAge срок, после которого пароль считается старым.
+	DefaultMaxAge = 365 * 24 * time.Hour
+)
+
+// Storage интерфейс чтения списка записей локального хранилища.
+type Storage interface {
+	GetList() []*model.StoreData
+}
+
+// Data интерфейс чтения расшифрованной записи.
+type Data interface {
+	Read(index int) (model.DataTypeable, error)
+}
+
+// BreachChecker интерфейс проверки пароля по базе утечек.
+// Возвращает, сколько раз пароль встречался в утечках, 0 — не встречался.
+type BreachChecker interface {
+	Count(password string) (int, error)
+}
+
+// Options параметры проверки.
+type Options struct {
+	// MinScore минимальная оценка стойкости пароля (strength.ScoreVeryWeak — strength.ScoreVeryStrong).
+	MinScore int
+	// MaxAge срок, после которого пароль считается старым, 0 — не проверять.
+	MaxAge time.Duration
+}
+
+// DefaultOptions возвращает параметры проверки по умолчанию.
+func DefaultOptions() Options {
+	return Options{
+		MinScore: DefaultMinScore,
+		MaxAge:   DefaultMaxAge,
+	}
+}
+
+// Item структура учетной записи в отчете.
+type Item struct {
+	Index     int    `json:"index"`
+	Title     string `json:"title,omitempty"`
+	Login     string `json:"login"`
+	VaultUUID string `json:"vault_uuid,omitempty"`
+}
+
+// WeakItem структура учетной записи со слабым паролем.
+type WeakItem struct {
+	Item
+	Score   int     `json:"score"`
+	Entropy float64 `json:"entropy"`
+}
+
+// OldItem структура учетной записи с давно не менявшимся паролем.
+// Дата изменения берется из версии записи.
+type OldItem struct {
+	Item
+	ChangedAt time.Time `json:"changed_at"`
+	Days      int       `json:"days"`
+}
+
+// Report структура отчета о проверке.
+type Report struct {
+	Checked int          `json:"checked"`
+	Weak    []WeakItem   `json:"weak"`
+	Reused  [][]Item     `json:"reused"`
+	Old     []OldItem    `json:"old"`
+	NoURI   []Item       `json:"no_uri"`
+	Options ReportLimits `json:"options"`
+}
+
+// ReportLimits структура параметров, с которыми выполнена проверка.
+type ReportLimits struct {
+	MinScore   int `json:"min_score"`
+	MaxAgeDays int `json:"max_age_days"`
+}
+
+// Issues метод возвращает кол-во найденных проблем.
+// Группа повторяющихся паролей считается одной проблемой.
+func (r *Report) Issues() int {
+	return len(r.Weak) + len(r.Reused) + len(r.Old) + len(r.NoURI)
+}
+
+// BreachedItem структура учетной записи с паролем, найденным в утечках.
+type BreachedItem struct {
+	Item
+	Count int `json:"count"`
+}
+
+// BreachReport структура отчета о проверке паролей по базе утечек.
+type BreachReport struct {
+	Checked  int            `json:"checked"`
+	Breached []BreachedItem `json:"breached"`
+}
+
+// Issues метод возвращает кол-во учетных записей с паролями из утечек.
+func (r *BreachReport) Issues() int {
+	return len(r.Breached)
+}
+
+// Audit структура проверки паролей.
+type Audit struct {
+	storage Storage
+	data    Data
+	now     func() time.Time
+}
+
+// New конструктор.
+func New(storage Storage, data Data) *Audit {
+	return &Audit{
+		storage: storage,
+		data:    data,
+		now:     time.Now,
+	}
+}
+
+// Run метод проверяет все учетные записи локального хранилища, включая записи общих хранилищ.
+func (a *Audit) Run(opts Options) *Report {
+	report := &Report{
+		Weak:   make([]WeakItem, 0),
+		Reused: make([][]Item, 0),
+		Old:    make([]OldItem, 0),
+		NoURI:  make([]Item, 0),
+		Options: ReportLimits{
+			MinScore:   opts.MinScore,
+			MaxAgeDays: int(opts.MaxAge / (24 * time.Hour)),
+		},
+	}
+
+	passwords := make(map[string][]Item)
+	now := a.now()
+
+	_ = a.credentials(func(item Item, storeData *model.StoreData, credential *model.DataCredential) error {
+		report.Checked++
+
+		if result := strength.Estimate(credential.Password); result.Score < opts.MinScore {
+			report.Weak = append(report.Weak, WeakItem{Item: item, Score: result.Score, Entropy: result.Entropy})
+		}
+
+		passwords[credential.Password] = append(passwords[credential.Password], item)
+
+		if age := now.Sub(storeData.Version); opts.MaxAge > 0 && age > opts.MaxAge {
+			report.Old = append(report.Old, OldItem{
+				Item:      item,
+				ChangedAt: storeData.Version,
+				Days:      int(age / (24 * time.Hour)),
+			})
+		}
+
+		if len(credential.URIs) == 0 {
+			report.NoURI = append(report.NoURI, item)
+		}
+
+		return nil
+	})
+
+	for _, items := range passwords {
+		if len(items) > 1 {
+			report.Reused = append(report.Reused, items)
+		}
+	}
+
+	sort.Slice(report.Reused, func(i, j int) bool {
+		return report.Reused[i][0].Index < report.Reused[j][0].Index
+	})
+
+	return report
+}
+
+// Breaches метод проверяет пароли всех учетных записей по базе утечек.
+// Каждый пароль проверяется один раз, даже если он повторяется в нескольких записях.
+func (a *Audit) Breaches(checker BreachChecker) (*BreachReport, error) {
+	report := &BreachReport{Breached: make([]BreachedItem, 0)}
+	counts := make(map[string]int)
+
+	err := a.credentials(func(item Item, _ *model.StoreData, credential *model.DataCredential) error {
+		report.Checked++
+
+		count, ok := counts[credential.Password]
+		if !ok {
+			var err error
+			if count, err = checker.Count(credential.Password); err != nil {
+				return err
+			}
+			counts[credential.Password] = count
+		}
+
+		if count > 0 {
+			report.Breached = append(report.Breached, BreachedItem{Item: item, Count: count})
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return report, nil
+}
+
+// credentials вызывает fn для каждой учетной записи локального хранилища, кроме удаленных.
+// Записи, которые не удалось расшифровать, пропускаются. Ошибка fn прерывает обход.
+func (a *Audit) credentials(fn func(item Item, storeData *model.StoreData, credential *model.DataCredential) error) error {
+	for index, storeData := range a.storage.GetList() {
+		if storeData.Deleted {
+			continue
+		}
+
+		dt, err := a.data.Read(index)
+		if err != nil {
+			continue
+		}
+
+		credential, ok := dt.(*model.DataCredential)
+		if !ok {
+			continue
+		}
+
+		item := Item{
+			Index:     index,
+			Title:     credential.Title,
+			Login:     credential.Login,
+			VaultUUID: storeData.VaultUUID,
+		}
+
+		if err = fn(item, storeData, credential); err != nil {
+			return err
+		}
+	}
+	return nil
+}
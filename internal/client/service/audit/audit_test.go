@@ -0,0 +1,133 @@
+package audit
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/casnerano/seckeep/internal/client/model"
+	mock_audit "github.com/casnerano/seckeep/internal/client/service/audit/mock"
+	smodel "github.com/casnerano/seckeep/internal/pkg/model"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/suite"
+)
+
+type AuditTestSuite struct {
+	suite.Suite
+	storage *mock_audit.MockStorage
+	data    *mock_audit.MockData
+	now     time.Time
+}
+
+func (s *AuditTestSuite) SetupTest() {
+	ctrl := gomock.NewController(s.T())
+	s.storage = mock_audit.NewMockStorage(ctrl)
+	s.data = mock_audit.NewMockData(ctrl)
+	s.now = time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+
+	decrypted := []model.DataTypeable{
+		&model.DataCredential{
+			DataInfo: model.DataInfo{Title: "GitHub"},
+			Login:    "ivan",
+			Password: "xK3!vQ9#mP2$wL7@zR5&",
+			URIs:     []model.DataURI{{URI: "github.com"}},
+		},
+		&model.DataCredential{DataInfo: model.DataInfo{Title: "Mail"}, Login: "ivan@example.com", Password: "P@ssw0rd"},
+		&model.DataText{Value: "P@ssw0rd"},
+		&model.DataCredential{
+			Login:    "root",
+			Password: "P@ssw0rd",
+			URIs:     []model.DataURI{{URI: "db.example.com"}},
+		},
+		&model.DataCredential{
+			Login:    "old",
+			Password: "kX9#mQ2vLp7$",
+			URIs:     []model.DataURI{{URI: "old.example.com"}},
+		},
+	}
+
+	storeData := []*model.StoreData{
+		{Type: smodel.DataTypeCredential, Version: s.now.AddDate(0, 0, -10)},
+		{Type: smodel.DataTypeCredential, Version: s.now.AddDate(0, 0, -10)},
+		{Type: smodel.DataTypeText, Version: s.now.AddDate(-3, 0, 0)},
+		{Type: smodel.DataTypeCredential, VaultUUID: "vault-uuid", Version: s.now.AddDate(0, 0, -10)},
+		{Type: smodel.DataTypeCredential, Version: s.now.AddDate(-2, 0, 0)},
+		{Type: smodel.DataTypeCredential, Deleted: true},
+	}
+
+	s.storage.EXPECT().GetList().Return(storeData).AnyTimes()
+	for index, dt := range decrypted {
+		s.data.EXPECT().Read(index).Return(dt, nil).AnyTimes()
+	}
+}
+
+func (s *AuditTestSuite) TestRun() {
+	audit := New(s.storage, s.data)
+	audit.now = func() time.Time { return s.now }
+
+	report := audit.Run(DefaultOptions())
+
+	s.Equal(4, report.Checked)
+
+	s.Require().Len(report.Weak, 2)
+	s.Equal(Item{Index: 1, Title: "Mail", Login: "ivan@example.com"}, report.Weak[0].Item)
+	s.Equal(Item{Index: 3, Login: "root", VaultUUID: "vault-uuid"}, report.Weak[1].Item)
+
+	s.Equal([][]Item{{
+		{Index: 1, Title: "Mail", Login: "ivan@example.com"},
+		{Index: 3, Login: "root", VaultUUID: "vault-uuid"},
+	}}, report.Reused)
+
+	s.Require().Len(report.Old, 1)
+	s.Equal(4, report.Old[0].Index)
+	s.Equal(731, report.Old[0].Days)
+
+	s.Equal([]Item{{Index: 1, Title: "Mail", Login: "ivan@example.com"}}, report.NoURI)
+
+	s.Equal(5, report.Issues())
+	s.Equal(ReportLimits{MinScore: DefaultMinScore, MaxAgeDays: 365}, report.Options)
+}
+
+func (s *AuditTestSuite) TestRunOptions() {
+	audit := New(s.storage, s.data)
+	audit.now = func() time.Time { return s.now }
+
+	report := audit.Run(Options{MinScore: 0})
+
+	s.Empty(report.Weak)
+	s.Empty(report.Old)
+	s.Equal(2, report.Issues())
+}
+
+func (s *AuditTestSuite) TestBreaches() {
+	audit := New(s.storage, s.data)
+
+	s.Run("Success", func() {
+		checker := mock_audit.NewMockBreachChecker(gomock.NewController(s.T()))
+		checker.EXPECT().Count("xK3!vQ9#mP2$wL7@zR5&").Return(0, nil)
+		checker.EXPECT().Count("P@ssw0rd").Return(52000, nil).Times(1)
+		checker.EXPECT().Count("kX9#mQ2vLp7$").Return(0, nil)
+
+		report, err := audit.Breaches(checker)
+		s.Require().NoError(err)
+
+		s.Equal(4, report.Checked)
+		s.Equal([]BreachedItem{
+			{Item: Item{Index: 1, Title: "Mail", Login: "ivan@example.com"}, Count: 52000},
+			{Item: Item{Index: 3, Login: "root", VaultUUID: "vault-uuid"}, Count: 52000},
+		}, report.Breached)
+		s.Equal(2, report.Issues())
+	})
+
+	s.Run("Checker error", func() {
+		checker := mock_audit.NewMockBreachChecker(gomock.NewController(s.T()))
+		checker.EXPECT().Count(gomock.Any()).Return(0, errors.New("invalid dataset line"))
+
+		_, err := audit.Breaches(checker)
+		s.Error(err)
+	})
+}
+
+func TestAuditTestSuite(t *testing.T) {
+	suite.Run(t, new(AuditTestSuite))
+}
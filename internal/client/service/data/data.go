@@ -9,9 +9,14 @@ import (
 	"time"
 
 	"github.com/casnerano/seckeep/internal/client/model"
-	smodel "github.com/casnerano/seckeep/internal/pkg/model"
+	"github.com/casnerano/seckeep/internal/client/service/data/encryptor"
+	vmodel "github.com/casnerano/seckeep/internal/server/model"
+	"github.com/casnerano/seckeep/pkg/cipher"
 )
 
+// ErrReadOnlyVault общее хранилище доступно только для чтения.
+var ErrReadOnlyVault = errors.New("vault is read-only")
+
 // Storage интерфейс работы с локальным хранилищем.
 type Storage interface {
 	Create(storeData *model.StoreData) error
@@ -27,28 +32,62 @@ type Encryptor interface {
 	Decrypt(encrypted []byte, dt model.DataTypeable) error
 }
 
+// VaultKeyring интерфейс получения общих хранилищ и их ключей.
+type VaultKeyring interface {
+	Find(nameOrUUID string) (*vmodel.Vault, error)
+	Cipher(vaultUUID string) (*cipher.Cipher, error)
+}
+
 // Data структура работы с данными.
+// Личные записи шифруются encryptor, записи общих хранилищ — ключом хранилища из vaults.
 type Data struct {
 	storage   Storage
 	encryptor Encryptor
+	vaults    VaultKeyring
 }
 
 // New конструктор.
-func New(storage Storage, encryptor Encryptor) *Data {
+func New(storage Storage, encryptor Encryptor, vaults VaultKeyring) *Data {
 	return &Data{
 		storage:   storage,
 		encryptor: encryptor,
+		vaults:    vaults,
 	}
 }
 
 // Create метод создает запись.
 func (d Data) Create(dt model.DataTypeable) error {
-	encrypted, err := d.encryptor.Encrypt(dt)
+	return d.create("", dt)
+}
+
+// CreateInVault метод создает запись в общем хранилище (по имени или UUID).
+func (d Data) CreateInVault(vault string, dt model.DataTypeable) error {
+	v, err := d.vaults.Find(vault)
+	if err != nil {
+		return err
+	}
+
+	if !v.Role.CanWrite() {
+		return ErrReadOnlyVault
+	}
+
+	return d.create(v.UUID, dt)
+}
+
+// create метод шифрует и сохраняет запись в локальное хранилище.
+func (d Data) create(vaultUUID string, dt model.DataTypeable) error {
+	enc, err := d.encryptorFor(vaultUUID)
+	if err != nil {
+		return err
+	}
+
+	encrypted, err := enc.Encrypt(dt)
 	if err != nil {
 		return err
 	}
 
 	sd := &model.StoreData{
+		VaultUUID: vaultUUID,
 		Type:      dt.Type(),
 		Value:     encrypted,
 		Version:   time.Now(),
@@ -65,21 +104,23 @@ func (d Data) Read(index int) (model.DataTypeable, error) {
 		return nil, err
 	}
 
-	var dt model.DataTypeable
-	switch storeData.Type {
-	case smodel.DataTypeCredential:
-		dt = &model.DataCredential{}
-	case smodel.DataTypeText:
-		dt = &model.DataText{}
-	case smodel.DataTypeCard:
-		dt = &model.DataCard{}
-	case smodel.DataTypeDocument:
-		dt = &model.DataDocument{}
-	default:
-		return nil, errors.New("unknown data type")
+	return d.Decrypt(storeData)
+}
+
+// Decrypt метод расшифровывает запись локального хранилища,
+// в том числе прежнюю ревизию записи, полученную с сервера.
+func (d Data) Decrypt(storeData *model.StoreData) (model.DataTypeable, error) {
+	dt, err := model.NewData(storeData.Type)
+	if err != nil {
+		return nil, err
+	}
+
+	enc, err := d.encryptorFor(storeData.VaultUUID)
+	if err != nil {
+		return nil, err
 	}
 
-	if err = d.encryptor.Decrypt(storeData.Value, dt); err != nil {
+	if err = enc.Decrypt(storeData.Value, dt); err != nil {
 		return nil, err
 	}
 
@@ -101,9 +142,39 @@ func (d Data) GetList() map[int]model.DataTypeable {
 	return result
 }
 
+// GetVaultList метод читает список данных общего хранилища (по имени или UUID).
+func (d Data) GetVaultList(vault string) (map[int]model.DataTypeable, error) {
+	v, err := d.vaults.Find(vault)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make(map[int]model.DataTypeable)
+	for index, value := range d.storage.GetList() {
+		if value.Deleted || value.VaultUUID != v.UUID {
+			continue
+		}
+
+		if dt, err := d.Read(index); err == nil {
+			result[index] = dt
+		}
+	}
+	return result, nil
+}
+
 // Update метод обновляет данные.
 func (d Data) Update(index int, dt model.DataTypeable) error {
-	encrypted, err := d.encryptor.Encrypt(dt)
+	storeData, err := d.storage.Read(index)
+	if err != nil {
+		return err
+	}
+
+	enc, err := d.encryptorFor(storeData.VaultUUID)
+	if err != nil {
+		return err
+	}
+
+	encrypted, err := enc.Encrypt(dt)
 	if err != nil {
 		return err
 	}
@@ -115,3 +186,17 @@ func (d Data) Update(index int, dt model.DataTypeable) error {
 func (d Data) Delete(index int) error {
 	return d.storage.Delete(index)
 }
+
+// encryptorFor метод возвращает шифровщик записей: личных (пустой vaultUUID) или общего хранилища.
+func (d Data) encryptorFor(vaultUUID string) (Encryptor, error) {
+	if vaultUUID == "" {
+		return d.encryptor, nil
+	}
+
+	c, err := d.vaults.Cipher(vaultUUID)
+	if err != nil {
+		return nil, err
+	}
+
+	return encryptor.New(c), nil
+}
@@ -7,6 +7,8 @@ import (
 	"github.com/casnerano/seckeep/internal/client/model"
 	mock_data "github.com/casnerano/seckeep/internal/client/service/data/mock"
 	smodel "github.com/casnerano/seckeep/internal/pkg/model"
+	vmodel "github.com/casnerano/seckeep/internal/server/model"
+	"github.com/casnerano/seckeep/pkg/cipher"
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/suite"
 )
@@ -19,6 +21,7 @@ type DataTestSuite struct {
 	suite.Suite
 	storage    *mock_data.MockStorage
 	encryptor  *mock_data.MockEncryptor
+	vaults     *mock_data.MockVaultKeyring
 	dataSerice *Data
 }
 
@@ -28,7 +31,8 @@ func (s *DataTestSuite) SetupSuite() {
 
 	s.storage = mock_data.NewMockStorage(ctrl)
 	s.encryptor = mock_data.NewMockEncryptor(ctrl)
-	s.dataSerice = New(s.storage, s.encryptor)
+	s.vaults = mock_data.NewMockVaultKeyring(ctrl)
+	s.dataSerice = New(s.storage, s.encryptor, s.vaults)
 }
 
 func (s *DataTestSuite) TestCreate() {
@@ -137,6 +141,7 @@ func (s *DataTestSuite) TestUpdate() {
 
 	s.Run("Correct data", func() {
 		encrypted := []byte{1, 2, 3, 4, 5}
+		s.storage.EXPECT().Read(1).Return(&model.StoreData{Type: smodel.DataTypeText}, nil)
 		s.encryptor.EXPECT().Encrypt(textDt).Return(encrypted, nil)
 		s.storage.EXPECT().Update(1, encrypted, gomock.Any()).Return(nil)
 		err := s.dataSerice.Update(1, textDt)
@@ -145,11 +150,42 @@ func (s *DataTestSuite) TestUpdate() {
 	})
 
 	s.Run("Encryptor unknown error", func() {
+		s.storage.EXPECT().Read(1).Return(&model.StoreData{Type: smodel.DataTypeText}, nil)
 		s.encryptor.EXPECT().Encrypt(textDt).Return(nil, errUnknown)
 		err := s.dataSerice.Update(1, textDt)
 
 		s.ErrorIs(err, errUnknown)
 	})
+
+	s.Run("Vault key unavailable", func() {
+		s.storage.EXPECT().Read(1).Return(&model.StoreData{Type: smodel.DataTypeText, VaultUUID: "4d1f"}, nil)
+		s.vaults.EXPECT().Cipher("4d1f").Return(nil, errUnknown)
+		err := s.dataSerice.Update(1, textDt)
+
+		s.ErrorIs(err, errUnknown)
+	})
+}
+
+func (s *DataTestSuite) TestCreateInVault() {
+	textDt := model.DataText{Value: "Example text"}
+
+	s.Run("Writable vault", func() {
+		s.vaults.EXPECT().Find("family").Return(&vmodel.Vault{UUID: "4d1f", Role: vmodel.VaultRoleEditor}, nil)
+		s.vaults.EXPECT().Cipher("4d1f").Return(cipher.New([]byte("vault key")), nil)
+		s.storage.EXPECT().Create(gomock.Any()).DoAndReturn(func(sd *model.StoreData) error {
+			s.Equal("4d1f", sd.VaultUUID)
+			s.NotEmpty(sd.Value)
+			return nil
+		})
+
+		s.NoError(s.dataSerice.CreateInVault("family", textDt))
+	})
+
+	s.Run("Read-only vault", func() {
+		s.vaults.EXPECT().Find("family").Return(&vmodel.Vault{UUID: "4d1f", Role: vmodel.VaultRoleViewer}, nil)
+
+		s.ErrorIs(s.dataSerice.CreateInVault("family", textDt), ErrReadOnlyVault)
+	})
 }
 
 func (s *DataTestSuite) TestDelete() {
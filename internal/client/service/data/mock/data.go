@@ -9,6 +9,8 @@ import (
 	time "time"
 
 	model "github.com/casnerano/seckeep/internal/client/model"
+	model0 "github.com/casnerano/seckeep/internal/server/model"
+	cipher "github.com/casnerano/seckeep/pkg/cipher"
 	gomock "github.com/golang/mock/gomock"
 )
 
@@ -157,3 +159,56 @@ func (mr *MockEncryptorMockRecorder) Encrypt(dt interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Encrypt", reflect.TypeOf((*MockEncryptor)(nil).Encrypt), dt)
 }
+
+// MockVaultKeyring is a mock of VaultKeyring interface.
+type MockVaultKeyring struct {
+	ctrl     *gomock.Controller
+	recorder *MockVaultKeyringMockRecorder
+}
+
+// MockVaultKeyringMockRecorder is the mock recorder for MockVaultKeyring.
+type MockVaultKeyringMockRecorder struct {
+	mock *MockVaultKeyring
+}
+
+// NewMockVaultKeyring creates a new mock instance.
+func NewMockVaultKeyring(ctrl *gomock.Controller) *MockVaultKeyring {
+	mock := &MockVaultKeyring{ctrl: ctrl}
+	mock.recorder = &MockVaultKeyringMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockVaultKeyring) EXPECT() *MockVaultKeyringMockRecorder {
+	return m.recorder
+}
+
+// Cipher mocks base method.
+func (m *MockVaultKeyring) Cipher(vaultUUID string) (*cipher.Cipher, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Cipher", vaultUUID)
+	ret0, _ := ret[0].(*cipher.Cipher)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Cipher indicates an expected call of Cipher.
+func (mr *MockVaultKeyringMockRecorder) Cipher(vaultUUID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Cipher", reflect.TypeOf((*MockVaultKeyring)(nil).Cipher), vaultUUID)
+}
+
+// Find mocks base method.
+func (m *MockVaultKeyring) Find(nameOrUUID string) (*model0.Vault, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Find", nameOrUUID)
+	ret0, _ := ret[0].(*model0.Vault)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Find indicates an expected call of Find.
+func (mr *MockVaultKeyringMockRecorder) Find(nameOrUUID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Find", reflect.TypeOf((*MockVaultKeyring)(nil).Find), nameOrUUID)
+}
@@ -0,0 +1,297 @@
+package print
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/casnerano/seckeep/internal/client/model"
+	smodel "github.com/casnerano/seckeep/internal/pkg/model"
+	"github.com/casnerano/seckeep/pkg/card"
+	"gopkg.in/yaml.v3"
+)
+
+// Format формат вывода данных.
+type Format string
+
+// Форматы вывода данных.
+const (
+	// FormatText текст для чтения человеком.
+	FormatText Format = "text"
+
+	// FormatJSON записи по схеме Record в формате JSON.
+	FormatJSON Format = "json"
+
+	// FormatYAML записи по схеме Record в формате YAML.
+	FormatYAML Format = "yaml"
+
+	// FormatTable таблица.
+	FormatTable Format = "table"
+)
+
+// ErrUnknownFormat неизвестный формат вывода.
+var ErrUnknownFormat = errors.New("unknown output format, expected text, json, yaml or table")
+
+// secretMask маска скрытого значения.
+const secretMask = "*****"
+
+// ParseFormat разбирает формат вывода, пустая строка — FormatText.
+func ParseFormat(s string) (Format, error) {
+	switch format := Format(strings.ToLower(strings.TrimSpace(s))); format {
+	case "":
+		return FormatText, nil
+	case FormatText, FormatJSON, FormatYAML, FormatTable:
+		return format, nil
+	}
+	return "", fmt.Errorf("%w: %q", ErrUnknownFormat, s)
+}
+
+// Machine проверяет, что формат предназначен для обработки программами (JSON, YAML).
+func (f Format) Machine() bool {
+	return f == FormatJSON || f == FormatYAML
+}
+
+// Record структура записи в машиночитаемом виде.
+// Схема стабильна: все ключи, кроме content, присутствуют всегда, списки пустые, а не null.
+// Значения секретных полей, номер карты и скрытые пользовательские поля маскируются, если не включен показ.
+type Record struct {
+	Index       int                `json:"index" yaml:"index"`
+	Type        smodel.DataType    `json:"type" yaml:"type"`
+	Title       string             `json:"title" yaml:"title"`
+	Folder      string             `json:"folder" yaml:"folder"`
+	Tags        []string           `json:"tags" yaml:"tags"`
+	Favorite    bool               `json:"favorite" yaml:"favorite"`
+	Values      map[string]string  `json:"values" yaml:"values"`
+	URIs        []RecordURI        `json:"uris" yaml:"uris"`
+	Fields      []RecordField      `json:"fields" yaml:"fields"`
+	Attachments []RecordAttachment `json:"attachments" yaml:"attachments"`
+	Meta        []string           `json:"meta" yaml:"meta"`
+
+	// Content содержимое документа в base64, только при выводе одной записи.
+	Content string `json:"content,omitempty" yaml:"content,omitempty"`
+}
+
+// RecordURI структура адреса сайта учетной записи.
+type RecordURI struct {
+	URI   string `json:"uri" yaml:"uri"`
+	Match string `json:"match" yaml:"match"`
+}
+
+// RecordField структура пользовательского поля.
+type RecordField struct {
+	Name  string `json:"name" yaml:"name"`
+	Type  string `json:"type" yaml:"type"`
+	Value string `json:"value" yaml:"value"`
+}
+
+// RecordAttachment структура прикрепленного файла: имя и размер в байтах.
+type RecordAttachment struct {
+	Name string `json:"name" yaml:"name"`
+	Size int    `json:"size" yaml:"size"`
+}
+
+// FormattedList метод печатает набор данных в формате format, записи упорядочены по индексу.
+// Для FormatText печатает список, сгруппированный по типу.
+func (p *Print) FormattedList(format Format, dt map[int]model.DataTypeable) error {
+	indexes := make([]int, 0, len(dt))
+	for index := range dt {
+		indexes = append(indexes, index)
+	}
+	sort.Ints(indexes)
+
+	records := make([]Record, 0, len(dt))
+	for _, index := range indexes {
+		records = append(records, p.record(index, dt[index], false))
+	}
+
+	switch format {
+	case FormatText:
+		p.GroupedList(dt)
+		return nil
+	case FormatTable:
+		return p.listTable(records)
+	}
+
+	return p.encode(format, records)
+}
+
+// FormattedDetail метод печатает запись в формате format.
+// Для FormatText печатает детальную информацию.
+func (p *Print) FormattedDetail(format Format, index int, dt model.DataTypeable) error {
+	record := p.record(index, dt, true)
+
+	switch format {
+	case FormatText:
+		p.Detail(index, dt)
+		return nil
+	case FormatTable:
+		return p.detailTable(record)
+	}
+
+	return p.encode(format, record)
+}
+
+// record метод возвращает запись в машиночитаемом виде, detail — с содержимым документа.
+func (p *Print) record(index int, dt model.DataTypeable, detail bool) Record {
+	info := dt.Info()
+
+	record := Record{
+		Index:       index,
+		Type:        dt.Type(),
+		Title:       info.Title,
+		Folder:      info.Folder,
+		Tags:        append(make([]string, 0, len(info.Tags)), info.Tags...),
+		Favorite:    info.Favorite,
+		Values:      make(map[string]string),
+		URIs:        make([]RecordURI, 0),
+		Fields:      make([]RecordField, 0, len(info.Fields)),
+		Attachments: make([]RecordAttachment, 0),
+		Meta:        make([]string, 0),
+	}
+
+	if spec, ok := model.LookupType(dt.Type()); ok {
+		for _, field := range spec.Fields {
+			value := field.Get(dt)
+			if field.Secret && value != "" && !p.reveal {
+				value = secretMask
+			}
+			record.Values[field.Name] = value
+		}
+		record.Meta = append(record.Meta, spec.Meta(dt)...)
+	}
+
+	switch data := dt.(type) {
+	case *model.DataCredential:
+		for _, uri := range data.URIs {
+			record.URIs = append(record.URIs, RecordURI{URI: uri.URI, Match: strings.ToLower(string(uri.MatchMode()))})
+		}
+	case *model.DataCard:
+		if !p.reveal {
+			record.Values["number"] = card.Mask(data.Number)
+		}
+	case *model.DataDocument:
+		if detail {
+			record.Content = base64.StdEncoding.EncodeToString(data.Content)
+		}
+	}
+
+	for _, field := range info.Fields {
+		value := field.Value
+		if field.Type == model.FieldTypeHidden && !p.reveal {
+			value = secretMask
+		}
+		record.Fields = append(record.Fields, RecordField{
+			Name:  field.Name,
+			Type:  strings.ToLower(string(field.Type)),
+			Value: value,
+		})
+	}
+
+	for _, attachment := range model.Attachments(dt) {
+		record.Attachments = append(record.Attachments, RecordAttachment{Name: attachment.Name, Size: len(attachment.Content)})
+	}
+
+	return record
+}
+
+// encode метод печатает значение в формате JSON или YAML.
+func (p *Print) encode(format Format, v any) error {
+	switch format {
+	case FormatJSON:
+		encoder := json.NewEncoder(p.writer)
+		encoder.SetEscapeHTML(false)
+		encoder.SetIndent("", "  ")
+		return encoder.Encode(v)
+	case FormatYAML:
+		encoder := yaml.NewEncoder(p.writer)
+		encoder.SetIndent(2)
+		if err := encoder.Encode(v); err != nil {
+			return err
+		}
+		return encoder.Close()
+	}
+	return fmt.Errorf("%w: %q", ErrUnknownFormat, format)
+}
+
+// listTable метод печатает записи таблицей: по строке на запись, основные поля — в последней колонке.
+func (p *Print) listTable(records []Record) error {
+	w := tabwriter.NewWriter(p.writer, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "№\tТип\tЗаголовок\tПапка\tТеги\tДанные")
+
+	for _, record := range records {
+		keys := make([]string, 0, len(record.Values))
+		for key := range record.Values {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+
+		values := make([]string, 0, len(keys))
+		for _, key := range keys {
+			values = append(values, key+"="+tableCell(record.Values[key]))
+		}
+
+		fmt.Fprintf(
+			w,
+			"%d\t%s\t%s\t%s\t%s\t%s\n",
+			record.Index,
+			record.Type,
+			tableCell(record.Title),
+			tableCell(record.Folder),
+			tableCell(strings.Join(record.Tags, ", ")),
+			strings.Join(values, " "),
+		)
+	}
+
+	return w.Flush()
+}
+
+// detailTable метод печатает запись таблицей «поле — значение».
+func (p *Print) detailTable(record Record) error {
+	w := tabwriter.NewWriter(p.writer, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "Поле\tЗначение")
+
+	row := func(name, value string) {
+		fmt.Fprintf(w, "%s\t%s\n", name, tableCell(value))
+	}
+
+	row("index", fmt.Sprint(record.Index))
+	row("type", string(record.Type))
+	row("title", record.Title)
+	row("folder", record.Folder)
+	row("tags", strings.Join(record.Tags, ", "))
+	row("favorite", fmt.Sprint(record.Favorite))
+
+	keys := make([]string, 0, len(record.Values))
+	for key := range record.Values {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		row(key, record.Values[key])
+	}
+
+	for _, uri := range record.URIs {
+		row("uri", uri.URI+" ("+uri.Match+")")
+	}
+	for _, field := range record.Fields {
+		row(field.Name, field.Value)
+	}
+	for _, attachment := range record.Attachments {
+		row("attachment", fmt.Sprintf("%s (%d Б)", attachment.Name, attachment.Size))
+	}
+	row("meta", strings.Join(record.Meta, "; "))
+
+	return w.Flush()
+}
+
+// tableCell возвращает значение для ячейки таблицы: переводы строк и табуляции экранируются, пустое — «—».
+func tableCell(value string) string {
+	if value == "" {
+		return "—"
+	}
+	return strings.NewReplacer("\n", `\n`, "\t", `\t`).Replace(value)
+}
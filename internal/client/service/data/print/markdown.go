@@ -0,0 +1,63 @@
+package print
+
+import (
+	"regexp"
+	"strings"
+	"unicode/utf8"
+)
+
+// Разметка Markdown, которая заменяется при печати в терминал.
+var (
+	markdownHeading  = regexp.MustCompile(`^(#{1,6})\s+(.*)$`)
+	markdownListItem = regexp.MustCompile(`^(\s*)[-*+]\s+(.*)$`)
+	markdownEmphasis = regexp.MustCompile(`(\*\*|__)(.+?)(\*\*|__)`)
+	markdownCode     = regexp.MustCompile("`([^`]+)`")
+	markdownLink     = regexp.MustCompile(`\[([^\]]+)\]\(([^)]+)\)`)
+)
+
+// Markdown возвращает текст в формате Markdown, подготовленный для печати в терминал:
+// заголовки подчеркиваются, маркеры списков заменяются на «•», ссылки печатаются как «текст (адрес)»,
+// выделение и код печатаются без служебных символов. Блоки кода (```) печатаются как есть.
+func Markdown(text string) string {
+	lines := strings.Split(text, "\n")
+	result := make([]string, 0, len(lines))
+
+	inCode := false
+	for _, line := range lines {
+		if strings.HasPrefix(strings.TrimSpace(line), "```") {
+			inCode = !inCode
+			continue
+		}
+
+		if inCode {
+			result = append(result, "    "+line)
+			continue
+		}
+
+		if match := markdownHeading.FindStringSubmatch(line); match != nil {
+			underline := "-"
+			if len(match[1]) == 1 {
+				underline = "="
+			}
+			heading := inline(match[2])
+			result = append(result, heading, strings.Repeat(underline, utf8.RuneCountInString(heading)))
+			continue
+		}
+
+		if match := markdownListItem.FindStringSubmatch(line); match != nil {
+			result = append(result, match[1]+"• "+inline(match[2]))
+			continue
+		}
+
+		result = append(result, inline(line))
+	}
+
+	return strings.Join(result, "\n")
+}
+
+// inline заменяет строчную разметку Markdown: выделение, код и ссылки.
+func inline(line string) string {
+	line = markdownLink.ReplaceAllString(line, "$1 ($2)")
+	line = markdownEmphasis.ReplaceAllString(line, "$2")
+	return markdownCode.ReplaceAllString(line, "$1")
+}
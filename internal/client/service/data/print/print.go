@@ -6,15 +6,19 @@ import (
 	"io"
 	"math"
 	"sort"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/casnerano/seckeep/internal/client/model"
 	smodel "github.com/casnerano/seckeep/internal/pkg/model"
+	"github.com/casnerano/seckeep/pkg/card"
 )
 
 // Print структура печати данных.
 type Print struct {
 	writer io.Writer
+	reveal bool
 }
 
 // New конструктор.
@@ -22,6 +26,12 @@ func New(writer io.Writer) *Print {
 	return &Print{writer: writer}
 }
 
+// SetReveal метод включает печать значений скрытых пользовательских полей,
+// полного номера и CVV банковских карт.
+func (p *Print) SetReveal(reveal bool) {
+	p.reveal = reveal
+}
+
 // GroupedList метод печает набор данных сгрупированные по типу.
 func (p *Print) GroupedList(dt map[int]model.DataTypeable) {
 	groups := make(map[smodel.DataType]map[int]model.DataTypeable)
@@ -38,15 +48,8 @@ func (p *Print) GroupedList(dt map[int]model.DataTypeable) {
 	grCount := len(groups)
 
 	for key := range groups {
-		switch key {
-		case smodel.DataTypeCredential:
-			fmt.Fprintln(p.writer, "Учетные записи:")
-		case smodel.DataTypeText:
-			fmt.Fprintln(p.writer, "Текстовые данные:")
-		case smodel.DataTypeCard:
-			fmt.Fprintln(p.writer, "Данные кредитных карт:")
-		case smodel.DataTypeDocument:
-			fmt.Fprintln(p.writer, "Документы:")
+		if spec, ok := model.LookupType(key); ok {
+			fmt.Fprintf(p.writer, "%s:\n", spec.Title)
 		}
 
 		sortedIndex := make([]int, 0, len(groups[key]))
@@ -57,55 +60,7 @@ func (p *Print) GroupedList(dt map[int]model.DataTypeable) {
 		sort.Ints(sortedIndex)
 
 		for _, index := range sortedIndex {
-			switch key {
-			case smodel.DataTypeCredential:
-				if data, ok := groups[key][index].(*model.DataCredential); ok {
-					fmt.Fprintf(
-						p.writer,
-						"#%d [ Логин: %s | Пароль: ***** | Мета: %s ]\n",
-						index,
-						data.Login,
-						p.JoinedMetaString(data.Meta),
-					)
-				}
-			case smodel.DataTypeText:
-				if data, ok := groups[key][index].(*model.DataText); ok {
-					length := float64(len(data.Value))
-					fmt.Fprintf(
-						p.writer,
-						"#%d [ Значение: %s | Мета: %s ]\n",
-						index,
-						data.Value[:int(length-math.Ceil(length/100*70))],
-						p.JoinedMetaString(data.Meta),
-					)
-				}
-			case smodel.DataTypeCard:
-				if data, ok := groups[key][index].(*model.DataCard); ok {
-					ownerValue := "—"
-					if data.Owner != "" {
-						ownerValue = data.Owner
-					}
-					fmt.Fprintf(
-						p.writer,
-						"#%d [ Номер: %s | Месяц/Год: %s | CVV: *** | Держатель: %s | Мета: %s ]\n",
-						index,
-						data.Number,
-						data.MonthYear,
-						ownerValue,
-						p.JoinedMetaString(data.Meta),
-					)
-				}
-			case smodel.DataTypeDocument:
-				if data, ok := groups[key][index].(*model.DataDocument); ok {
-					fmt.Fprintf(
-						p.writer,
-						"#%d [ Название: %s | Мета: %s ]\n",
-						index,
-						data.Name,
-						p.JoinedMetaString(data.Meta),
-					)
-				}
-			}
+			fmt.Fprintln(p.writer, p.line(index, groups[key][index]))
 		}
 
 		grIndex++
@@ -115,26 +70,181 @@ func (p *Print) GroupedList(dt map[int]model.DataTypeable) {
 	}
 }
 
+// Tree метод печатает набор данных деревом папок.
+// Записи без папки печатаются в корне, папки и записи внутри них отсортированы.
+func (p *Print) Tree(dt map[int]model.DataTypeable) {
+	root := newFolderNode()
+	for index := range dt {
+		node := root
+		if folder := dt[index].Info().Folder; folder != "" {
+			for _, name := range strings.Split(folder, "/") {
+				node = node.child(name)
+			}
+		}
+		node.items = append(node.items, index)
+	}
+
+	p.printNode(root, dt, 0)
+}
+
+// folderNode узел дерева папок.
+type folderNode struct {
+	children map[string]*folderNode
+	items    []int
+}
+
+// newFolderNode конструктор узла дерева папок.
+func newFolderNode() *folderNode {
+	return &folderNode{children: make(map[string]*folderNode)}
+}
+
+// child возвращает вложенную папку name, создавая её при отсутствии.
+func (n *folderNode) child(name string) *folderNode {
+	if _, ok := n.children[name]; !ok {
+		n.children[name] = newFolderNode()
+	}
+	return n.children[name]
+}
+
+// printNode метод печатает узел дерева папок с отступом depth.
+func (p *Print) printNode(node *folderNode, dt map[int]model.DataTypeable, depth int) {
+	indent := strings.Repeat("  ", depth)
+
+	names := make([]string, 0, len(node.children))
+	for name := range node.children {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		fmt.Fprintf(p.writer, "%s%s/\n", indent, name)
+		p.printNode(node.children[name], dt, depth+1)
+	}
+
+	sort.Ints(node.items)
+	for _, index := range node.items {
+		fmt.Fprintf(p.writer, "%s%s\n", indent, p.line(index, dt[index]))
+	}
+}
+
+// Line метод печатает краткое однострочное представление записи.
+func (p *Print) Line(index int, dt model.DataTypeable) {
+	fmt.Fprintln(p.writer, p.line(index, dt))
+}
+
+// line метод возвращает краткое однострочное представление записи.
+func (p *Print) line(index int, dt model.DataTypeable) string {
+	heading := p.heading(index, dt)
+
+	switch data := dt.(type) {
+	case *model.DataCredential:
+		return fmt.Sprintf(
+			"%s [ Логин: %s | Пароль: ***** | Мета: %s ]",
+			heading,
+			data.Login,
+			p.JoinedMetaString(data.Meta),
+		)
+	case *model.DataText:
+		length := float64(len(data.Value))
+		return fmt.Sprintf(
+			"%s [ Значение: %s | Мета: %s ]",
+			heading,
+			data.Value[:int(length-math.Ceil(length/100*70))],
+			p.JoinedMetaString(data.Meta),
+		)
+	case *model.DataCard:
+		ownerValue := "—"
+		if data.Owner != "" {
+			ownerValue = data.Owner
+		}
+		return fmt.Sprintf(
+			"%s [ Номер: %s | Месяц/Год: %s | CVV: *** | Держатель: %s | Мета: %s ]",
+			heading,
+			card.Mask(data.Number),
+			cardExpiry(data),
+			ownerValue,
+			p.JoinedMetaString(data.Meta),
+		)
+	case *model.DataDocument:
+		return fmt.Sprintf(
+			"%s [ Название: %s | Мета: %s ]",
+			heading,
+			data.Name,
+			p.JoinedMetaString(data.Meta),
+		)
+	}
+
+	if spec, ok := model.LookupType(dt.Type()); ok {
+		values := make([]string, 0, len(spec.Fields)+1)
+		for _, field := range spec.Fields {
+			if field.Multiline {
+				continue
+			}
+
+			value := field.Get(dt)
+			switch {
+			case value == "":
+				value = "—"
+			case field.Secret:
+				value = "*****"
+			}
+			values = append(values, fmt.Sprintf("%s: %s", field.Title, value))
+		}
+		values = append(values, "Мета: "+p.JoinedMetaString(spec.Meta(dt)))
+
+		return fmt.Sprintf("%s [ %s ]", heading, strings.Join(values, " | "))
+	}
+
+	return heading
+}
+
+// heading метод возвращает заголовок записи: индекс, отметку избранного и название.
+func (p *Print) heading(index int, dt model.DataTypeable) string {
+	info := dt.Info()
+
+	heading := fmt.Sprintf("#%d", index)
+	if info.Favorite {
+		heading += " ★"
+	}
+	if info.Title != "" {
+		heading += " " + info.Title
+	}
+
+	return heading
+}
+
+// cardExpiry возвращает срок действия карты в формате MM/YY или исходное значение, если его не удалось разобрать.
+func cardExpiry(data *model.DataCard) string {
+	if expiry, err := data.Expiry(); err == nil {
+		return expiry.String()
+	}
+	return data.MonthYear
+}
+
 // Detail метод печает детальную информацию данных.
 func (p *Print) Detail(index int, dt model.DataTypeable) {
+	fmt.Fprintf(p.writer, "Индекс: #%d\n", index)
+	p.Content(dt)
+}
+
+// Content метод печатает содержимое данных без индекса (например, полученных по ссылке).
+func (p *Print) Content(dt model.DataTypeable) {
+	p.info(dt.Info())
+
 	switch dt.Type() {
 	case smodel.DataTypeCredential:
 		if data, ok := dt.(*model.DataCredential); ok {
-			fmt.Fprintf(
-				p.writer,
-				"Индекс: #%d\nЛогин: %s\nПароль: %s\nМета: %s",
-				index,
-				data.Login,
-				data.Password,
-				p.JoinedMetaString(data.Meta),
-			)
+			fmt.Fprintf(p.writer, "Логин: %s\nПароль: %s\n", data.Login, data.Password)
+			for _, uri := range data.URIs {
+				fmt.Fprintf(p.writer, "Адрес: %s (%s)\n", uri.URI, strings.ToLower(string(uri.MatchMode())))
+			}
+			fmt.Fprintf(p.writer, "Мета: %s", p.JoinedMetaString(data.Meta))
 		}
 	case smodel.DataTypeText:
 		if data, ok := dt.(*model.DataText); ok {
 			fmt.Fprintf(
 				p.writer,
-				"Индекс: #%d\nЗначение: %s\nМета: %s",
-				index,
+				"Значение: %s\nМета: %s",
 				data.Value,
 				p.JoinedMetaString(data.Meta),
 			)
@@ -145,13 +255,25 @@ func (p *Print) Detail(index int, dt model.DataTypeable) {
 			if data.Owner != "" {
 				ownerValue = data.Owner
 			}
+			number, cvv := card.Mask(data.Number), "***"
+			if p.reveal {
+				number, cvv = card.Format(data.Number), data.CVV
+			}
+			if brand := data.Brand(); brand != card.BrandUnknown {
+				number += " (" + string(brand) + ")"
+			}
+
+			expiry := cardExpiry(data)
+			if e, err := data.Expiry(); err == nil && e.Expired(time.Now()) {
+				expiry += " (истекла)"
+			}
+
 			fmt.Fprintf(
 				p.writer,
-				"Индекс: #%d\nНомер: %s\nМесяц/Год: %s\nCVV: %s\nДержатель: %s\nМета: %s",
-				index,
-				data.Number,
-				data.MonthYear,
-				data.CVV,
+				"Номер: %s\nМесяц/Год: %s\nCVV: %s\nДержатель: %s\nМета: %s",
+				number,
+				expiry,
+				cvv,
 				ownerValue,
 				p.JoinedMetaString(data.Meta),
 			)
@@ -160,14 +282,120 @@ func (p *Print) Detail(index int, dt model.DataTypeable) {
 		if data, ok := dt.(*model.DataDocument); ok {
 			fmt.Fprintf(
 				p.writer,
-				"Индекс: #%d\nНазвание: %s\nКонтент:\n=====\n%s\n=====\nМета: %s",
-				index,
+				"Название: %s\nКонтент:\n=====\n%s\n=====\nМета: %s",
 				data.Name,
 				data.Content,
 				p.JoinedMetaString(data.Meta),
 			)
 		}
+	default:
+		if spec, ok := model.LookupType(dt.Type()); ok {
+			p.generic(spec, dt)
+		}
+	}
+
+	p.fields(dt.Info().Fields)
+	p.attachments(model.Attachments(dt))
+}
+
+// generic метод печатает основные поля и мета данные записи по описанию типа из реестра.
+func (p *Print) generic(spec model.TypeSpec, dt model.DataTypeable) {
+	for _, field := range spec.Fields {
+		value := field.Get(dt)
+		switch {
+		case field.Multiline:
+			if field.Markdown {
+				value = Markdown(value)
+			}
+			fmt.Fprintf(p.writer, "%s:\n=====\n%s\n=====\n", field.Title, strings.TrimRight(value, "\n"))
+		case value == "":
+			fmt.Fprintf(p.writer, "%s: —\n", field.Title)
+		default:
+			fmt.Fprintf(p.writer, "%s: %s\n", field.Title, value)
+		}
+	}
+	fmt.Fprintf(p.writer, "Мета: %s", p.JoinedMetaString(spec.Meta(dt)))
+}
+
+// info метод печатает заполненные сведения о записи.
+func (p *Print) info(info model.DataInfo) {
+	if info.Title != "" {
+		fmt.Fprintf(p.writer, "Заголовок: %s\n", info.Title)
+	}
+	if info.Folder != "" {
+		fmt.Fprintf(p.writer, "Папка: %s\n", info.Folder)
+	}
+	if len(info.Tags) > 0 {
+		fmt.Fprintf(p.writer, "Теги: %s\n", strings.Join(info.Tags, ", "))
+	}
+	if info.Favorite {
+		fmt.Fprintln(p.writer, "Избранное: да")
+	}
+}
+
+// fields метод печатает пользовательские поля записи, значения скрытых полей маскируются.
+func (p *Print) fields(fields []model.DataField) {
+	for _, field := range fields {
+		value := field.Value
+		switch field.Type {
+		case model.FieldTypeHidden:
+			if !p.reveal {
+				value = "*****"
+			}
+		case model.FieldTypeBoolean:
+			value = "нет"
+			if enabled, _ := strconv.ParseBool(field.Value); enabled {
+				value = "да"
+			}
+		case model.FieldTypeLinked:
+			value = "→ " + field.Value
+		}
+		fmt.Fprintf(p.writer, "\n%s: %s", field.Name, value)
+	}
+}
+
+// attachments метод печатает названия и размеры прикрепленных файлов.
+func (p *Print) attachments(attachments []model.DataAttachment) {
+	for _, attachment := range attachments {
+		fmt.Fprintf(p.writer, "\nВложение: %s (%d Б)", attachment.Name, len(attachment.Content))
+	}
+}
+
+// Diff метод печатает изменения значений записи, значения скрытых полей маскируются.
+// Многострочные значения печатаются построчно с пометками «-» (прежнее) и «+» (новое).
+func (p *Print) Diff(changes []model.DataChange) {
+	if len(changes) == 0 {
+		fmt.Fprintln(p.writer, "Изменений нет.")
+		return
+	}
+
+	for _, change := range changes {
+		oldValue, newValue := p.changeValue(change, change.Old), p.changeValue(change, change.New)
+
+		if !strings.Contains(oldValue+newValue, "\n") {
+			fmt.Fprintf(p.writer, "%s: %s → %s\n", change.Title, oldValue, newValue)
+			continue
+		}
+
+		fmt.Fprintf(p.writer, "%s:\n", change.Title)
+		for _, line := range strings.Split(strings.TrimSuffix(change.Old, "\n"), "\n") {
+			fmt.Fprintf(p.writer, "- %s\n", line)
+		}
+		for _, line := range strings.Split(strings.TrimSuffix(change.New, "\n"), "\n") {
+			fmt.Fprintf(p.writer, "+ %s\n", line)
+		}
+	}
+}
+
+// changeValue метод возвращает значение изменения для печати: пустое — «—», скрытое — маска.
+func (p *Print) changeValue(change model.DataChange, value string) string {
+	switch {
+	case value == "":
+		return "—"
+	case change.Secret && !p.reveal:
+		return "*****"
 	}
+	return value
 }
 
 // JoinedMetaString метод объеденяет слайс тегов (строк) в строку.
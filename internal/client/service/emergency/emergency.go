@@ -0,0 +1,261 @@
+// Package emergency содержит методы работы с экстренным доступом доверенных лиц.
+// Владелец шифрует ключ своих данных открытым ключом доверенного лица и передает его серверу.
+// Сервер выдает зашифрованный ключ доверенному лицу, только если владелец не отклонил запрос
+// доступа в течение периода ожидания, поэтому сам сервер данные владельца прочитать не может.
+package emergency
+
+//go:generate mockgen -destination=mock/emergency.go -source=emergency.go
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/casnerano/seckeep/internal/client/model"
+	"github.com/casnerano/seckeep/internal/client/service/data/encryptor"
+	"github.com/casnerano/seckeep/internal/client/service/keyring"
+	vmodel "github.com/casnerano/seckeep/internal/server/model"
+	"github.com/casnerano/seckeep/pkg/cipher"
+	"github.com/casnerano/seckeep/pkg/keybox"
+	"github.com/go-resty/resty/v2"
+)
+
+// Основные ошибки при работе с экстренным доступом.
+var (
+	// ErrUnauthorized отсутствует авторизация.
+	ErrUnauthorized = errors.New("unauthorized")
+
+	// ErrNotFound доступ или пользователь не найден.
+	ErrNotFound = errors.New("emergency access or user not found")
+
+	// ErrUserNotFound пользователь не найден или не опубликовал открытый ключ.
+	ErrUserNotFound = errors.New("user not found or has no public key")
+
+	// ErrNotReleased период ожидания еще не истек.
+	ErrNotReleased = errors.New("emergency access is not released yet")
+
+	// ErrNotRequested доступ не запрошен.
+	ErrNotRequested = errors.New("emergency access is not requested")
+
+	// ErrFingerprintMismatch отпечаток опубликованных ключей доверенного лица не совпадает с подтвержденным.
+	ErrFingerprintMismatch = errors.New("contact keys fingerprint mismatch")
+)
+
+// Keyring интерфейс получения ключей пользователей.
+type Keyring interface {
+	Keys() (*keyring.Keys, error)
+	PublicKeys(login string) (*vmodel.UserKeys, error)
+}
+
+// Emergency структура для работы с экстренным доступом.
+type Emergency struct {
+	client  *resty.Client
+	keyring Keyring
+	dataKey []byte
+}
+
+// New конструктор.
+// keyring — ключи пользователя, dataKey — ключ, которым зашифрованы личные данные пользователя.
+func New(client *resty.Client, keyring Keyring, dataKey []byte) *Emergency {
+	return &Emergency{
+		client:  client,
+		keyring: keyring,
+		dataKey: dataKey,
+	}
+}
+
+// Fingerprint метод возвращает отпечаток открытых ключей доверенного лица login, опубликованных на сервере.
+func (e *Emergency) Fingerprint(login string) (string, error) {
+	userKeys, err := e.publicKeys(login)
+	if err != nil {
+		return "", err
+	}
+
+	return keybox.Fingerprint(userKeys.EncryptionKey, userKeys.SigningKey), nil
+}
+
+// Grant метод выдает доверенному лицу login экстренный доступ с периодом ожидания waitPeriod.
+// Ключ данных шифруется открытым ключом доверенного лица, опубликованным на сервере,
+// только если отпечаток этих ключей совпадает с подтвержденным пользователем fingerprint:
+// иначе сервер мог бы подменить ключ и получить доступ к данным.
+func (e *Emergency) Grant(login, fingerprint string, waitPeriod time.Duration) (*vmodel.EmergencyAccess, error) {
+	userKeys, err := e.publicKeys(login)
+	if err != nil {
+		return nil, err
+	}
+
+	if normalizeFingerprint(keybox.Fingerprint(userKeys.EncryptionKey, userKeys.SigningKey)) != normalizeFingerprint(fingerprint) {
+		return nil, fmt.Errorf("%w: %s", ErrFingerprintMismatch, login)
+	}
+
+	wrappedKey, err := keybox.Seal(e.dataKey, userKeys.EncryptionKey)
+	if err != nil {
+		return nil, err
+	}
+
+	access := &vmodel.EmergencyAccess{}
+	response, err := e.client.R().
+		SetBody(vmodel.EmergencyGrantRequest{
+			Login:      login,
+			WrappedKey: wrappedKey,
+			WaitPeriod: int64(waitPeriod.Seconds()),
+		}).
+		SetResult(access).
+		Put("/emergency/contacts")
+	if err != nil {
+		return nil, err
+	}
+
+	if err = statusError(response); err != nil {
+		return nil, err
+	}
+
+	return access, nil
+}
+
+// Contacts метод возвращает доверенных лиц пользователя.
+func (e *Emergency) Contacts() ([]*vmodel.EmergencyAccess, error) {
+	return e.list("/emergency/contacts")
+}
+
+// Revoke метод отзывает экстренный доступ доверенного лица login.
+func (e *Emergency) Revoke(login string) error {
+	response, err := e.client.R().Delete("/emergency/contacts/" + url.PathEscape(login))
+	if err != nil {
+		return err
+	}
+
+	return statusError(response)
+}
+
+// Deny метод отклоняет запрос экстренного доступа доверенного лица login.
+func (e *Emergency) Deny(login string) error {
+	response, err := e.client.R().Post("/emergency/contacts/" + url.PathEscape(login) + "/deny")
+	if err != nil {
+		return err
+	}
+
+	return statusError(response)
+}
+
+// Grants метод возвращает экстренные доступы, выданные пользователю другими владельцами.
+func (e *Emergency) Grants() ([]*vmodel.EmergencyAccess, error) {
+	return e.list("/emergency/grants")
+}
+
+// Request метод запрашивает экстренный доступ к данным владельца login.
+func (e *Emergency) Request(login string) (*vmodel.EmergencyAccess, error) {
+	access := &vmodel.EmergencyAccess{}
+	response, err := e.client.R().
+		SetResult(access).
+		Post("/emergency/grants/" + url.PathEscape(login) + "/request")
+	if err != nil {
+		return nil, err
+	}
+
+	if err = statusError(response); err != nil {
+		return nil, err
+	}
+
+	return access, nil
+}
+
+// Data метод получает и расшифровывает личные данные владельца login по открытому экстренному доступу.
+func (e *Emergency) Data(login string) ([]model.DataTypeable, error) {
+	emergencyData := &vmodel.EmergencyData{}
+	response, err := e.client.R().
+		SetResult(emergencyData).
+		Get("/emergency/grants/" + url.PathEscape(login) + "/data")
+	if err != nil {
+		return nil, err
+	}
+
+	if err = statusError(response); err != nil {
+		return nil, err
+	}
+
+	keys, err := e.keyring.Keys()
+	if err != nil {
+		return nil, err
+	}
+
+	dataKey, err := keys.Encryption.Open(emergencyData.WrappedKey)
+	if err != nil {
+		return nil, err
+	}
+
+	dataEncryptor := encryptor.New(cipher.New(dataKey))
+	result := make([]model.DataTypeable, 0, len(emergencyData.Data))
+
+	for _, datum := range emergencyData.Data {
+		dt, err := model.NewData(datum.Type)
+		if err != nil {
+			return nil, err
+		}
+
+		if err = dataEncryptor.Decrypt(datum.Value, dt); err != nil {
+			return nil, err
+		}
+
+		result = append(result, dt)
+	}
+
+	return result, nil
+}
+
+// list метод возвращает список доступов по адресу path.
+func (e *Emergency) list(path string) ([]*vmodel.EmergencyAccess, error) {
+	accesses := make([]*vmodel.EmergencyAccess, 0)
+	response, err := e.client.R().SetResult(&accesses).Get(path)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = statusError(response); err != nil {
+		return nil, err
+	}
+
+	return accesses, nil
+}
+
+// statusError возвращает ошибку по коду ответа сервера.
+func statusError(response *resty.Response) error {
+	switch response.StatusCode() {
+	case http.StatusOK:
+		return nil
+	case http.StatusBadRequest:
+		return fmt.Errorf("incorrect values: %w", errors.New(string(response.Body())))
+	case http.StatusUnauthorized:
+		return ErrUnauthorized
+	case http.StatusForbidden:
+		return ErrNotReleased
+	case http.StatusNotFound:
+		return ErrNotFound
+	case http.StatusConflict:
+		return ErrNotRequested
+	}
+
+	return fmt.Errorf("internal server error: %w", errors.New(response.Status()))
+}
+
+// publicKeys метод возвращает опубликованные открытые ключи доверенного лица login.
+func (e *Emergency) publicKeys(login string) (*vmodel.UserKeys, error) {
+	userKeys, err := e.keyring.PublicKeys(login)
+	if err != nil {
+		if errors.Is(err, keyring.ErrUserNotFound) {
+			return nil, fmt.Errorf("%w: %s", ErrUserNotFound, login)
+		}
+		return nil, err
+	}
+
+	return userKeys, nil
+}
+
+// normalizeFingerprint приводит отпечаток к виду без пробелов в верхнем регистре,
+// чтобы отпечаток можно было ввести без разбивки на группы.
+func normalizeFingerprint(fingerprint string) string {
+	return strings.ToUpper(strings.Join(strings.Fields(fingerprint), ""))
+}
@@ -0,0 +1,163 @@
+package emergency
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/casnerano/seckeep/internal/client/model"
+	"github.com/casnerano/seckeep/internal/client/service/data/encryptor"
+	mock_emergency "github.com/casnerano/seckeep/internal/client/service/emergency/mock"
+	"github.com/casnerano/seckeep/internal/client/service/keyring"
+	smodel "github.com/casnerano/seckeep/internal/pkg/model"
+	vmodel "github.com/casnerano/seckeep/internal/server/model"
+	"github.com/casnerano/seckeep/pkg/cipher"
+	"github.com/casnerano/seckeep/pkg/keybox"
+	"github.com/go-resty/resty/v2"
+	"github.com/golang/mock/gomock"
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/suite"
+)
+
+var jsonHeader = http.Header{"Content-Type": []string{"application/json"}}
+
+type EmergencyServiceTestSuite struct {
+	suite.Suite
+	client  *resty.Client
+	keyring *mock_emergency.MockKeyring
+	keys    *keyring.Keys
+	dataKey []byte
+	service *Emergency
+}
+
+func (s *EmergencyServiceTestSuite) SetupSuite() {
+	ctrl := gomock.NewController(s.T())
+	defer ctrl.Finish()
+
+	s.client = resty.New()
+	s.client.SetBaseURL("http://127.0.0.1/api")
+
+	encryption, err := keybox.GenerateKeyPair()
+	s.Require().NoError(err)
+
+	s.keys = &keyring.Keys{Encryption: encryption}
+	s.keyring = mock_emergency.NewMockKeyring(ctrl)
+	s.keyring.EXPECT().Keys().Return(s.keys, nil).AnyTimes()
+
+	s.dataKey = []byte("owner secret")
+	s.service = New(s.client, s.keyring, s.dataKey)
+}
+
+func (s *EmergencyServiceTestSuite) SetupTest() {
+	httpmock.ActivateNonDefault(s.client.GetClient())
+}
+
+func (s *EmergencyServiceTestSuite) TearDownTest() {
+	httpmock.DeactivateAndReset()
+}
+
+func (s *EmergencyServiceTestSuite) TestGrant() {
+	s.Run("Key is sealed for contact", func() {
+		s.keyring.EXPECT().PublicKeys("petr").
+			Return(&vmodel.UserKeys{Login: "petr", EncryptionKey: s.keys.Encryption.Public[:]}, nil)
+
+		httpmock.RegisterResponder(http.MethodPut, "http://127.0.0.1/api/emergency/contacts",
+			func(request *http.Request) (*http.Response, error) {
+				rd := vmodel.EmergencyGrantRequest{}
+				s.Require().NoError(json.NewDecoder(request.Body).Decode(&rd))
+				s.Equal(int64(259200), rd.WaitPeriod)
+
+				opened, err := s.keys.Encryption.Open(rd.WrappedKey)
+				s.Require().NoError(err)
+				s.Equal(s.dataKey, opened)
+
+				return httpmock.NewJsonResponse(http.StatusOK, vmodel.EmergencyAccess{
+					ContactLogin: rd.Login,
+					Status:       vmodel.EmergencyStatusGranted,
+				})
+			},
+		)
+
+		// Отпечаток можно передать без разбивки на группы и в нижнем регистре.
+		fingerprint := strings.ToLower(strings.ReplaceAll(keybox.Fingerprint(s.keys.Encryption.Public[:], nil), " ", ""))
+
+		access, err := s.service.Grant("petr", fingerprint, 72*time.Hour)
+		s.Require().NoError(err)
+		s.Equal(vmodel.EmergencyStatusGranted, access.Status)
+	})
+
+	s.Run("Fingerprint mismatch", func() {
+		httpmock.Reset()
+
+		other, err := keybox.GenerateKeyPair()
+		s.Require().NoError(err)
+
+		s.keyring.EXPECT().PublicKeys("petr").
+			Return(&vmodel.UserKeys{Login: "petr", EncryptionKey: other.Public[:]}, nil)
+
+		_, err = s.service.Grant("petr", keybox.Fingerprint(s.keys.Encryption.Public[:], nil), 72*time.Hour)
+		s.ErrorIs(err, ErrFingerprintMismatch)
+		s.Zero(httpmock.GetTotalCallCount())
+	})
+
+	s.Run("Unknown contact", func() {
+		s.keyring.EXPECT().PublicKeys("nobody").Return(nil, keyring.ErrUserNotFound)
+
+		_, err := s.service.Grant("nobody", "", time.Hour)
+		s.ErrorIs(err, ErrUserNotFound)
+	})
+}
+
+func (s *EmergencyServiceTestSuite) TestFingerprint() {
+	s.keyring.EXPECT().PublicKeys("petr").
+		Return(&vmodel.UserKeys{Login: "petr", EncryptionKey: s.keys.Encryption.Public[:]}, nil)
+
+	fingerprint, err := s.service.Fingerprint("petr")
+	s.Require().NoError(err)
+	s.Equal(keybox.Fingerprint(s.keys.Encryption.Public[:], nil), fingerprint)
+}
+
+func (s *EmergencyServiceTestSuite) TestData() {
+	s.Run("Released access", func() {
+		wrappedKey, err := keybox.Seal([]byte("owner secret"), s.keys.Encryption.Public[:])
+		s.Require().NoError(err)
+
+		value, err := encryptor.New(cipher.New([]byte("owner secret"))).Encrypt(model.DataText{Value: "last will"})
+		s.Require().NoError(err)
+
+		body := vmodel.EmergencyData{
+			OwnerLogin: "ivan",
+			WrappedKey: wrappedKey,
+			Data:       []*smodel.Data{{Type: smodel.DataTypeText, Value: value}},
+		}
+		responder, _ := httpmock.NewJsonResponder(http.StatusOK, body)
+		httpmock.RegisterResponder(http.MethodGet, "http://127.0.0.1/api/emergency/grants/ivan/data", responder)
+
+		data, err := s.service.Data("ivan")
+		s.Require().NoError(err)
+		s.Require().Len(data, 1)
+		s.Equal(&model.DataText{Value: "last will"}, data[0])
+	})
+
+	s.Run("Waiting period", func() {
+		httpmock.RegisterResponder(http.MethodGet, "http://127.0.0.1/api/emergency/grants/ivan/data",
+			httpmock.NewStringResponder(http.StatusForbidden, "").HeaderSet(jsonHeader))
+
+		_, err := s.service.Data("ivan")
+		s.ErrorIs(err, ErrNotReleased)
+	})
+}
+
+func (s *EmergencyServiceTestSuite) TestRequest() {
+	httpmock.RegisterResponder(http.MethodPost, "http://127.0.0.1/api/emergency/grants/ivan/request",
+		httpmock.NewStringResponder(http.StatusNotFound, "").HeaderSet(jsonHeader))
+
+	_, err := s.service.Request("ivan")
+	s.ErrorIs(err, ErrNotFound)
+}
+
+func TestEmergencyServiceTestSuite(t *testing.T) {
+	suite.Run(t, new(EmergencyServiceTestSuite))
+}
@@ -0,0 +1,150 @@
+// Package history содержит методы получения прежних версий (ревизий) записей.
+// Сервер хранит последние ревизии каждой записи в зашифрованном виде,
+// ревизии расшифровываются и сравниваются только на клиенте.
+package history
+
+//go:generate mockgen -destination=mock/history.go -source=history.go
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/casnerano/seckeep/internal/client/model"
+	smodel "github.com/casnerano/seckeep/internal/pkg/model"
+	"github.com/go-resty/resty/v2"
+)
+
+// Основные ошибки при работе с историей записей.
+var (
+	// ErrUnauthorized отсутствует авторизация.
+	ErrUnauthorized = errors.New("unauthorized")
+
+	// ErrNotFound запись не найдена на сервере.
+	ErrNotFound = errors.New("data not found on server")
+
+	// ErrNotSynced запись еще не синхронизирована с сервером.
+	ErrNotSynced = errors.New("data is not synchronized with server yet")
+
+	// ErrRevisionNotFound ревизия записи не найдена.
+	ErrRevisionNotFound = errors.New("revision not found")
+)
+
+// Storage интерфейс чтения записей локального хранилища.
+type Storage interface {
+	Read(index int) (*model.StoreData, error)
+}
+
+// Decryptor интерфейс расшифровки записей.
+type Decryptor interface {
+	Decrypt(storeData *model.StoreData) (model.DataTypeable, error)
+}
+
+// Revision структура расшифрованной ревизии записи.
+type Revision struct {
+	Revision  int
+	Version   time.Time
+	CreatedAt time.Time
+	Data      model.DataTypeable
+}
+
+// History структура получения ревизий записей.
+type History struct {
+	client    *resty.Client
+	storage   Storage
+	decryptor Decryptor
+}
+
+// New конструктор.
+func New(client *resty.Client, storage Storage, decryptor Decryptor) *History {
+	return &History{
+		client:    client,
+		storage:   storage,
+		decryptor: decryptor,
+	}
+}
+
+// List метод возвращает расшифрованные ревизии записи index, начиная с последней.
+func (h *History) List(index int) ([]Revision, error) {
+	storeData, err := h.storage.Read(index)
+	if err != nil {
+		return nil, err
+	}
+
+	if storeData.UUID == "" {
+		return nil, ErrNotSynced
+	}
+
+	revisions := make([]*smodel.DataRevision, 0)
+	response, err := h.client.R().
+		SetResult(&revisions).
+		Get(historyPath(storeData))
+	if err != nil {
+		return nil, err
+	}
+
+	if err = statusError(response); err != nil {
+		return nil, err
+	}
+
+	result := make([]Revision, 0, len(revisions))
+	for _, revision := range revisions {
+		dt, err := h.decryptor.Decrypt(&model.StoreData{
+			UUID:      storeData.UUID,
+			VaultUUID: storeData.VaultUUID,
+			Type:      storeData.Type,
+			Value:     revision.Value,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("revision %d: %w", revision.Revision, err)
+		}
+
+		result = append(result, Revision{
+			Revision:  revision.Revision,
+			Version:   revision.Version,
+			CreatedAt: revision.CreatedAt,
+			Data:      dt,
+		})
+	}
+
+	return result, nil
+}
+
+// Revision метод возвращает расшифрованную ревизию revision записи index.
+func (h *History) Revision(index, revision int) (*Revision, error) {
+	revisions, err := h.List(index)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range revisions {
+		if revisions[i].Revision == revision {
+			return &revisions[i], nil
+		}
+	}
+
+	return nil, fmt.Errorf("%w: %d", ErrRevisionNotFound, revision)
+}
+
+// historyPath возвращает путь ресурса ревизий записи: личной или общего хранилища.
+func historyPath(sd *model.StoreData) string {
+	if sd.VaultUUID != "" {
+		return "/vaults/" + sd.VaultUUID + "/data/" + sd.UUID + "/history"
+	}
+	return "/data/" + sd.UUID + "/history"
+}
+
+// statusError возвращает ошибку по коду ответа сервера.
+func statusError(response *resty.Response) error {
+	switch response.StatusCode() {
+	case http.StatusOK:
+		return nil
+	case http.StatusUnauthorized:
+		return ErrUnauthorized
+	case http.StatusNotFound:
+		return ErrNotFound
+	}
+
+	return fmt.Errorf("internal server error: %w", errors.New(response.Status()))
+}
@@ -0,0 +1,107 @@
+package history
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/casnerano/seckeep/internal/client/model"
+	mock_history "github.com/casnerano/seckeep/internal/client/service/history/mock"
+	smodel "github.com/casnerano/seckeep/internal/pkg/model"
+	"github.com/go-resty/resty/v2"
+	"github.com/golang/mock/gomock"
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/suite"
+)
+
+type HistoryServiceTestSuite struct {
+	suite.Suite
+	client    *resty.Client
+	storage   *mock_history.MockStorage
+	decryptor *mock_history.MockDecryptor
+	service   *History
+}
+
+func (s *HistoryServiceTestSuite) SetupSuite() {
+	ctrl := gomock.NewController(s.T())
+	defer ctrl.Finish()
+
+	s.client = resty.New()
+	s.client.SetBaseURL("http://127.0.0.1/api")
+
+	s.storage = mock_history.NewMockStorage(ctrl)
+	s.decryptor = mock_history.NewMockDecryptor(ctrl)
+	s.service = New(s.client, s.storage, s.decryptor)
+}
+
+func (s *HistoryServiceTestSuite) SetupTest() {
+	httpmock.ActivateNonDefault(s.client.GetClient())
+}
+
+func (s *HistoryServiceTestSuite) TearDownTest() {
+	httpmock.DeactivateAndReset()
+}
+
+func (s *HistoryServiceTestSuite) TestList() {
+	version := time.Date(2026, 10, 1, 12, 0, 0, 0, time.UTC)
+
+	s.Run("Vault revisions are decrypted", func() {
+		storeData := &model.StoreData{UUID: "data-uuid", VaultUUID: "vault-uuid", Type: smodel.DataTypeText}
+		s.storage.EXPECT().Read(1).Return(storeData, nil)
+
+		httpmock.RegisterResponder(http.MethodGet, "http://127.0.0.1/api/vaults/vault-uuid/data/data-uuid/history",
+			httpmock.NewJsonResponderOrPanic(http.StatusOK, []smodel.DataRevision{
+				{Revision: 2, Value: []byte("second"), Version: version},
+				{Revision: 1, Value: []byte("first"), Version: version},
+			}),
+		)
+
+		s.decryptor.EXPECT().Decrypt(gomock.Any()).DoAndReturn(func(sd *model.StoreData) (model.DataTypeable, error) {
+			s.Equal("vault-uuid", sd.VaultUUID)
+			return &model.DataText{Value: string(sd.Value)}, nil
+		}).Times(2)
+
+		revisions, err := s.service.List(1)
+		s.Require().NoError(err)
+		s.Equal([]Revision{
+			{Revision: 2, Version: version, Data: &model.DataText{Value: "second"}},
+			{Revision: 1, Version: version, Data: &model.DataText{Value: "first"}},
+		}, revisions)
+	})
+
+	s.Run("Not synchronized", func() {
+		s.storage.EXPECT().Read(2).Return(&model.StoreData{Type: smodel.DataTypeText}, nil)
+
+		_, err := s.service.List(2)
+		s.ErrorIs(err, ErrNotSynced)
+	})
+
+	s.Run("Not found on server", func() {
+		s.storage.EXPECT().Read(3).Return(&model.StoreData{UUID: "deleted", Type: smodel.DataTypeText}, nil)
+		httpmock.RegisterResponder(http.MethodGet, "http://127.0.0.1/api/data/deleted/history",
+			httpmock.NewStringResponder(http.StatusNotFound, ""),
+		)
+
+		_, err := s.service.List(3)
+		s.ErrorIs(err, ErrNotFound)
+	})
+}
+
+func (s *HistoryServiceTestSuite) TestRevision() {
+	s.storage.EXPECT().Read(1).Return(&model.StoreData{UUID: "data-uuid", Type: smodel.DataTypeText}, nil).Times(2)
+	httpmock.RegisterResponder(http.MethodGet, "http://127.0.0.1/api/data/data-uuid/history",
+		httpmock.NewJsonResponderOrPanic(http.StatusOK, []smodel.DataRevision{{Revision: 5, Value: []byte("old")}}),
+	)
+	s.decryptor.EXPECT().Decrypt(gomock.Any()).Return(&model.DataText{Value: "old"}, nil).Times(2)
+
+	revision, err := s.service.Revision(1, 5)
+	s.Require().NoError(err)
+	s.Equal(&model.DataText{Value: "old"}, revision.Data)
+
+	_, err = s.service.Revision(1, 4)
+	s.ErrorIs(err, ErrRevisionNotFound)
+}
+
+func TestHistoryServiceTestSuite(t *testing.T) {
+	suite.Run(t, new(HistoryServiceTestSuite))
+}
@@ -0,0 +1,88 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: history.go
+
+// Package mock_history is a generated GoMock package.
+package mock_history
+
+import (
+	reflect "reflect"
+
+	model "github.com/casnerano/seckeep/internal/client/model"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockStorage is a mock of Storage interface.
+type MockStorage struct {
+	ctrl     *gomock.Controller
+	recorder *MockStorageMockRecorder
+}
+
+// MockStorageMockRecorder is the mock recorder for MockStorage.
+type MockStorageMockRecorder struct {
+	mock *MockStorage
+}
+
+// NewMockStorage creates a new mock instance.
+func NewMockStorage(ctrl *gomock.Controller) *MockStorage {
+	mock := &MockStorage{ctrl: ctrl}
+	mock.recorder = &MockStorageMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockStorage) EXPECT() *MockStorageMockRecorder {
+	return m.recorder
+}
+
+// Read mocks base method.
+func (m *MockStorage) Read(index int) (*model.StoreData, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Read", index)
+	ret0, _ := ret[0].(*model.StoreData)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Read indicates an expected call of Read.
+func (mr *MockStorageMockRecorder) Read(index interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Read", reflect.TypeOf((*MockStorage)(nil).Read), index)
+}
+
+// MockDecryptor is a mock of Decryptor interface.
+type MockDecryptor struct {
+	ctrl     *gomock.Controller
+	recorder *MockDecryptorMockRecorder
+}
+
+// MockDecryptorMockRecorder is the mock recorder for MockDecryptor.
+type MockDecryptorMockRecorder struct {
+	mock *MockDecryptor
+}
+
+// NewMockDecryptor creates a new mock instance.
+func NewMockDecryptor(ctrl *gomock.Controller) *MockDecryptor {
+	mock := &MockDecryptor{ctrl: ctrl}
+	mock.recorder = &MockDecryptorMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockDecryptor) EXPECT() *MockDecryptorMockRecorder {
+	return m.recorder
+}
+
+// Decrypt mocks base method.
+func (m *MockDecryptor) Decrypt(storeData *model.StoreData) (model.DataTypeable, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Decrypt", storeData)
+	ret0, _ := ret[0].(model.DataTypeable)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Decrypt indicates an expected call of Decrypt.
+func (mr *MockDecryptorMockRecorder) Decrypt(storeData interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Decrypt", reflect.TypeOf((*MockDecryptor)(nil).Decrypt), storeData)
+}
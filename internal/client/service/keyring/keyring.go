@@ -0,0 +1,290 @@
+// Package keyring содержит методы работы с ключами пользователя.
+// Ключи шифрования (X25519) и подписи (Ed25519) генерируются на клиенте при первой авторизации.
+// Открытые ключи публикуются на сервере, закрытые хранятся на сервере и локально
+// только зашифрованными ключом хранилища профиля.
+package keyring
+
+import (
+	"crypto/ed25519"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+
+	"github.com/casnerano/seckeep/internal/server/model"
+	"github.com/casnerano/seckeep/pkg/keybox"
+	"github.com/go-resty/resty/v2"
+)
+
+// keysFileMode права доступа к локальному файлу ключей.
+const keysFileMode fs.FileMode = 0600
+
+// Основные ошибки при работе с ключами.
+var (
+	// ErrUnauthorized отсутствует авторизация.
+	ErrUnauthorized = errors.New("unauthorized")
+
+	// ErrUserNotFound пользователь не найден или не опубликовал ключи.
+	ErrUserNotFound = errors.New("user not found or has no published keys")
+
+	// ErrKeysMismatch закрытые ключи не соответствуют опубликованным открытым.
+	ErrKeysMismatch = errors.New("private keys do not match published keys")
+
+	// ErrKeysExist на сервере уже опубликованы другие ключи.
+	ErrKeysExist = errors.New("other keys are already published")
+)
+
+// Cipher интерфейс шифровщика и дешифровщика.
+type Cipher interface {
+	Encrypt(src []byte) ([]byte, error)
+	Decrypt(dst []byte) ([]byte, error)
+}
+
+// Keys структура ключей пользователя.
+type Keys struct {
+	Encryption *keybox.KeyPair
+	Signing    ed25519.PrivateKey
+}
+
+// SigningPublic метод возвращает открытый ключ подписи.
+func (k *Keys) SigningPublic() ed25519.PublicKey {
+	return k.Signing.Public().(ed25519.PublicKey)
+}
+
+// Fingerprint метод возвращает отпечаток открытых ключей.
+func (k *Keys) Fingerprint() string {
+	return keybox.Fingerprint(k.Encryption.Public[:], k.SigningPublic())
+}
+
+// privateKeys структура закрытых ключей для шифрования и передачи на сервер.
+type privateKeys struct {
+	Encryption []byte `json:"encryption"`
+	Signing    []byte `json:"signing"`
+}
+
+// Keyring структура для работы с ключами пользователя.
+type Keyring struct {
+	client *resty.Client
+	cipher Cipher
+	secret []byte
+	fName  string
+	keys   *Keys
+}
+
+// New конструктор.
+// cipher — шифровщик ключом хранилища профиля, secret — ключ хранилища профиля,
+// из которого выводится прежняя пара ключей (LegacyKeys), fName — путь к локальному файлу ключей.
+func New(client *resty.Client, cipher Cipher, secret []byte, fName string) *Keyring {
+	return &Keyring{
+		client: client,
+		cipher: cipher,
+		secret: secret,
+		fName:  fName,
+	}
+}
+
+// Keys метод возвращает ключи пользователя.
+// Ключи читаются из локального файла, при его отсутствии — загружаются с сервера или генерируются.
+func (k *Keyring) Keys() (*Keys, error) {
+	if k.keys != nil {
+		return k.keys, nil
+	}
+
+	encrypted, err := os.ReadFile(k.fName)
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			if err = k.Setup(); err != nil {
+				return nil, err
+			}
+			return k.keys, nil
+		}
+		return nil, err
+	}
+
+	if k.keys, err = k.open(encrypted); err != nil {
+		return nil, err
+	}
+
+	return k.keys, nil
+}
+
+// LegacyKeys метод возвращает пару ключей, выведенную из ключа хранилища профиля,
+// которой шифровались ключи общих хранилищ до появления случайных ключей пользователя.
+func (k *Keyring) LegacyKeys() (*keybox.KeyPair, error) {
+	return keybox.DeriveLegacyKeyPair(k.secret)
+}
+
+// Setup метод загружает ключи пользователя с сервера,
+// а если они еще не опубликованы — генерирует новые и публикует.
+func (k *Keyring) Setup() error {
+	published := &model.UserKeys{}
+	response, err := k.client.R().SetResult(published).Get("/user/keys")
+	if err != nil {
+		return err
+	}
+
+	switch response.StatusCode() {
+	case http.StatusOK:
+		return k.restore(published)
+	case http.StatusNotFound:
+		return k.generate()
+	case http.StatusUnauthorized:
+		return ErrUnauthorized
+	}
+
+	return fmt.Errorf("internal server error: %w", errors.New(response.Status()))
+}
+
+// PublicKeys метод возвращает опубликованные открытые ключи пользователя по логину.
+func (k *Keyring) PublicKeys(login string) (*model.UserKeys, error) {
+	published := &model.UserKeys{}
+	response, err := k.client.R().SetResult(published).Get("/users/" + url.PathEscape(login) + "/keys")
+	if err != nil {
+		return nil, err
+	}
+
+	switch response.StatusCode() {
+	case http.StatusOK:
+		return published, nil
+	case http.StatusNotFound:
+		return nil, fmt.Errorf("%w: %s", ErrUserNotFound, login)
+	case http.StatusUnauthorized:
+		return nil, ErrUnauthorized
+	}
+
+	return nil, fmt.Errorf("internal server error: %w", errors.New(response.Status()))
+}
+
+// Fingerprint метод возвращает отпечаток открытых ключей пользователя по логину,
+// при пустом логине — отпечаток собственных ключей.
+func (k *Keyring) Fingerprint(login string) (string, error) {
+	if login == "" {
+		keys, err := k.Keys()
+		if err != nil {
+			return "", err
+		}
+		return keys.Fingerprint(), nil
+	}
+
+	published, err := k.PublicKeys(login)
+	if err != nil {
+		return "", err
+	}
+
+	return keybox.Fingerprint(published.EncryptionKey, published.SigningKey), nil
+}
+
+// generate метод генерирует новые ключи и публикует их на сервере.
+func (k *Keyring) generate() error {
+	encryption, err := keybox.GenerateKeyPair()
+	if err != nil {
+		return err
+	}
+
+	signing, err := keybox.GenerateSigningKey()
+	if err != nil {
+		return err
+	}
+
+	keys := &Keys{Encryption: encryption, Signing: signing}
+
+	encrypted, err := k.seal(keys)
+	if err != nil {
+		return err
+	}
+
+	response, err := k.client.R().
+		SetBody(model.UserKeysRequest{
+			EncryptionKey: keys.Encryption.Public[:],
+			SigningKey:    keys.SigningPublic(),
+			PrivateKeys:   encrypted,
+		}).
+		Put("/user/keys")
+	if err != nil {
+		return err
+	}
+
+	switch response.StatusCode() {
+	case http.StatusOK:
+		return k.save(keys, encrypted)
+	case http.StatusConflict:
+		return ErrKeysExist
+	case http.StatusUnauthorized:
+		return ErrUnauthorized
+	}
+
+	return fmt.Errorf("internal server error: %w", errors.New(response.Status()))
+}
+
+// restore метод расшифровывает закрытые ключи, загруженные с сервера,
+// и сверяет их с опубликованными открытыми ключами.
+func (k *Keyring) restore(published *model.UserKeys) error {
+	keys, err := k.open(published.PrivateKeys)
+	if err != nil {
+		return err
+	}
+
+	if keys.Fingerprint() != keybox.Fingerprint(published.EncryptionKey, published.SigningKey) {
+		return ErrKeysMismatch
+	}
+
+	return k.save(keys, published.PrivateKeys)
+}
+
+// save метод сохраняет зашифрованные закрытые ключи в локальный файл.
+func (k *Keyring) save(keys *Keys, encrypted []byte) error {
+	if err := os.MkdirAll(filepath.Dir(k.fName), 0700); err != nil {
+		return err
+	}
+
+	if err := os.WriteFile(k.fName, encrypted, keysFileMode); err != nil {
+		return err
+	}
+
+	k.keys = keys
+	return nil
+}
+
+// seal метод шифрует закрытые ключи ключом хранилища.
+func (k *Keyring) seal(keys *Keys) ([]byte, error) {
+	bKeys, err := json.Marshal(privateKeys{
+		Encryption: keys.Encryption.Private[:],
+		Signing:    keys.Signing.Seed(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return k.cipher.Encrypt(bKeys)
+}
+
+// open метод расшифровывает закрытые ключи и восстанавливает по ним открытые.
+func (k *Keyring) open(encrypted []byte) (*Keys, error) {
+	bKeys, err := k.cipher.Decrypt(encrypted)
+	if err != nil {
+		return nil, err
+	}
+
+	private := privateKeys{}
+	if err = json.Unmarshal(bKeys, &private); err != nil {
+		return nil, err
+	}
+
+	if len(private.Signing) != ed25519.SeedSize {
+		return nil, keybox.ErrInvalidKey
+	}
+
+	encryption, err := keybox.NewKeyPair(private.Encryption)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Keys{
+		Encryption: encryption,
+		Signing:    ed25519.NewKeyFromSeed(private.Signing),
+	}, nil
+}
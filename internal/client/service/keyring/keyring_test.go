@@ -0,0 +1,151 @@
+package keyring
+
+import (
+	"encoding/json"
+	"net/http"
+	"path/filepath"
+	"testing"
+
+	"github.com/casnerano/seckeep/internal/server/model"
+	"github.com/casnerano/seckeep/pkg/cipher"
+	"github.com/go-resty/resty/v2"
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/suite"
+)
+
+var jsonHeader = http.Header{"Content-Type": []string{"application/json"}}
+
+type KeyringTestSuite struct {
+	suite.Suite
+	client *resty.Client
+	cipher *cipher.Cipher
+}
+
+func (s *KeyringTestSuite) SetupSuite() {
+	s.client = resty.New()
+	s.client.SetBaseURL("http://127.0.0.1/api")
+	s.cipher = cipher.New([]byte("example key"))
+}
+
+func (s *KeyringTestSuite) SetupTest() {
+	httpmock.ActivateNonDefault(s.client.GetClient())
+}
+
+func (s *KeyringTestSuite) TearDownTest() {
+	httpmock.DeactivateAndReset()
+}
+
+func (s *KeyringTestSuite) newKeyring() *Keyring {
+	return New(s.client, s.cipher, []byte("example key"), filepath.Join(s.T().TempDir(), "keys"))
+}
+
+func (s *KeyringTestSuite) TestSetup() {
+	var published model.UserKeysRequest
+
+	s.Run("Generate and publish", func() {
+		httpmock.RegisterResponder(
+			http.MethodGet, s.client.BaseURL+"/user/keys",
+			httpmock.NewStringResponder(http.StatusNotFound, ""),
+		)
+		httpmock.RegisterResponder(http.MethodPut, s.client.BaseURL+"/user/keys", func(r *http.Request) (*http.Response, error) {
+			s.NoError(json.NewDecoder(r.Body).Decode(&published))
+			return httpmock.NewStringResponse(http.StatusOK, ""), nil
+		})
+
+		keys, err := s.newKeyring().Keys()
+		s.Require().NoError(err)
+
+		s.Equal(keys.Encryption.Public[:], published.EncryptionKey)
+		s.Equal([]byte(keys.SigningPublic()), published.SigningKey)
+		s.NotEmpty(published.PrivateKeys)
+	})
+
+	s.Run("Restore from server", func() {
+		body, err := json.Marshal(model.UserKeys{
+			EncryptionKey: published.EncryptionKey,
+			SigningKey:    published.SigningKey,
+			PrivateKeys:   published.PrivateKeys,
+		})
+		s.Require().NoError(err)
+
+		httpmock.RegisterResponder(
+			http.MethodGet, s.client.BaseURL+"/user/keys",
+			httpmock.NewBytesResponder(http.StatusOK, body).HeaderSet(jsonHeader),
+		)
+
+		keys, err := s.newKeyring().Keys()
+		s.Require().NoError(err)
+		s.Equal(published.EncryptionKey, keys.Encryption.Public[:])
+	})
+
+	s.Run("Keys do not match published", func() {
+		body, err := json.Marshal(model.UserKeys{
+			EncryptionKey: published.SigningKey,
+			SigningKey:    published.EncryptionKey,
+			PrivateKeys:   published.PrivateKeys,
+		})
+		s.Require().NoError(err)
+
+		httpmock.RegisterResponder(
+			http.MethodGet, s.client.BaseURL+"/user/keys",
+			httpmock.NewBytesResponder(http.StatusOK, body).HeaderSet(jsonHeader),
+		)
+
+		_, err = s.newKeyring().Keys()
+		s.ErrorIs(err, ErrKeysMismatch)
+	})
+
+	s.Run("Unauthorized", func() {
+		httpmock.RegisterResponder(
+			http.MethodGet, s.client.BaseURL+"/user/keys",
+			httpmock.NewStringResponder(http.StatusUnauthorized, ""),
+		)
+
+		s.ErrorIs(s.newKeyring().Setup(), ErrUnauthorized)
+	})
+}
+
+func (s *KeyringTestSuite) TestLocalKeys() {
+	httpmock.RegisterResponder(
+		http.MethodGet, s.client.BaseURL+"/user/keys",
+		httpmock.NewStringResponder(http.StatusNotFound, ""),
+	)
+	httpmock.RegisterResponder(
+		http.MethodPut, s.client.BaseURL+"/user/keys",
+		httpmock.NewStringResponder(http.StatusOK, ""),
+	)
+
+	fName := filepath.Join(s.T().TempDir(), "keys")
+
+	keys, err := New(s.client, s.cipher, []byte("example key"), fName).Keys()
+	s.Require().NoError(err)
+
+	httpmock.Reset()
+
+	local, err := New(s.client, s.cipher, []byte("example key"), fName).Keys()
+	s.Require().NoError(err)
+	s.Equal(keys.Fingerprint(), local.Fingerprint())
+}
+
+func (s *KeyringTestSuite) TestFingerprint() {
+	httpmock.RegisterResponder(
+		http.MethodGet, s.client.BaseURL+"/users/anna/keys",
+		httpmock.NewStringResponder(http.StatusOK, `{"login":"anna","encryption_key":"AQ==","signing_key":"Ag=="}`).
+			HeaderSet(jsonHeader),
+	)
+	httpmock.RegisterResponder(
+		http.MethodGet, s.client.BaseURL+"/users/petr/keys",
+		httpmock.NewStringResponder(http.StatusNotFound, ""),
+	)
+
+	fingerprint, err := s.newKeyring().Fingerprint("anna")
+	s.Require().NoError(err)
+	s.Len(fingerprint, 79)
+
+	_, err = s.newKeyring().Fingerprint("petr")
+	s.ErrorIs(err, ErrUserNotFound)
+}
+
+func TestKeyringTestSuite(t *testing.T) {
+	suite.Run(t, new(KeyringTestSuite))
+}
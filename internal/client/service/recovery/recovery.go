@@ -0,0 +1,195 @@
+// Package recovery содержит методы работы с ключом восстановления.
+// Ключ восстановления генерируется на клиенте и показывается пользователю один раз.
+// Из него выводятся два ключа: проверочное значение, по которому сервер разрешает сменить пароль,
+// и ключ, которым шифруется ключ хранилища профиля. Сам ключ восстановления на сервер не передается.
+package recovery
+
+//go:generate mockgen -destination=mock/recovery.go -source=recovery.go
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base32"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/casnerano/seckeep/internal/client/config"
+	"github.com/casnerano/seckeep/internal/server/model"
+	"github.com/casnerano/seckeep/pkg/cipher"
+	"github.com/go-resty/resty/v2"
+)
+
+const (
+	// keySize длина (в байтах) ключа восстановления.
+	keySize = 32
+
+	// groupSize длина группы символов в текстовом представлении ключа восстановления.
+	groupSize = 4
+)
+
+// Метки выведения ключей из ключа восстановления.
+var (
+	verifierLabel = []byte("seckeep recovery verifier")
+	wrappingLabel = []byte("seckeep recovery wrapping key")
+)
+
+// keyEncoding кодировка текстового представления ключа восстановления.
+var keyEncoding = base32.StdEncoding.WithPadding(base32.NoPadding)
+
+// Основные ошибки при работе с ключом восстановления.
+var (
+	// ErrUnauthorized отсутствует авторизация.
+	ErrUnauthorized = errors.New("unauthorized")
+
+	// ErrInvalidKey ключ восстановления имеет некорректный формат.
+	ErrInvalidKey = errors.New("invalid recovery key format")
+
+	// ErrIncorrectKey ключ восстановления не подходит к аккаунту.
+	ErrIncorrectKey = errors.New("incorrect login or recovery key")
+
+	// ErrTooManyRequests превышен лимит попыток.
+	ErrTooManyRequests = errors.New("too many requests")
+)
+
+// Profiles интерфейс чтения и изменения ключа шифрования профиля.
+type Profiles interface {
+	Profile(name string) (*config.Profile, error)
+	SetSecret(name, secret string) error
+}
+
+// Recovery структура для работы с ключом восстановления.
+type Recovery struct {
+	client      *resty.Client
+	profiles    Profiles
+	profileName string
+}
+
+// New конструктор.
+// Восстанавливается ключ шифрования профиля profileName.
+func New(client *resty.Client, profiles Profiles, profileName string) *Recovery {
+	return &Recovery{
+		client:      client,
+		profiles:    profiles,
+		profileName: profileName,
+	}
+}
+
+// Generate метод генерирует новый ключ восстановления и сохраняет на сервере
+// зашифрованный им ключ шифрования профиля. Ранее выданный ключ восстановления перестает действовать.
+func (r *Recovery) Generate() (string, error) {
+	profile, err := r.profiles.Profile(r.profileName)
+	if err != nil {
+		return "", err
+	}
+
+	key := make([]byte, keySize)
+	if _, err = rand.Read(key); err != nil {
+		return "", err
+	}
+
+	verifier, wrappingKey := deriveKeys(key)
+
+	wrappedKey, err := cipher.New(wrappingKey).Encrypt([]byte(profile.Encryptor.Secret))
+	if err != nil {
+		return "", err
+	}
+
+	response, err := r.client.R().
+		SetBody(model.UserRecoveryKeyRequest{Verifier: verifier, WrappedKey: wrappedKey}).
+		Put("/user/recovery-key")
+	if err != nil {
+		return "", err
+	}
+
+	switch response.StatusCode() {
+	case http.StatusOK:
+		return formatKey(key), nil
+	case http.StatusBadRequest:
+		return "", fmt.Errorf("incorrect values: %w", errors.New(string(response.Body())))
+	case http.StatusUnauthorized:
+		return "", ErrUnauthorized
+	}
+
+	return "", fmt.Errorf("internal server error: %w", errors.New(response.Status()))
+}
+
+// Recover метод восстанавливает доступ к аккаунту login по ключу восстановления:
+// на сервере устанавливается новый пароль, а ключ шифрования профиля заменяется восстановленным.
+func (r *Recovery) Recover(login, recoveryKey, newPassword string) error {
+	key, err := parseKey(recoveryKey)
+	if err != nil {
+		return err
+	}
+
+	verifier, wrappingKey := deriveKeys(key)
+
+	result := &model.UserRecoverResponse{}
+	response, err := r.client.R().
+		SetBody(model.UserRecoverRequest{Login: login, Verifier: verifier, NewPassword: newPassword}).
+		SetResult(result).
+		Post("/user/recover")
+	if err != nil {
+		return err
+	}
+
+	switch response.StatusCode() {
+	case http.StatusOK:
+	case http.StatusBadRequest:
+		return fmt.Errorf("incorrect values: %w", errors.New(string(response.Body())))
+	case http.StatusUnauthorized:
+		return ErrIncorrectKey
+	case http.StatusTooManyRequests:
+		return ErrTooManyRequests
+	default:
+		return fmt.Errorf("internal server error: %w", errors.New(response.Status()))
+	}
+
+	secret, err := cipher.New(wrappingKey).Decrypt(result.WrappedKey)
+	if err != nil {
+		return ErrIncorrectKey
+	}
+
+	return r.profiles.SetSecret(r.profileName, string(secret))
+}
+
+// deriveKeys выводит из ключа восстановления проверочное значение и ключ шифрования ключа профиля.
+func deriveKeys(key []byte) (verifier, wrappingKey []byte) {
+	return derive(key, verifierLabel), derive(key, wrappingLabel)
+}
+
+// derive выводит из ключа восстановления ключ с меткой label.
+func derive(key, label []byte) []byte {
+	mac := hmac.New(sha256.New, key)
+	mac.Write(label)
+	return mac.Sum(nil)
+}
+
+// formatKey возвращает текстовое представление ключа восстановления: группы по 4 символа через дефис.
+func formatKey(key []byte) string {
+	encoded := keyEncoding.EncodeToString(key)
+
+	groups := make([]string, 0, len(encoded)/groupSize+1)
+	for len(encoded) > groupSize {
+		groups = append(groups, encoded[:groupSize])
+		encoded = encoded[groupSize:]
+	}
+	groups = append(groups, encoded)
+
+	return strings.Join(groups, "-")
+}
+
+// parseKey разбирает текстовое представление ключа восстановления.
+// Регистр, дефисы и пробелы не учитываются.
+func parseKey(s string) ([]byte, error) {
+	s = strings.ToUpper(strings.NewReplacer("-", "", " ", "").Replace(s))
+
+	key, err := keyEncoding.DecodeString(s)
+	if err != nil || len(key) != keySize {
+		return nil, ErrInvalidKey
+	}
+
+	return key, nil
+}
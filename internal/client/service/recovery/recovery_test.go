@@ -0,0 +1,101 @@
+package recovery
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/casnerano/seckeep/internal/client/config"
+	mock_recovery "github.com/casnerano/seckeep/internal/client/service/recovery/mock"
+	"github.com/casnerano/seckeep/internal/server/model"
+	"github.com/go-resty/resty/v2"
+	"github.com/golang/mock/gomock"
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/suite"
+)
+
+var jsonHeader = http.Header{"Content-Type": []string{"application/json"}}
+
+type RecoveryServiceTestSuite struct {
+	suite.Suite
+	client   *resty.Client
+	profiles *mock_recovery.MockProfiles
+	service  *Recovery
+}
+
+func (s *RecoveryServiceTestSuite) SetupSuite() {
+	ctrl := gomock.NewController(s.T())
+	defer ctrl.Finish()
+
+	s.client = resty.New()
+	s.client.SetBaseURL("http://127.0.0.1/api")
+
+	s.profiles = mock_recovery.NewMockProfiles(ctrl)
+	s.service = New(s.client, s.profiles, "work")
+}
+
+func (s *RecoveryServiceTestSuite) SetupTest() {
+	httpmock.ActivateNonDefault(s.client.GetClient())
+}
+
+func (s *RecoveryServiceTestSuite) TearDownTest() {
+	httpmock.DeactivateAndReset()
+}
+
+func (s *RecoveryServiceTestSuite) TestGenerateAndRecover() {
+	profile, err := config.NewProfile("https://vault.example.com", "profile secret")
+	s.Require().NoError(err)
+	s.profiles.EXPECT().Profile("work").Return(profile, nil)
+
+	stored := model.UserRecoveryKeyRequest{}
+	httpmock.RegisterResponder(http.MethodPut, "http://127.0.0.1/api/user/recovery-key",
+		func(request *http.Request) (*http.Response, error) {
+			s.Require().NoError(json.NewDecoder(request.Body).Decode(&stored))
+			return httpmock.NewStringResponse(http.StatusOK, ""), nil
+		},
+	)
+
+	recoveryKey, err := s.service.Generate()
+	s.Require().NoError(err)
+	s.Len(strings.Split(recoveryKey, "-"), 13)
+	s.Len(stored.Verifier, 32)
+
+	httpmock.RegisterResponder(http.MethodPost, "http://127.0.0.1/api/user/recover",
+		func(request *http.Request) (*http.Response, error) {
+			rd := model.UserRecoverRequest{}
+			s.Require().NoError(json.NewDecoder(request.Body).Decode(&rd))
+
+			if string(rd.Verifier) != string(stored.Verifier) {
+				return httpmock.NewStringResponse(http.StatusUnauthorized, ""), nil
+			}
+			return httpmock.NewJsonResponse(http.StatusOK, model.UserRecoverResponse{WrappedKey: stored.WrappedKey})
+		},
+	)
+
+	s.Run("Correct recovery key", func() {
+		s.profiles.EXPECT().SetSecret("work", "profile secret").Return(nil)
+		s.NoError(s.service.Recover("ivan", strings.ToLower(recoveryKey), "n3w-Pa$$word"))
+	})
+
+	s.Run("Another recovery key", func() {
+		other := strings.Repeat("A", 52)
+		s.ErrorIs(s.service.Recover("ivan", other, "n3w-Pa$$word"), ErrIncorrectKey)
+	})
+
+	s.Run("Invalid recovery key", func() {
+		s.ErrorIs(s.service.Recover("ivan", "ABCD-EFGH", "n3w-Pa$$word"), ErrInvalidKey)
+	})
+}
+
+func (s *RecoveryServiceTestSuite) TestRecoverTooManyRequests() {
+	httpmock.RegisterResponder(http.MethodPost, "http://127.0.0.1/api/user/recover",
+		httpmock.NewStringResponder(http.StatusTooManyRequests, "").HeaderSet(jsonHeader))
+
+	key := make([]byte, keySize)
+	s.ErrorIs(s.service.Recover("ivan", formatKey(key), "n3w-Pa$$word"), ErrTooManyRequests)
+}
+
+func TestRecoveryServiceTestSuite(t *testing.T) {
+	suite.Run(t, new(RecoveryServiceTestSuite))
+}
@@ -0,0 +1,134 @@
+package search
+
+import (
+	"encoding/json"
+	"errors"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/casnerano/seckeep/internal/client/model"
+	smodel "github.com/casnerano/seckeep/internal/pkg/model"
+)
+
+// indexFileMode права доступа к файлу поискового индекса.
+const indexFileMode fs.FileMode = 0600
+
+// Index интерфейс поискового индекса.
+type Index interface {
+	Load() (map[int]Entry, error)
+	Save(entries map[int]Entry) error
+}
+
+// Entry структура записи поискового индекса.
+// Версия и дата создания позволяют определить, что запись хранилища изменилась.
+type Entry struct {
+	Type      smodel.DataType `json:"type"`
+	Version   time.Time       `json:"version"`
+	CreatedAt time.Time       `json:"created_at"`
+	Title     string          `json:"title,omitempty"`
+	Fields    []string        `json:"fields,omitempty"`
+}
+
+// NewEntry конструктор записи индекса для расшифрованной записи хранилища.
+func NewEntry(storeData *model.StoreData, dt model.DataTypeable) Entry {
+	return Entry{
+		Type:      storeData.Type,
+		Version:   storeData.Version,
+		CreatedAt: storeData.CreatedAt,
+		Title:     dt.Info().Title,
+		Fields:    fields(dt),
+	}
+}
+
+// Actual проверяет, что запись индекса соответствует записи хранилища.
+func (e Entry) Actual(storeData *model.StoreData) bool {
+	return e.Type == storeData.Type &&
+		e.Version.Equal(storeData.Version) &&
+		e.CreatedAt.Equal(storeData.CreatedAt)
+}
+
+// rank возвращает релевантность записи терминам запроса, 0 — запись не найдена.
+// Каждый термин должен совпасть хотя бы с одним полем, учитывается лучшее совпадение.
+func (e Entry) rank(terms []string) int {
+	total := 0
+	for _, term := range terms {
+		best := match(term, e.Title) * titleWeight
+		for _, field := range e.Fields {
+			if score := match(term, field); score > best {
+				best = score
+			}
+		}
+
+		if best == 0 {
+			return 0
+		}
+		total += best
+	}
+	return total
+}
+
+// Cipher интерфейс шифровщика и дешифровщика.
+type Cipher interface {
+	Encrypt(src []byte) ([]byte, error)
+	Decrypt(dst []byte) ([]byte, error)
+}
+
+// FileIndex структура поискового индекса в локальном зашифрованном файле.
+type FileIndex struct {
+	cipher Cipher
+	fName  string
+}
+
+// NewFileIndex конструктор.
+// cipher — шифровщик ключом хранилища профиля, fName — путь к файлу индекса.
+func NewFileIndex(cipher Cipher, fName string) *FileIndex {
+	return &FileIndex{
+		cipher: cipher,
+		fName:  fName,
+	}
+}
+
+// Load метод читает индекс из файла. Если файла нет, возвращается пустой индекс.
+func (i *FileIndex) Load() (map[int]Entry, error) {
+	entries := make(map[int]Entry)
+
+	encrypted, err := os.ReadFile(i.fName)
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return entries, nil
+		}
+		return nil, err
+	}
+
+	bEntries, err := i.cipher.Decrypt(encrypted)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = json.Unmarshal(bEntries, &entries); err != nil {
+		return nil, err
+	}
+
+	return entries, nil
+}
+
+// Save метод шифрует и сохраняет индекс в файл.
+func (i *FileIndex) Save(entries map[int]Entry) error {
+	bEntries, err := json.Marshal(entries)
+	if err != nil {
+		return err
+	}
+
+	encrypted, err := i.cipher.Encrypt(bEntries)
+	if err != nil {
+		return err
+	}
+
+	if err = os.MkdirAll(filepath.Dir(i.fName), 0700); err != nil {
+		return err
+	}
+
+	return os.WriteFile(i.fName, encrypted, indexFileMode)
+}
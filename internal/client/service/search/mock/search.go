@@ -0,0 +1,87 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: search.go
+
+// Package mock_search is a generated GoMock package.
+package mock_search
+
+import (
+	reflect "reflect"
+
+	model "github.com/casnerano/seckeep/internal/client/model"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockStorage is a mock of Storage interface.
+type MockStorage struct {
+	ctrl     *gomock.Controller
+	recorder *MockStorageMockRecorder
+}
+
+// MockStorageMockRecorder is the mock recorder for MockStorage.
+type MockStorageMockRecorder struct {
+	mock *MockStorage
+}
+
+// NewMockStorage creates a new mock instance.
+func NewMockStorage(ctrl *gomock.Controller) *MockStorage {
+	mock := &MockStorage{ctrl: ctrl}
+	mock.recorder = &MockStorageMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockStorage) EXPECT() *MockStorageMockRecorder {
+	return m.recorder
+}
+
+// GetList mocks base method.
+func (m *MockStorage) GetList() []*model.StoreData {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetList")
+	ret0, _ := ret[0].([]*model.StoreData)
+	return ret0
+}
+
+// GetList indicates an expected call of GetList.
+func (mr *MockStorageMockRecorder) GetList() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetList", reflect.TypeOf((*MockStorage)(nil).GetList))
+}
+
+// MockData is a mock of Data interface.
+type MockData struct {
+	ctrl     *gomock.Controller
+	recorder *MockDataMockRecorder
+}
+
+// MockDataMockRecorder is the mock recorder for MockData.
+type MockDataMockRecorder struct {
+	mock *MockData
+}
+
+// NewMockData creates a new mock instance.
+func NewMockData(ctrl *gomock.Controller) *MockData {
+	mock := &MockData{ctrl: ctrl}
+	mock.recorder = &MockDataMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockData) EXPECT() *MockDataMockRecorder {
+	return m.recorder
+}
+
+// Read mocks base method.
+func (m *MockData) Read(index int) (model.DataTypeable, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Read", index)
+	ret0, _ := ret[0].(model.DataTypeable)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Read indicates an expected call of Read.
+func (mr *MockDataMockRecorder) Read(index interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Read", reflect.TypeOf((*MockData)(nil).Read), index)
+}
@@ -0,0 +1,274 @@
+// Package search содержит методы полнотекстового поиска по записям локального хранилища.
+// Записи расшифровываются только в памяти, совпадения ранжируются по близости к запросу.
+// Для больших хранилищ можно использовать локальный поисковый индекс: он хранится зашифрованным
+// ключом хранилища профиля и позволяет расшифровывать только измененные и найденные записи.
+package search
+
+//go:generate mockgen -destination=mock/search.go -source=search.go
+
+import (
+	"sort"
+	"strings"
+	"unicode"
+
+	"github.com/casnerano/seckeep/internal/client/model"
+)
+
+// Веса совпадения термина запроса со значением поля.
+const (
+	scoreExact     = 100
+	scorePrefix    = 80
+	scoreWord      = 60
+	scoreSubstring = 40
+	scoreFuzzy     = 20
+)
+
+// titleWeight множитель релевантности совпадения с заголовком записи.
+const titleWeight = 2
+
+// Storage интерфейс чтения списка записей локального хранилища.
+type Storage interface {
+	GetList() []*model.StoreData
+}
+
+// Data интерфейс чтения расшифрованной записи.
+type Data interface {
+	Read(index int) (model.DataTypeable, error)
+}
+
+// Result структура найденной записи.
+type Result struct {
+	Index int
+	Data  model.DataTypeable
+	Score int
+}
+
+// Search структура поиска по записям.
+type Search struct {
+	storage Storage
+	data    Data
+	index   Index
+}
+
+// New конструктор.
+func New(storage Storage, data Data, index Index) *Search {
+	return &Search{
+		storage: storage,
+		data:    data,
+		index:   index,
+	}
+}
+
+// Find метод ищет записи по запросу query, расшифровывая все записи хранилища.
+// Результаты отсортированы по убыванию релевантности.
+func (s *Search) Find(query string) []Result {
+	terms := splitTerms(query)
+	if len(terms) == 0 {
+		return nil
+	}
+
+	results := make([]Result, 0)
+	for index, storeData := range s.storage.GetList() {
+		if storeData.Deleted {
+			continue
+		}
+
+		dt, err := s.data.Read(index)
+		if err != nil {
+			continue
+		}
+
+		if score := NewEntry(storeData, dt).rank(terms); score > 0 {
+			results = append(results, Result{Index: index, Data: dt, Score: score})
+		}
+	}
+
+	sortResults(results)
+	return results
+}
+
+// FindIndexed метод ищет записи по запросу query с помощью поискового индекса.
+// Расшифровываются только записи, измененные с момента построения индекса, и найденные записи.
+// Индекс обновляется и сохраняется, если записи хранилища изменились.
+func (s *Search) FindIndexed(query string) ([]Result, error) {
+	terms := splitTerms(query)
+	if len(terms) == 0 {
+		return nil, nil
+	}
+
+	entries, err := s.index.Load()
+	if err != nil {
+		return nil, err
+	}
+
+	actual := make(map[int]Entry, len(entries))
+	changed := false
+
+	results := make([]Result, 0)
+	for index, storeData := range s.storage.GetList() {
+		if storeData.Deleted {
+			continue
+		}
+
+		var dt model.DataTypeable
+
+		entry, ok := entries[index]
+		if !ok || !entry.Actual(storeData) {
+			if dt, err = s.data.Read(index); err != nil {
+				continue
+			}
+			entry = NewEntry(storeData, dt)
+			changed = true
+		}
+		actual[index] = entry
+
+		score := entry.rank(terms)
+		if score == 0 {
+			continue
+		}
+
+		if dt == nil {
+			if dt, err = s.data.Read(index); err != nil {
+				continue
+			}
+		}
+
+		results = append(results, Result{Index: index, Data: dt, Score: score})
+	}
+
+	if changed || len(actual) != len(entries) {
+		if err = s.index.Save(actual); err != nil {
+			return nil, err
+		}
+	}
+
+	sortResults(results)
+	return results, nil
+}
+
+// splitTerms разбивает запрос на термины в нижнем регистре.
+func splitTerms(query string) []string {
+	return strings.Fields(strings.ToLower(query))
+}
+
+// sortResults сортирует результаты по убыванию релевантности, при равенстве — по индексу записи.
+func sortResults(results []Result) {
+	sort.SliceStable(results, func(i, j int) bool {
+		if results[i].Score != results[j].Score {
+			return results[i].Score > results[j].Score
+		}
+		return results[i].Index < results[j].Index
+	})
+}
+
+// fields возвращает значения полей записи, по которым выполняется поиск (кроме заголовка):
+// теги, текстовые пользовательские поля, адреса сайтов учетных записей
+// и основные поля, отмеченные в описании типа опцией search.
+func fields(dt model.DataTypeable) []string {
+	result := append([]string{}, dt.Info().Tags...)
+	for _, field := range dt.Info().Fields {
+		result = append(result, field.Name)
+		if field.Type == model.FieldTypeText {
+			result = append(result, field.Value)
+		}
+	}
+
+	if credential, ok := dt.(*model.DataCredential); ok {
+		for _, uri := range credential.URIs {
+			result = append(result, uri.URI)
+		}
+	}
+
+	if spec, ok := model.LookupType(dt.Type()); ok {
+		for _, field := range spec.Fields {
+			if field.Search {
+				result = append(result, field.Get(dt))
+			}
+		}
+		result = append(result, spec.Meta(dt)...)
+	}
+
+	return result
+}
+
+// match возвращает релевантность совпадения термина term со значением value, 0 — нет совпадения.
+func match(term, value string) int {
+	value = strings.ToLower(value)
+
+	switch {
+	case value == "":
+		return 0
+	case value == term:
+		return scoreExact
+	case strings.HasPrefix(value, term):
+		return scorePrefix
+	}
+
+	if position := strings.Index(value, term); position >= 0 {
+		for position >= 0 {
+			if isWordStart(value, position) {
+				return scoreWord
+			}
+
+			next := strings.Index(value[position+1:], term)
+			if next < 0 {
+				break
+			}
+			position += next + 1
+		}
+		return scoreSubstring
+	}
+
+	return fuzzy(term, value)
+}
+
+// isWordStart проверяет, что позиция position в value является началом слова.
+func isWordStart(value string, position int) bool {
+	if position == 0 {
+		return true
+	}
+
+	previous := []rune(value[:position])
+	return !unicode.IsLetter(previous[len(previous)-1]) && !unicode.IsDigit(previous[len(previous)-1])
+}
+
+// fuzzy возвращает релевантность нечеткого совпадения: символы термина term встречаются в value
+// в том же порядке. Чем больше лишних символов между ними, тем ниже релевантность.
+func fuzzy(term, value string) int {
+	termRunes := []rune(term)
+	if len(termRunes) < 2 {
+		return 0
+	}
+
+	best := 0
+	valueRunes := []rune(value)
+
+	for start := range valueRunes {
+		if valueRunes[start] != termRunes[0] {
+			continue
+		}
+
+		matched := 1
+		end := start
+		for i := start + 1; i < len(valueRunes) && matched < len(termRunes); i++ {
+			if valueRunes[i] == termRunes[matched] {
+				matched++
+				end = i
+			}
+		}
+
+		if matched < len(termRunes) {
+			break
+		}
+
+		gaps := end - start + 1 - len(termRunes)
+		if score := scoreFuzzy - gaps; score > best {
+			best = score
+		}
+	}
+
+	if best < 1 {
+		return 0
+	}
+	return best
+}
@@ -0,0 +1,159 @@
+package search
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/casnerano/seckeep/internal/client/model"
+	mock_search "github.com/casnerano/seckeep/internal/client/service/search/mock"
+	smodel "github.com/casnerano/seckeep/internal/pkg/model"
+	"github.com/casnerano/seckeep/pkg/cipher"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/suite"
+)
+
+type SearchTestSuite struct {
+	suite.Suite
+	ctrl       *gomock.Controller
+	storage    *mock_search.MockStorage
+	data       *mock_search.MockData
+	storeData  []*model.StoreData
+	decrypted  map[int]model.DataTypeable
+	version    time.Time
+	indexFName string
+}
+
+func (s *SearchTestSuite) SetupTest() {
+	s.ctrl = gomock.NewController(s.T())
+	s.storage = mock_search.NewMockStorage(s.ctrl)
+	s.data = mock_search.NewMockData(s.ctrl)
+	s.indexFName = filepath.Join(s.T().TempDir(), "search.idx")
+	s.version = time.Date(2023, 5, 20, 12, 0, 0, 0, time.UTC)
+
+	s.decrypted = map[int]model.DataTypeable{
+		0: &model.DataCredential{DataInfo: model.DataInfo{Title: "GitHub"}, Login: "ivan", Password: "secret"},
+		1: &model.DataCredential{DataInfo: model.DataInfo{Title: "Work mail"}, Login: "ivan@github.com", Password: "secret"},
+		2: &model.DataText{Value: "Remember to renew the domain", Meta: []string{"todo"}},
+		3: &model.DataCard{Number: "4012888888881881", Owner: "Ivan Ivanov", CVV: "github"},
+		4: &model.DataDocument{Name: "passport.pdf"},
+	}
+
+	s.storeData = make([]*model.StoreData, 0, len(s.decrypted))
+	for index := 0; index < len(s.decrypted); index++ {
+		s.storeData = append(s.storeData, &model.StoreData{
+			Type:      s.decrypted[index].Type(),
+			Version:   s.version,
+			CreatedAt: s.version,
+		})
+	}
+	s.storeData = append(s.storeData, &model.StoreData{Type: smodel.DataTypeText, Deleted: true})
+
+	s.storage.EXPECT().GetList().Return(s.storeData).AnyTimes()
+}
+
+func (s *SearchTestSuite) TearDownTest() {
+	s.ctrl.Finish()
+}
+
+func (s *SearchTestSuite) expectRead(indexes ...int) {
+	for _, index := range indexes {
+		s.data.EXPECT().Read(index).Return(s.decrypted[index], nil)
+	}
+}
+
+func (s *SearchTestSuite) indexes(results []Result) []int {
+	indexes := make([]int, 0, len(results))
+	for _, result := range results {
+		indexes = append(indexes, result.Index)
+	}
+	return indexes
+}
+
+func (s *SearchTestSuite) TestFind() {
+	s.Run("Title ranks first", func() {
+		s.expectRead(0, 1, 2, 3, 4)
+		s.Equal([]int{0, 1}, s.indexes(New(s.storage, s.data, nil).Find("github")))
+	})
+
+	s.Run("All terms must match", func() {
+		s.expectRead(0, 1, 2, 3, 4)
+		s.Equal([]int{3}, s.indexes(New(s.storage, s.data, nil).Find("ivan ivanov")))
+	})
+
+	s.Run("Fuzzy match", func() {
+		s.expectRead(0, 1, 2, 3, 4)
+		s.Equal([]int{4}, s.indexes(New(s.storage, s.data, nil).Find("pssprt")))
+	})
+
+	s.Run("Empty query", func() {
+		s.Empty(New(s.storage, s.data, nil).Find("  "))
+	})
+}
+
+func (s *SearchTestSuite) TestFindIndexed() {
+	index := NewFileIndex(cipher.New([]byte("example key")), s.indexFName)
+	search := New(s.storage, s.data, index)
+
+	s.Run("Builds index", func() {
+		s.expectRead(0, 1, 2, 3, 4)
+
+		results, err := search.FindIndexed("renew")
+		s.Require().NoError(err)
+		s.Equal([]int{2}, s.indexes(results))
+
+		entries, err := index.Load()
+		s.Require().NoError(err)
+		s.Len(entries, 5)
+	})
+
+	s.Run("Decrypts only found records", func() {
+		s.expectRead(4)
+
+		results, err := search.FindIndexed("passport")
+		s.Require().NoError(err)
+		s.Equal([]int{4}, s.indexes(results))
+		s.Equal(s.decrypted[4], results[0].Data)
+	})
+
+	s.Run("Reindexes changed records", func() {
+		s.storeData[2].Version = s.version.Add(time.Hour)
+		s.decrypted[2] = &model.DataText{Value: "Pay the rent"}
+		s.expectRead(2)
+
+		results, err := search.FindIndexed("rent")
+		s.Require().NoError(err)
+		s.Equal([]int{2}, s.indexes(results))
+	})
+
+	s.Run("Index is encrypted", func() {
+		_, err := NewFileIndex(cipher.New([]byte("other key")), s.indexFName).Load()
+		s.Error(err)
+	})
+}
+
+func (s *SearchTestSuite) TestMatch() {
+	tests := []struct {
+		name  string
+		term  string
+		value string
+		want  int
+	}{
+		{"Exact", "mail", "Mail", scoreExact},
+		{"Prefix", "mail", "mailbox", scorePrefix},
+		{"Word", "mail", "work mail", scoreWord},
+		{"Substring", "mail", "gmail", scoreSubstring},
+		{"Fuzzy", "gml", "gmail", scoreFuzzy - 2},
+		{"No match", "mail", "github", 0},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			s.Equal(tt.want, match(tt.term, tt.value))
+		})
+	}
+}
+
+func TestSearchTestSuite(t *testing.T) {
+	suite.Run(t, new(SearchTestSuite))
+}
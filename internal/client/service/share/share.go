@@ -0,0 +1,180 @@
+// Package share содержит методы создания и открытия ссылок на секреты.
+// Запись шифруется на клиенте случайным ключом, на сервер загружается только шифротекст,
+// а ключ передается во фрагменте ссылки, который браузеры и HTTP-клиенты не отправляют на сервер.
+package share
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/casnerano/seckeep/internal/client/model"
+	smodel "github.com/casnerano/seckeep/internal/pkg/model"
+	vmodel "github.com/casnerano/seckeep/internal/server/model"
+	"github.com/casnerano/seckeep/pkg/cipher"
+	"github.com/casnerano/seckeep/pkg/keybox"
+	"github.com/go-resty/resty/v2"
+)
+
+// linkPath путь ссылки на секрет относительно адреса сервера.
+const linkPath = "/share/"
+
+// Основные ошибки при работе со ссылками на секреты.
+var (
+	// ErrUnauthorized отсутствует авторизация.
+	ErrUnauthorized = errors.New("unauthorized")
+
+	// ErrNotFound ссылка не найдена, истекла или исчерпала число просмотров.
+	ErrNotFound = errors.New("share not found, expired or already viewed")
+
+	// ErrInvalidLink некорректная ссылка.
+	ErrInvalidLink = errors.New("invalid share link")
+)
+
+// payload структура зашифрованного содержимого ссылки.
+type payload struct {
+	Type  smodel.DataType `json:"type"`
+	Value json.RawMessage `json:"value"`
+}
+
+// Share структура для работы со ссылками на секреты.
+type Share struct {
+	client     *resty.Client
+	openClient *resty.Client
+	serverURL  string
+}
+
+// New конструктор.
+// client — авторизованный клиент API сервера, serverURL — адрес сервера для формирования ссылок.
+func New(client *resty.Client, serverURL string) *Share {
+	return &Share{
+		client:     client,
+		openClient: resty.New(),
+		serverURL:  strings.TrimRight(serverURL, "/"),
+	}
+}
+
+// Create метод шифрует запись случайным ключом, загружает шифротекст на сервер и возвращает ссылку.
+func (s *Share) Create(dt model.DataTypeable, ttl time.Duration, views int) (string, error) {
+	bValue, err := json.Marshal(dt)
+	if err != nil {
+		return "", err
+	}
+
+	bPayload, err := json.Marshal(payload{Type: dt.Type(), Value: bValue})
+	if err != nil {
+		return "", err
+	}
+
+	key, err := keybox.NewKey()
+	if err != nil {
+		return "", err
+	}
+
+	encrypted, err := cipher.New(key).Encrypt(bPayload)
+	if err != nil {
+		return "", err
+	}
+
+	share := &vmodel.Share{}
+	response, err := s.client.R().
+		SetBody(vmodel.ShareCreateRequest{
+			Value: encrypted,
+			TTL:   int64(ttl / time.Second),
+			Views: views,
+		}).
+		SetResult(share).
+		Post("/shares")
+	if err != nil {
+		return "", err
+	}
+
+	switch response.StatusCode() {
+	case http.StatusOK:
+		return s.serverURL + linkPath + share.UUID + "#" + base64.RawURLEncoding.EncodeToString(key), nil
+	case http.StatusBadRequest:
+		return "", fmt.Errorf("incorrect values: %w", errors.New(string(response.Body())))
+	case http.StatusUnauthorized:
+		return "", ErrUnauthorized
+	}
+
+	return "", fmt.Errorf("internal server error: %w", errors.New(response.Status()))
+}
+
+// Open метод загружает шифротекст по ссылке и расшифровывает запись ключом из ссылки.
+// Каждое открытие засчитывается сервером как просмотр.
+// Запрос выполняется без авторизации: ссылка может вести на сервер другого профиля.
+func (s *Share) Open(link string) (model.DataTypeable, error) {
+	apiURL, key, err := parseLink(link)
+	if err != nil {
+		return nil, err
+	}
+
+	share := &vmodel.Share{}
+	response, err := s.openClient.R().
+		SetResult(share).
+		Post(apiURL)
+	if err != nil {
+		return nil, err
+	}
+
+	switch response.StatusCode() {
+	case http.StatusOK:
+	case http.StatusNotFound:
+		return nil, ErrNotFound
+	default:
+		return nil, fmt.Errorf("internal server error: %w", errors.New(response.Status()))
+	}
+
+	bPayload, err := cipher.New(key).Decrypt(share.Value)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidLink, err.Error())
+	}
+
+	p := payload{}
+	if err = json.Unmarshal(bPayload, &p); err != nil {
+		return nil, err
+	}
+
+	dt, err := model.NewData(p.Type)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = json.Unmarshal(p.Value, dt); err != nil {
+		return nil, err
+	}
+
+	return dt, nil
+}
+
+// parseLink разбирает ссылку на адрес API открытия секрета и ключ.
+func parseLink(link string) (string, []byte, error) {
+	u, err := url.Parse(link)
+	if err != nil {
+		return "", nil, fmt.Errorf("%w: %s", ErrInvalidLink, err.Error())
+	}
+
+	index := strings.LastIndex(u.Path, linkPath)
+	if u.Scheme == "" || u.Host == "" || index < 0 || u.Fragment == "" {
+		return "", nil, ErrInvalidLink
+	}
+
+	uuid := u.Path[index+len(linkPath):]
+	if uuid == "" || strings.Contains(uuid, "/") {
+		return "", nil, ErrInvalidLink
+	}
+
+	key, err := base64.RawURLEncoding.DecodeString(u.Fragment)
+	if err != nil || len(key) != keybox.KeySize {
+		return "", nil, ErrInvalidLink
+	}
+
+	apiURL := u.Scheme + "://" + u.Host + u.Path[:index] + "/api/shares/" + url.PathEscape(uuid) + "/open"
+	return apiURL, key, nil
+}
@@ -0,0 +1,106 @@
+package share
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/casnerano/seckeep/internal/client/model"
+	vmodel "github.com/casnerano/seckeep/internal/server/model"
+	"github.com/go-resty/resty/v2"
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/suite"
+)
+
+type ShareServiceTestSuite struct {
+	suite.Suite
+	client       *resty.Client
+	shareService *Share
+}
+
+func (s *ShareServiceTestSuite) SetupSuite() {
+	s.client = resty.New()
+	s.client.SetBaseURL("http://127.0.0.1/api")
+	s.shareService = New(s.client, "http://127.0.0.1/")
+	s.shareService.openClient = s.client
+}
+
+func (s *ShareServiceTestSuite) SetupTest() {
+	httpmock.ActivateNonDefault(s.client.GetClient())
+}
+
+func (s *ShareServiceTestSuite) TearDownTest() {
+	httpmock.DeactivateAndReset()
+}
+
+func (s *ShareServiceTestSuite) TestCreateOpen() {
+	dt := model.DataCredential{Login: "ivan", Password: "example"}
+
+	var uploaded vmodel.ShareCreateRequest
+	httpmock.RegisterResponder(http.MethodPost, s.client.BaseURL+"/shares", func(r *http.Request) (*http.Response, error) {
+		s.NoError(json.NewDecoder(r.Body).Decode(&uploaded))
+		return httpmock.NewJsonResponse(http.StatusOK, vmodel.Share{UUID: "0b8e5c1a"})
+	})
+
+	link, err := s.shareService.Create(dt, time.Hour, 1)
+	s.Require().NoError(err)
+
+	s.True(strings.HasPrefix(link, "http://127.0.0.1/share/0b8e5c1a#"))
+	s.Equal(int64(3600), uploaded.TTL)
+	s.Equal(1, uploaded.Views)
+	s.NotContains(string(uploaded.Value), "example")
+
+	s.Run("Open link", func() {
+		httpmock.RegisterResponder(
+			http.MethodPost, "http://127.0.0.1/api/shares/0b8e5c1a/open",
+			httpmock.NewJsonResponderOrPanic(http.StatusOK, vmodel.Share{UUID: "0b8e5c1a", Value: uploaded.Value}),
+		)
+
+		opened, err := s.shareService.Open(link)
+		s.Require().NoError(err)
+		s.Equal(&dt, opened)
+	})
+
+	s.Run("Already viewed", func() {
+		httpmock.RegisterResponder(
+			http.MethodPost, "http://127.0.0.1/api/shares/0b8e5c1a/open",
+			httpmock.NewStringResponder(http.StatusNotFound, ""),
+		)
+
+		_, err := s.shareService.Open(link)
+		s.ErrorIs(err, ErrNotFound)
+	})
+
+	s.Run("Wrong key", func() {
+		httpmock.RegisterResponder(
+			http.MethodPost, "http://127.0.0.1/api/shares/0b8e5c1a/open",
+			httpmock.NewJsonResponderOrPanic(http.StatusOK, vmodel.Share{UUID: "0b8e5c1a", Value: uploaded.Value}),
+		)
+
+		_, err := s.shareService.Open("http://127.0.0.1/share/0b8e5c1a#AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA")
+		s.ErrorIs(err, ErrInvalidLink)
+	})
+}
+
+func (s *ShareServiceTestSuite) TestParseLink() {
+	apiURL, key, err := parseLink("https://vault.example.com/seckeep/share/0b8e5c1a#AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA")
+	s.Require().NoError(err)
+	s.Equal("https://vault.example.com/seckeep/api/shares/0b8e5c1a/open", apiURL)
+	s.Len(key, 32)
+
+	for _, link := range []string{
+		"0b8e5c1a",
+		"https://vault.example.com/share/0b8e5c1a",
+		"https://vault.example.com/data/0b8e5c1a#AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA",
+		"https://vault.example.com/share/0b8e5c1a#short",
+	} {
+		_, _, err = parseLink(link)
+		s.ErrorIs(err, ErrInvalidLink, link)
+	}
+}
+
+func TestShareServiceTestSuite(t *testing.T) {
+	suite.Run(t, new(ShareServiceTestSuite))
+}
@@ -12,11 +12,6 @@ import (
 	"github.com/casnerano/seckeep/internal/client/model"
 )
 
-const (
-	// DefaultFileName дефолтный путь к файлу храненого хранилища.
-	DefaultFileName = "./cmd/client/var/store/data.registry"
-)
-
 // Основные ошибки при работе с локальным хранилищем.
 var (
 	// ErrOutOfRangeStore вышел за пределы индекса данных.
@@ -32,7 +27,7 @@ type Storage struct {
 
 // New конструктор.
 func New(fName string) (*Storage, error) {
-	file, err := os.OpenFile(fName, os.O_CREATE|os.O_RDWR, 0664)
+	file, err := os.OpenFile(fName, os.O_CREATE|os.O_RDWR, 0600)
 	if err != nil {
 		return nil, err
 	}
@@ -112,6 +107,16 @@ func (s *Storage) Delete(index int) error {
 	return nil
 }
 
+// Undelete метод снимает с записи по индексу пометку на удаление.
+func (s *Storage) Undelete(index int) error {
+	s.memStore[index].Deleted = false
+	if err := s.ClearFlush(); err != nil {
+		s.memStore[index].Deleted = true
+		return err
+	}
+	return nil
+}
+
 // ClearFlush метод очищает хранилище, и заново записывает все данные из памяти.
 func (s *Storage) ClearFlush() error {
 	if _, err := s.fileStore.Seek(0, io.SeekStart); err != nil {
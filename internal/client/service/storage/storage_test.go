@@ -108,6 +108,14 @@ func (s *StorageTestSuite) TestDelete() {
 	s.NoError(err)
 }
 
+func (s *StorageTestSuite) TestUndelete() {
+	s.Require().NoError(s.storageService.Delete(0))
+	s.Require().True(s.storageService.memStore[0].Deleted)
+
+	s.NoError(s.storageService.Undelete(0))
+	s.False(s.storageService.memStore[0].Deleted)
+}
+
 func TestStorageTestSuite(t *testing.T) {
 	suite.Run(t, new(StorageTestSuite))
 }
@@ -10,6 +10,7 @@ import (
 	"time"
 
 	"github.com/casnerano/seckeep/internal/client/model"
+	vmodel "github.com/casnerano/seckeep/internal/server/model"
 	"github.com/casnerano/seckeep/pkg/log"
 	"github.com/go-resty/resty/v2"
 )
@@ -105,10 +106,18 @@ func (s *Syncer) Run() error {
 	localOtherItemsMap := make(map[string]*storeData)
 
 	// Записи без UUID — созданы локлаьно.
-	// Записи с признаком "Deleted" — нужно удалить на сервере.
+	// Записи с признаком "Deleted" — нужно удалить на сервере (переместить в корзину).
+	// Записи, созданные и удаленные локально, загружаются на сервер и сразу удаляются,
+	// чтобы их можно было восстановить из корзины.
 	for index, sd := range s.storage.GetList() {
 		if sd.UUID == "" {
 			localCreatedItems = append(localCreatedItems, sd)
+			if sd.Deleted {
+				localDeletedItems = append(localDeletedItems, &storeData{
+					data:  sd,
+					index: index,
+				})
+			}
 		} else if sd.Deleted {
 			localDeletedItems = append(localDeletedItems, &storeData{
 				data:  sd,
@@ -186,16 +195,20 @@ func (s *Syncer) RunWithStatus() {
 	fmt.Println()
 }
 
-// loadToServer загружает записи на сервер.
+// loadToServer загружает записи на сервер, записям присваиваются UUID, выданные сервером.
 func (s *Syncer) loadToServer(localItems []*model.StoreData) error {
 	for key := range localItems {
+		created := &model.StoreData{}
 		_, err := s.client.R().
 			SetBody(localItems[key]).
-			Post("/data")
+			SetResult(created).
+			Post(dataPath(localItems[key]))
 
 		if err != nil {
 			return err
 		}
+
+		localItems[key].UUID = created.UUID
 	}
 
 	return nil
@@ -209,7 +222,7 @@ func (s *Syncer) updateToServer(localItems []*model.StoreData) error {
 				Value   []byte    `json:"value"`
 				Version time.Time `json:"version"`
 			}{localItems[key].Value, localItems[key].Version}).
-			Put("/data/" + localItems[key].UUID)
+			Put(dataPath(localItems[key]) + "/" + localItems[key].UUID)
 
 		if err != nil {
 			return err
@@ -219,7 +232,7 @@ func (s *Syncer) updateToServer(localItems []*model.StoreData) error {
 	return nil
 }
 
-// loadFromServer выгружает записи из сервер.
+// exportFromServer выгружает личные записи и записи общих хранилищ из сервера.
 func (s *Syncer) exportFromServer() ([]*model.StoreData, error) {
 	items := make([]*model.StoreData, 0)
 	_, err := s.client.R().
@@ -230,14 +243,52 @@ func (s *Syncer) exportFromServer() ([]*model.StoreData, error) {
 		return nil, err
 	}
 
+	vaults := make([]*vmodel.Vault, 0)
+	_, err = s.client.R().
+		SetResult(&vaults).
+		Get("/vaults")
+
+	if err != nil {
+		return nil, err
+	}
+
+	for _, vault := range vaults {
+		vaultItems := make([]*model.StoreData, 0)
+		_, err = s.client.R().
+			SetResult(&vaultItems).
+			Get("/vaults/" + vault.UUID + "/data")
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, item := range vaultItems {
+			item.VaultUUID = vault.UUID
+		}
+		items = append(items, vaultItems...)
+	}
+
 	return items, nil
 }
 
-// removeFromServer удаляет записи из сервера.
+// dataPath возвращает путь ресурса записей: личных или общего хранилища.
+func dataPath(sd *model.StoreData) string {
+	if sd.VaultUUID != "" {
+		return "/vaults/" + sd.VaultUUID + "/data"
+	}
+	return "/data"
+}
+
+// removeFromServer перемещает записи на сервере в корзину.
+// Записи без UUID (не загруженные на сервер) пропускаются.
 func (s *Syncer) removeFromServer(items []*storeData) error {
 	for key := range items {
+		if items[key].data.UUID == "" {
+			continue
+		}
+
 		_, err := s.client.R().
-			Delete("/data/" + items[key].data.UUID)
+			Delete(dataPath(items[key].data) + "/" + items[key].data.UUID)
 
 		if err != nil {
 			return err
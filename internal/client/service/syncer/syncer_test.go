@@ -25,6 +25,8 @@ type DataTestSuite struct {
 	syncerService *Syncer
 }
 
+var jsonHeader = http.Header{"Content-Type": []string{"application/json"}}
+
 func (s *DataTestSuite) SetupSuite() {
 	ctrl := gomock.NewController(s.T())
 	defer ctrl.Finish()
@@ -47,6 +49,10 @@ func (s *DataTestSuite) TearDownTest() {
 
 func (s *DataTestSuite) SetupSubTest() {
 	httpmock.Reset()
+	httpmock.RegisterResponder(
+		http.MethodGet, s.client.BaseURL+"/vaults",
+		httpmock.NewStringResponder(http.StatusOK, "[]"),
+	)
 }
 
 func (s *DataTestSuite) TestPingServerHealth() {
@@ -141,6 +147,34 @@ func (s *DataTestSuite) TestRun() {
 	})
 }
 
+func (s *DataTestSuite) TestRunDeletedBeforeSync() {
+	s.Run("Local item is uploaded and moved to trash", func() {
+		s.storage.EXPECT().Len().Return(1)
+
+		responder, err := httpmock.NewJsonResponder(http.StatusOK, []struct{}{})
+		s.Require().NoError(err)
+
+		httpmock.RegisterResponder(http.MethodGet, s.client.BaseURL+"/data", responder)
+
+		s.storage.EXPECT().GetList().Return([]*model.StoreData{{Deleted: true}})
+
+		httpmock.RegisterResponder(
+			http.MethodPost, s.client.BaseURL+"/data",
+			httpmock.NewJsonResponderOrPanic(http.StatusOK, model.StoreData{UUID: "created"}),
+		)
+
+		httpmock.RegisterResponder(
+			http.MethodDelete, s.client.BaseURL+"/data/created",
+			httpmock.NewStringResponder(http.StatusOK, ""),
+		)
+
+		s.storage.EXPECT().OverwriteStore([]*model.StoreData{}).Return(nil)
+
+		s.NoError(s.syncerService.Run())
+		s.Equal(1, httpmock.GetCallCountInfo()["DELETE "+s.client.BaseURL+"/data/created"])
+	})
+}
+
 func (s *DataTestSuite) TestLoadToServer() {
 	s.Run("Empty items", func() {
 		httpmock.RegisterResponder(
@@ -256,6 +290,31 @@ func (s *DataTestSuite) TestExportFromServer() {
 		s.NoError(err)
 	})
 
+	s.Run("Vault data items", func() {
+		httpmock.RegisterResponder(
+			http.MethodGet, s.client.BaseURL+"/data",
+			httpmock.NewStringResponder(http.StatusOK, `[{"uuid":"u1"}]`).
+				HeaderSet(jsonHeader),
+		)
+		httpmock.RegisterResponder(
+			http.MethodGet, s.client.BaseURL+"/vaults",
+			httpmock.NewStringResponder(http.StatusOK, `[{"uuid":"v1","name":"family"}]`).
+				HeaderSet(jsonHeader),
+		)
+		httpmock.RegisterResponder(
+			http.MethodGet, s.client.BaseURL+"/vaults/v1/data",
+			httpmock.NewStringResponder(http.StatusOK, `[{"uuid":"u2"}]`).
+				HeaderSet(jsonHeader),
+		)
+
+		result, err := s.syncerService.exportFromServer()
+
+		s.Require().NoError(err)
+		s.Require().Len(result, 2)
+		s.Empty(result[0].VaultUUID)
+		s.Equal("v1", result[1].VaultUUID)
+	})
+
 	s.Run("Error response", func() {
 		httpmock.RegisterResponder(
 			http.MethodGet, s.client.BaseURL+"/data",
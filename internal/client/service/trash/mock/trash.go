@@ -0,0 +1,140 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: trash.go
+
+// Package mock_trash is a generated GoMock package.
+package mock_trash
+
+import (
+	reflect "reflect"
+
+	model "github.com/casnerano/seckeep/internal/client/model"
+	model0 "github.com/casnerano/seckeep/internal/server/model"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockStorage is a mock of Storage interface.
+type MockStorage struct {
+	ctrl     *gomock.Controller
+	recorder *MockStorageMockRecorder
+}
+
+// MockStorageMockRecorder is the mock recorder for MockStorage.
+type MockStorageMockRecorder struct {
+	mock *MockStorage
+}
+
+// NewMockStorage creates a new mock instance.
+func NewMockStorage(ctrl *gomock.Controller) *MockStorage {
+	mock := &MockStorage{ctrl: ctrl}
+	mock.recorder = &MockStorageMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockStorage) EXPECT() *MockStorageMockRecorder {
+	return m.recorder
+}
+
+// GetList mocks base method.
+func (m *MockStorage) GetList() []*model.StoreData {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetList")
+	ret0, _ := ret[0].([]*model.StoreData)
+	return ret0
+}
+
+// GetList indicates an expected call of GetList.
+func (mr *MockStorageMockRecorder) GetList() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetList", reflect.TypeOf((*MockStorage)(nil).GetList))
+}
+
+// Undelete mocks base method.
+func (m *MockStorage) Undelete(index int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Undelete", index)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Undelete indicates an expected call of Undelete.
+func (mr *MockStorageMockRecorder) Undelete(index interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Undelete", reflect.TypeOf((*MockStorage)(nil).Undelete), index)
+}
+
+// MockDecryptor is a mock of Decryptor interface.
+type MockDecryptor struct {
+	ctrl     *gomock.Controller
+	recorder *MockDecryptorMockRecorder
+}
+
+// MockDecryptorMockRecorder is the mock recorder for MockDecryptor.
+type MockDecryptorMockRecorder struct {
+	mock *MockDecryptor
+}
+
+// NewMockDecryptor creates a new mock instance.
+func NewMockDecryptor(ctrl *gomock.Controller) *MockDecryptor {
+	mock := &MockDecryptor{ctrl: ctrl}
+	mock.recorder = &MockDecryptorMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockDecryptor) EXPECT() *MockDecryptorMockRecorder {
+	return m.recorder
+}
+
+// Decrypt mocks base method.
+func (m *MockDecryptor) Decrypt(storeData *model.StoreData) (model.DataTypeable, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Decrypt", storeData)
+	ret0, _ := ret[0].(model.DataTypeable)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Decrypt indicates an expected call of Decrypt.
+func (mr *MockDecryptorMockRecorder) Decrypt(storeData interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Decrypt", reflect.TypeOf((*MockDecryptor)(nil).Decrypt), storeData)
+}
+
+// MockVaults is a mock of Vaults interface.
+type MockVaults struct {
+	ctrl     *gomock.Controller
+	recorder *MockVaultsMockRecorder
+}
+
+// MockVaultsMockRecorder is the mock recorder for MockVaults.
+type MockVaultsMockRecorder struct {
+	mock *MockVaults
+}
+
+// NewMockVaults creates a new mock instance.
+func NewMockVaults(ctrl *gomock.Controller) *MockVaults {
+	mock := &MockVaults{ctrl: ctrl}
+	mock.recorder = &MockVaultsMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockVaults) EXPECT() *MockVaultsMockRecorder {
+	return m.recorder
+}
+
+// List mocks base method.
+func (m *MockVaults) List() ([]*model0.Vault, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List")
+	ret0, _ := ret[0].([]*model0.Vault)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockVaultsMockRecorder) List() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockVaults)(nil).List))
+}
@@ -0,0 +1,232 @@
+// Package trash содержит методы работы с корзиной удаленных записей.
+// Удаленная запись помечается в локальном хранилище и при синхронизации перемещается
+// в корзину на сервере, где хранится до истечения срока хранения или очистки корзины.
+package trash
+
+//go:generate mockgen -destination=mock/trash.go -source=trash.go
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"sort"
+	"time"
+
+	"github.com/casnerano/seckeep/internal/client/model"
+	smodel "github.com/casnerano/seckeep/internal/pkg/model"
+	vmodel "github.com/casnerano/seckeep/internal/server/model"
+	"github.com/go-resty/resty/v2"
+)
+
+// Основные ошибки при работе с корзиной.
+var (
+	// ErrUnauthorized отсутствует авторизация.
+	ErrUnauthorized = errors.New("unauthorized")
+
+	// ErrNotFound запись не найдена в корзине.
+	ErrNotFound = errors.New("data not found in trash")
+)
+
+// Storage интерфейс работы с записями локального хранилища.
+type Storage interface {
+	GetList() []*model.StoreData
+	Undelete(index int) error
+}
+
+// Decryptor интерфейс расшифровки записей.
+type Decryptor interface {
+	Decrypt(storeData *model.StoreData) (model.DataTypeable, error)
+}
+
+// Vaults интерфейс получения общих хранилищ пользователя.
+type Vaults interface {
+	List() ([]*vmodel.Vault, error)
+}
+
+// Item структура записи в корзине.
+// Pending — запись удалена локально и еще не перемещена в корзину на сервере.
+type Item struct {
+	UUID      string
+	VaultUUID string
+	DeletedAt time.Time
+	Pending   bool
+	Data      model.DataTypeable
+	index     int
+}
+
+// Trash структура работы с корзиной.
+type Trash struct {
+	client    *resty.Client
+	storage   Storage
+	decryptor Decryptor
+	vaults    Vaults
+}
+
+// New конструктор.
+func New(client *resty.Client, storage Storage, decryptor Decryptor, vaults Vaults) *Trash {
+	return &Trash{
+		client:    client,
+		storage:   storage,
+		decryptor: decryptor,
+		vaults:    vaults,
+	}
+}
+
+// List метод возвращает записи корзины: сначала удаленные локально,
+// затем (если remote) записи корзины на сервере, начиная с последней удаленной.
+func (t *Trash) List(remote bool) ([]Item, error) {
+	items := make([]Item, 0)
+
+	for index, sd := range t.storage.GetList() {
+		if !sd.Deleted {
+			continue
+		}
+
+		dt, err := t.decryptor.Decrypt(sd)
+		if err != nil {
+			continue
+		}
+
+		items = append(items, Item{
+			UUID:      sd.UUID,
+			VaultUUID: sd.VaultUUID,
+			Pending:   true,
+			Data:      dt,
+			index:     index,
+		})
+	}
+
+	if !remote {
+		return items, nil
+	}
+
+	remoteItems, err := t.remoteList()
+	if err != nil {
+		return nil, err
+	}
+
+	return append(items, remoteItems...), nil
+}
+
+// Restore метод восстанавливает запись из корзины.
+// Запись, удаленная локально, восстанавливается сразу, запись корзины на сервере —
+// появится в локальном хранилище после синхронизации.
+func (t *Trash) Restore(item Item) error {
+	if item.Pending {
+		return t.storage.Undelete(item.index)
+	}
+
+	response, err := t.client.R().Post(dataPath(item.VaultUUID) + "/" + item.UUID + "/restore")
+	if err != nil {
+		return err
+	}
+
+	return statusError(response)
+}
+
+// Empty метод окончательно удаляет записи корзины на сервере:
+// личные и записи общих хранилищ, доступных для изменения.
+func (t *Trash) Empty() error {
+	response, err := t.client.R().Delete(dataPath("") + "/trash")
+	if err != nil {
+		return err
+	}
+
+	if err = statusError(response); err != nil {
+		return err
+	}
+
+	vaults, err := t.vaults.List()
+	if err != nil {
+		return err
+	}
+
+	for _, vault := range vaults {
+		if !vault.Role.CanWrite() {
+			continue
+		}
+
+		response, err = t.client.R().Delete(dataPath(vault.UUID) + "/trash")
+		if err != nil {
+			return err
+		}
+
+		if err = statusError(response); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// remoteList метод возвращает расшифрованные записи корзины на сервере, начиная с последней удаленной.
+func (t *Trash) remoteList() ([]Item, error) {
+	vaults, err := t.vaults.List()
+	if err != nil {
+		return nil, err
+	}
+
+	vaultUUIDs := []string{""}
+	for _, vault := range vaults {
+		vaultUUIDs = append(vaultUUIDs, vault.UUID)
+	}
+
+	items := make([]Item, 0)
+	for _, vaultUUID := range vaultUUIDs {
+		data := make([]*smodel.Data, 0)
+		response, err := t.client.R().SetResult(&data).Get(dataPath(vaultUUID) + "/trash")
+		if err != nil {
+			return nil, err
+		}
+
+		if err = statusError(response); err != nil {
+			return nil, err
+		}
+
+		for _, datum := range data {
+			dt, err := t.decryptor.Decrypt(&model.StoreData{
+				UUID:      datum.UUID,
+				VaultUUID: vaultUUID,
+				Type:      datum.Type,
+				Value:     datum.Value,
+			})
+			if err != nil {
+				continue
+			}
+
+			item := Item{UUID: datum.UUID, VaultUUID: vaultUUID, Data: dt}
+			if datum.DeletedAt != nil {
+				item.DeletedAt = *datum.DeletedAt
+			}
+			items = append(items, item)
+		}
+	}
+
+	sort.SliceStable(items, func(i, j int) bool {
+		return items[i].DeletedAt.After(items[j].DeletedAt)
+	})
+
+	return items, nil
+}
+
+// dataPath возвращает путь ресурса записей: личных или общего хранилища.
+func dataPath(vaultUUID string) string {
+	if vaultUUID != "" {
+		return "/vaults/" + vaultUUID + "/data"
+	}
+	return "/data"
+}
+
+// statusError возвращает ошибку по коду ответа сервера.
+func statusError(response *resty.Response) error {
+	switch response.StatusCode() {
+	case http.StatusOK:
+		return nil
+	case http.StatusUnauthorized:
+		return ErrUnauthorized
+	case http.StatusNotFound:
+		return ErrNotFound
+	}
+
+	return fmt.Errorf("internal server error: %w", errors.New(response.Status()))
+}
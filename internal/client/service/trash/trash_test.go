@@ -0,0 +1,136 @@
+package trash
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/casnerano/seckeep/internal/client/model"
+	mock_trash "github.com/casnerano/seckeep/internal/client/service/trash/mock"
+	smodel "github.com/casnerano/seckeep/internal/pkg/model"
+	vmodel "github.com/casnerano/seckeep/internal/server/model"
+	"github.com/go-resty/resty/v2"
+	"github.com/golang/mock/gomock"
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/suite"
+)
+
+type TrashServiceTestSuite struct {
+	suite.Suite
+	client    *resty.Client
+	storage   *mock_trash.MockStorage
+	decryptor *mock_trash.MockDecryptor
+	vaults    *mock_trash.MockVaults
+	service   *Trash
+}
+
+func (s *TrashServiceTestSuite) SetupSuite() {
+	ctrl := gomock.NewController(s.T())
+	defer ctrl.Finish()
+
+	s.client = resty.New()
+	s.client.SetBaseURL("http://127.0.0.1/api")
+
+	s.storage = mock_trash.NewMockStorage(ctrl)
+	s.decryptor = mock_trash.NewMockDecryptor(ctrl)
+	s.vaults = mock_trash.NewMockVaults(ctrl)
+	s.service = New(s.client, s.storage, s.decryptor, s.vaults)
+
+	s.decryptor.EXPECT().Decrypt(gomock.Any()).DoAndReturn(func(sd *model.StoreData) (model.DataTypeable, error) {
+		return &model.DataText{Value: string(sd.Value)}, nil
+	}).AnyTimes()
+}
+
+func (s *TrashServiceTestSuite) SetupTest() {
+	httpmock.ActivateNonDefault(s.client.GetClient())
+}
+
+func (s *TrashServiceTestSuite) TearDownTest() {
+	httpmock.DeactivateAndReset()
+}
+
+func (s *TrashServiceTestSuite) TestList() {
+	older := time.Date(2026, 10, 1, 12, 0, 0, 0, time.UTC)
+	newer := older.Add(time.Hour)
+
+	s.storage.EXPECT().GetList().Return([]*model.StoreData{
+		{UUID: "kept", Type: smodel.DataTypeText, Value: []byte("kept")},
+		{UUID: "pending", Type: smodel.DataTypeText, Value: []byte("pending"), Deleted: true},
+	}).Times(2)
+
+	s.Run("Local only", func() {
+		items, err := s.service.List(false)
+		s.Require().NoError(err)
+		s.Equal([]Item{
+			{UUID: "pending", Pending: true, Data: &model.DataText{Value: "pending"}, index: 1},
+		}, items)
+	})
+
+	s.Run("Local and remote", func() {
+		s.vaults.EXPECT().List().Return([]*vmodel.Vault{{UUID: "vault-uuid", Role: vmodel.VaultRoleViewer}}, nil)
+
+		httpmock.RegisterResponder(http.MethodGet, "http://127.0.0.1/api/data/trash",
+			httpmock.NewJsonResponderOrPanic(http.StatusOK, []smodel.Data{
+				{UUID: "personal", Type: smodel.DataTypeText, Value: []byte("personal"), DeletedAt: &older},
+			}),
+		)
+		httpmock.RegisterResponder(http.MethodGet, "http://127.0.0.1/api/vaults/vault-uuid/data/trash",
+			httpmock.NewJsonResponderOrPanic(http.StatusOK, []smodel.Data{
+				{UUID: "shared", Type: smodel.DataTypeText, Value: []byte("shared"), DeletedAt: &newer},
+			}),
+		)
+
+		items, err := s.service.List(true)
+		s.Require().NoError(err)
+		s.Require().Len(items, 3)
+		s.Equal("pending", items[0].UUID)
+		s.Equal(Item{UUID: "shared", VaultUUID: "vault-uuid", DeletedAt: newer, Data: &model.DataText{Value: "shared"}}, items[1])
+		s.Equal(Item{UUID: "personal", DeletedAt: older, Data: &model.DataText{Value: "personal"}}, items[2])
+	})
+}
+
+func (s *TrashServiceTestSuite) TestRestore() {
+	s.Run("Pending item", func() {
+		s.storage.EXPECT().Undelete(3).Return(nil)
+		s.NoError(s.service.Restore(Item{UUID: "pending", Pending: true, index: 3}))
+	})
+
+	s.Run("Remote item", func() {
+		httpmock.RegisterResponder(http.MethodPost, "http://127.0.0.1/api/vaults/vault-uuid/data/shared/restore",
+			httpmock.NewStringResponder(http.StatusOK, ""),
+		)
+		s.NoError(s.service.Restore(Item{UUID: "shared", VaultUUID: "vault-uuid"}))
+	})
+
+	s.Run("Remote item is not in trash", func() {
+		httpmock.RegisterResponder(http.MethodPost, "http://127.0.0.1/api/data/personal/restore",
+			httpmock.NewStringResponder(http.StatusNotFound, ""),
+		)
+		s.ErrorIs(s.service.Restore(Item{UUID: "personal"}), ErrNotFound)
+	})
+}
+
+func (s *TrashServiceTestSuite) TestEmpty() {
+	s.vaults.EXPECT().List().Return([]*vmodel.Vault{
+		{UUID: "editable", Role: vmodel.VaultRoleEditor},
+		{UUID: "readonly", Role: vmodel.VaultRoleViewer},
+	}, nil)
+
+	httpmock.RegisterResponder(http.MethodDelete, "http://127.0.0.1/api/data/trash",
+		httpmock.NewStringResponder(http.StatusOK, ""),
+	)
+	httpmock.RegisterResponder(http.MethodDelete, "http://127.0.0.1/api/vaults/editable/data/trash",
+		httpmock.NewStringResponder(http.StatusOK, ""),
+	)
+
+	s.NoError(s.service.Empty())
+
+	info := httpmock.GetCallCountInfo()
+	s.Equal(1, info["DELETE http://127.0.0.1/api/data/trash"])
+	s.Equal(1, info["DELETE http://127.0.0.1/api/vaults/editable/data/trash"])
+	s.Equal(2, httpmock.GetTotalCallCount())
+}
+
+func TestTrashServiceTestSuite(t *testing.T) {
+	suite.Run(t, new(TrashServiceTestSuite))
+}
@@ -0,0 +1,49 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: urimatch.go
+
+// Package mock_urimatch is a generated GoMock package.
+package mock_urimatch
+
+import (
+	reflect "reflect"
+
+	model "github.com/casnerano/seckeep/internal/client/model"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockData is a mock of Data interface.
+type MockData struct {
+	ctrl     *gomock.Controller
+	recorder *MockDataMockRecorder
+}
+
+// MockDataMockRecorder is the mock recorder for MockData.
+type MockDataMockRecorder struct {
+	mock *MockData
+}
+
+// NewMockData creates a new mock instance.
+func NewMockData(ctrl *gomock.Controller) *MockData {
+	mock := &MockData{ctrl: ctrl}
+	mock.recorder = &MockDataMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockData) EXPECT() *MockDataMockRecorder {
+	return m.recorder
+}
+
+// GetList mocks base method.
+func (m *MockData) GetList() map[int]model.DataTypeable {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetList")
+	ret0, _ := ret[0].(map[int]model.DataTypeable)
+	return ret0
+}
+
+// GetList indicates an expected call of GetList.
+func (mr *MockDataMockRecorder) GetList() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetList", reflect.TypeOf((*MockData)(nil).GetList))
+}
@@ -0,0 +1,174 @@
+// Package urimatch содержит методы поиска учетных записей по адресу сайта.
+// Адреса учетных записей сопоставляются с адресом сайта согласно их режиму (model.URIMatch),
+// найденные учетные записи ранжируются по качеству совпадения.
+package urimatch
+
+//go:generate mockgen -destination=mock/urimatch.go -source=urimatch.go
+
+import (
+	"errors"
+	"net"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/casnerano/seckeep/internal/client/model"
+	"golang.org/x/net/publicsuffix"
+)
+
+// Качество совпадения адресов, чем больше — тем точнее.
+const (
+	// QualityNone адреса не совпадают.
+	QualityNone = iota
+
+	// QualityDomain совпадает базовый домен.
+	QualityDomain
+
+	// QualityHost совпадает хост и порт.
+	QualityHost
+
+	// QualityRegex адрес соответствует регулярному выражению.
+	QualityRegex
+
+	// QualityExact совпадает адрес целиком.
+	QualityExact
+)
+
+// ErrInvalidURL некорректный адрес сайта.
+var ErrInvalidURL = errors.New("invalid url")
+
+// Data интерфейс чтения списка записей.
+type Data interface {
+	GetList() map[int]model.DataTypeable
+}
+
+// Result структура найденной учетной записи.
+type Result struct {
+	Index      int
+	Credential *model.DataCredential
+	Quality    int
+}
+
+// Matcher структура поиска учетных записей по адресу сайта.
+type Matcher struct {
+	data Data
+}
+
+// New конструктор.
+func New(data Data) *Matcher {
+	return &Matcher{data: data}
+}
+
+// Find метод возвращает учетные записи, адреса которых совпадают с адресом сайта target.
+// Результаты отсортированы по убыванию качества совпадения.
+func (m *Matcher) Find(target string) ([]Result, error) {
+	targetURL, err := parse(target)
+	if err != nil {
+		return nil, err
+	}
+
+	results := make([]Result, 0)
+	for index, dt := range m.data.GetList() {
+		credential, ok := dt.(*model.DataCredential)
+		if !ok {
+			continue
+		}
+
+		best := QualityNone
+		for _, uri := range credential.URIs {
+			if quality := Match(uri, targetURL); quality > best {
+				best = quality
+			}
+		}
+
+		if best > QualityNone {
+			results = append(results, Result{Index: index, Credential: credential, Quality: best})
+		}
+	}
+
+	sort.Slice(results, func(i, j int) bool {
+		if results[i].Quality != results[j].Quality {
+			return results[i].Quality > results[j].Quality
+		}
+		return results[i].Index < results[j].Index
+	})
+
+	return results, nil
+}
+
+// Match возвращает качество совпадения адреса учетной записи uri с адресом сайта target.
+// Режим адреса задает допустимую точность совпадения: например, для URIMatchDomain
+// совпадение хоста или адреса целиком дает более высокое качество, чем совпадение только домена.
+func Match(uri model.DataURI, target *url.URL) int {
+	mode := uri.MatchMode()
+
+	if mode == model.URIMatchRegex {
+		re, err := regexp.Compile(uri.URI)
+		if err != nil || !re.MatchString(target.String()) {
+			return QualityNone
+		}
+		return QualityRegex
+	}
+
+	uriURL, err := parse(uri.URI)
+	if err != nil {
+		return QualityNone
+	}
+
+	switch {
+	case uriURL.String() == target.String():
+		return QualityExact
+	case mode == model.URIMatchExact:
+		return QualityNone
+	case uriURL.Host == target.Host:
+		return QualityHost
+	case mode == model.URIMatchHost:
+		return QualityNone
+	case baseDomain(uriURL.Hostname()) == baseDomain(target.Hostname()):
+		return QualityDomain
+	}
+
+	return QualityNone
+}
+
+// parse разбирает и нормализует адрес: схема по умолчанию https, хост в нижнем регистре,
+// без портов по умолчанию, фрагмента и завершающего слеша.
+func parse(s string) (*url.URL, error) {
+	s = strings.TrimSpace(s)
+	if !strings.Contains(s, "://") {
+		s = "https://" + s
+	}
+
+	u, err := url.Parse(s)
+	if err != nil || u.Hostname() == "" {
+		return nil, ErrInvalidURL
+	}
+
+	u.Scheme = strings.ToLower(u.Scheme)
+	u.Host = strings.ToLower(u.Host)
+	if port := u.Port(); (u.Scheme == "https" && port == "443") || (u.Scheme == "http" && port == "80") {
+		u.Host = u.Hostname()
+	}
+	u.Fragment = ""
+	u.RawFragment = ""
+	u.Path = strings.TrimSuffix(u.Path, "/")
+	u.RawPath = strings.TrimSuffix(u.RawPath, "/")
+	u.User = nil
+
+	return u, nil
+}
+
+// baseDomain возвращает базовый домен хоста (example.co.uk для login.example.co.uk).
+// Для IP-адресов и хостов без публичного суффикса возвращается сам хост.
+func baseDomain(host string) string {
+	if net.ParseIP(host) != nil {
+		return host
+	}
+
+	domain, err := publicsuffix.EffectiveTLDPlusOne(host)
+	if err != nil {
+		return host
+	}
+	return domain
+}
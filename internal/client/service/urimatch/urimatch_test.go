@@ -0,0 +1,79 @@
+package urimatch
+
+import (
+	"net/url"
+	"testing"
+
+	"github.com/casnerano/seckeep/internal/client/model"
+	mock_urimatch "github.com/casnerano/seckeep/internal/client/service/urimatch/mock"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/suite"
+)
+
+type URIMatchTestSuite struct {
+	suite.Suite
+	data *mock_urimatch.MockData
+}
+
+func (s *URIMatchTestSuite) SetupSuite() {
+	ctrl := gomock.NewController(s.T())
+	defer ctrl.Finish()
+
+	s.data = mock_urimatch.NewMockData(ctrl)
+}
+
+func (s *URIMatchTestSuite) TestMatch() {
+	target, err := parse("https://Login.Example.co.uk:443/signin/#top")
+	s.Require().NoError(err)
+
+	tests := []struct {
+		name string
+		uri  model.DataURI
+		want int
+	}{
+		{"Exact", model.DataURI{URI: "https://login.example.co.uk/signin", Match: model.URIMatchExact}, QualityExact},
+		{"Exact mismatch", model.DataURI{URI: "https://login.example.co.uk/", Match: model.URIMatchExact}, QualityNone},
+		{"Host", model.DataURI{URI: "login.example.co.uk", Match: model.URIMatchHost}, QualityHost},
+		{"Host mismatch", model.DataURI{URI: "www.example.co.uk", Match: model.URIMatchHost}, QualityNone},
+		{"Domain", model.DataURI{URI: "https://www.example.co.uk"}, QualityDomain},
+		{"Domain with exact address", model.DataURI{URI: "login.example.co.uk/signin"}, QualityExact},
+		{"Other domain", model.DataURI{URI: "https://other.co.uk"}, QualityNone},
+		{"Regex", model.DataURI{URI: `^https://[a-z]+\.example\.co\.uk/`, Match: model.URIMatchRegex}, QualityRegex},
+		{"Invalid regex", model.DataURI{URI: `(`, Match: model.URIMatchRegex}, QualityNone},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			s.Equal(tt.want, Match(tt.uri, target))
+		})
+	}
+
+	s.Run("IP address", func() {
+		ipTarget := &url.URL{Scheme: "http", Host: "10.0.0.2:8080"}
+		s.Equal(QualityNone, Match(model.DataURI{URI: "http://10.0.0.1:8080"}, ipTarget))
+	})
+}
+
+func (s *URIMatchTestSuite) TestFind() {
+	s.data.EXPECT().GetList().Return(map[int]model.DataTypeable{
+		0: &model.DataCredential{Login: "domain", URIs: []model.DataURI{{URI: "example.com"}}},
+		1: &model.DataCredential{Login: "host", URIs: []model.DataURI{{URI: "https://login.example.com", Match: model.URIMatchHost}}},
+		2: &model.DataCredential{Login: "none"},
+		3: &model.DataText{Value: "https://login.example.com"},
+	})
+
+	results, err := New(s.data).Find("https://login.example.com/auth")
+	s.Require().NoError(err)
+	s.Require().Len(results, 2)
+	s.Equal(1, results[0].Index)
+	s.Equal(QualityHost, results[0].Quality)
+	s.Equal(0, results[1].Index)
+	s.Equal(QualityDomain, results[1].Quality)
+
+	_, err = New(s.data).Find("https://")
+	s.ErrorIs(err, ErrInvalidURL)
+}
+
+func TestURIMatchTestSuite(t *testing.T) {
+	suite.Run(t, new(URIMatchTestSuite))
+}
@@ -0,0 +1,82 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: vault.go
+
+// Package mock_vault is a generated GoMock package.
+package mock_vault
+
+import (
+	reflect "reflect"
+
+	keyring "github.com/casnerano/seckeep/internal/client/service/keyring"
+	model "github.com/casnerano/seckeep/internal/server/model"
+	keybox "github.com/casnerano/seckeep/pkg/keybox"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockKeyring is a mock of Keyring interface.
+type MockKeyring struct {
+	ctrl     *gomock.Controller
+	recorder *MockKeyringMockRecorder
+}
+
+// MockKeyringMockRecorder is the mock recorder for MockKeyring.
+type MockKeyringMockRecorder struct {
+	mock *MockKeyring
+}
+
+// NewMockKeyring creates a new mock instance.
+func NewMockKeyring(ctrl *gomock.Controller) *MockKeyring {
+	mock := &MockKeyring{ctrl: ctrl}
+	mock.recorder = &MockKeyringMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockKeyring) EXPECT() *MockKeyringMockRecorder {
+	return m.recorder
+}
+
+// Keys mocks base method.
+func (m *MockKeyring) Keys() (*keyring.Keys, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Keys")
+	ret0, _ := ret[0].(*keyring.Keys)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Keys indicates an expected call of Keys.
+func (mr *MockKeyringMockRecorder) Keys() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Keys", reflect.TypeOf((*MockKeyring)(nil).Keys))
+}
+
+// LegacyKeys mocks base method.
+func (m *MockKeyring) LegacyKeys() (*keybox.KeyPair, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LegacyKeys")
+	ret0, _ := ret[0].(*keybox.KeyPair)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LegacyKeys indicates an expected call of LegacyKeys.
+func (mr *MockKeyringMockRecorder) LegacyKeys() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LegacyKeys", reflect.TypeOf((*MockKeyring)(nil).LegacyKeys))
+}
+
+// PublicKeys mocks base method.
+func (m *MockKeyring) PublicKeys(login string) (*model.UserKeys, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PublicKeys", login)
+	ret0, _ := ret[0].(*model.UserKeys)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PublicKeys indicates an expected call of PublicKeys.
+func (mr *MockKeyringMockRecorder) PublicKeys(login interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PublicKeys", reflect.TypeOf((*MockKeyring)(nil).PublicKeys), login)
+}
@@ -0,0 +1,351 @@
+// Package vault содержит методы работы с общими хранилищами на сервере.
+// Ключ хранилища генерируется на клиенте и передается участникам зашифрованным их открытыми ключами,
+// сервер хранит только зашифрованные ключи.
+// Список хранилищ кешируется локально, чтобы записи общих хранилищ были доступны без связи с сервером.
+package vault
+
+//go:generate mockgen -destination=mock/vault.go -source=vault.go
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+
+	"github.com/casnerano/seckeep/internal/client/service/keyring"
+	"github.com/casnerano/seckeep/internal/server/model"
+	"github.com/casnerano/seckeep/pkg/cipher"
+	"github.com/casnerano/seckeep/pkg/keybox"
+	"github.com/go-resty/resty/v2"
+)
+
+// cacheFileMode права доступа к файлу кеша хранилищ.
+const cacheFileMode = 0600
+
+// Основные ошибки при работе с общими хранилищами.
+var (
+	// ErrNotFound хранилище не найдено.
+	ErrNotFound = errors.New("vault not found")
+
+	// ErrUnauthorized отсутствует авторизация.
+	ErrUnauthorized = errors.New("unauthorized")
+
+	// ErrForbidden недостаточно прав.
+	ErrForbidden = errors.New("forbidden")
+
+	// ErrUserNotFound пользователь не найден или не опубликовал открытый ключ.
+	ErrUserNotFound = errors.New("user not found or has no public key")
+
+	// ErrLastOwner операция оставила бы хранилище без владельца.
+	ErrLastOwner = errors.New("vault must have at least one owner")
+)
+
+// Keyring интерфейс получения ключей пользователей.
+type Keyring interface {
+	Keys() (*keyring.Keys, error)
+	LegacyKeys() (*keybox.KeyPair, error)
+	PublicKeys(login string) (*model.UserKeys, error)
+}
+
+// Vault структура для работы с общими хранилищами.
+type Vault struct {
+	client    *resty.Client
+	keyring   Keyring
+	cacheFile string
+	vaults    []*model.Vault
+}
+
+// New конструктор.
+// keyring — ключи пользователя, cacheFile — путь к файлу локального кеша списка хранилищ.
+func New(client *resty.Client, keyring Keyring, cacheFile string) *Vault {
+	return &Vault{
+		client:    client,
+		keyring:   keyring,
+		cacheFile: cacheFile,
+	}
+}
+
+// Create метод создает общее хранилище со случайным ключом.
+func (v *Vault) Create(name string) (*model.Vault, error) {
+	keys, err := v.keyring.Keys()
+	if err != nil {
+		return nil, err
+	}
+
+	key, err := keybox.NewKey()
+	if err != nil {
+		return nil, err
+	}
+
+	wrappedKey, err := keybox.Seal(key, keys.Encryption.Public[:])
+	if err != nil {
+		return nil, err
+	}
+
+	vault := &model.Vault{}
+	response, err := v.client.R().
+		SetBody(model.VaultCreateRequest{Name: name, WrappedKey: wrappedKey}).
+		SetResult(vault).
+		Post("/vaults")
+	if err != nil {
+		return nil, err
+	}
+
+	if err = statusError(response); err != nil {
+		return nil, err
+	}
+
+	v.vaults = nil
+	return vault, nil
+}
+
+// List метод возвращает общие хранилища пользователя.
+// Если сервер недоступен, список читается из локального кеша.
+func (v *Vault) List() ([]*model.Vault, error) {
+	if v.vaults != nil {
+		return v.vaults, nil
+	}
+
+	vaults := make([]*model.Vault, 0)
+	response, err := v.client.R().SetResult(&vaults).Get("/vaults")
+	if err != nil {
+		return v.readCache()
+	}
+
+	if err = statusError(response); err != nil {
+		if errors.Is(err, ErrUnauthorized) {
+			return v.readCache()
+		}
+		return nil, err
+	}
+
+	v.vaults = vaults
+	if err = v.writeCache(vaults); err != nil {
+		return nil, fmt.Errorf("vault cache error: %w", err)
+	}
+
+	return vaults, nil
+}
+
+// Find метод ищет хранилище по UUID или имени.
+func (v *Vault) Find(nameOrUUID string) (*model.Vault, error) {
+	vaults, err := v.List()
+	if err != nil {
+		return nil, err
+	}
+
+	for _, vault := range vaults {
+		if vault.UUID == nameOrUUID || vault.Name == nameOrUUID {
+			return vault, nil
+		}
+	}
+
+	return nil, fmt.Errorf("%w: %s", ErrNotFound, nameOrUUID)
+}
+
+// Cipher метод возвращает шифровщик записей хранилища.
+func (v *Vault) Cipher(vaultUUID string) (*cipher.Cipher, error) {
+	vault, err := v.Find(vaultUUID)
+	if err != nil {
+		return nil, err
+	}
+
+	key, err := v.openKey(vault)
+	if err != nil {
+		return nil, err
+	}
+
+	return cipher.New(key), nil
+}
+
+// Members метод возвращает участников хранилища.
+func (v *Vault) Members(nameOrUUID string) ([]*model.VaultMember, error) {
+	vault, err := v.Find(nameOrUUID)
+	if err != nil {
+		return nil, err
+	}
+
+	members := make([]*model.VaultMember, 0)
+	response, err := v.client.R().SetResult(&members).Get("/vaults/" + vault.UUID + "/members")
+	if err != nil {
+		return nil, err
+	}
+
+	if err = statusError(response); err != nil {
+		return nil, err
+	}
+
+	return members, nil
+}
+
+// SaveMember метод добавляет участника в хранилище или изменяет его роль.
+// Ключ хранилища шифруется открытым ключом участника, опубликованным на сервере.
+func (v *Vault) SaveMember(nameOrUUID, login string, role model.VaultRole) error {
+	vault, err := v.Find(nameOrUUID)
+	if err != nil {
+		return err
+	}
+
+	key, err := v.openKey(vault)
+	if err != nil {
+		return err
+	}
+
+	userKeys, err := v.keyring.PublicKeys(login)
+	if err != nil {
+		if errors.Is(err, keyring.ErrUserNotFound) {
+			return fmt.Errorf("%w: %s", ErrUserNotFound, login)
+		}
+		return err
+	}
+
+	wrappedKey, err := keybox.Seal(key, userKeys.EncryptionKey)
+	if err != nil {
+		return err
+	}
+
+	response, err := v.client.R().
+		SetBody(model.VaultMemberRequest{Login: login, Role: role, WrappedKey: wrappedKey}).
+		Put("/vaults/" + vault.UUID + "/members")
+	if err != nil {
+		return err
+	}
+
+	if err = statusError(response); err != nil {
+		if errors.Is(err, ErrNotFound) {
+			return fmt.Errorf("%w: %s", ErrUserNotFound, login)
+		}
+		return err
+	}
+
+	return nil
+}
+
+// RemoveMember метод удаляет участника из хранилища.
+func (v *Vault) RemoveMember(nameOrUUID, login string) error {
+	vault, err := v.Find(nameOrUUID)
+	if err != nil {
+		return err
+	}
+
+	response, err := v.client.R().Delete("/vaults/" + vault.UUID + "/members/" + url.PathEscape(login))
+	if err != nil {
+		return err
+	}
+
+	return statusError(response)
+}
+
+// openKey метод расшифровывает ключ хранилища закрытым ключом пользователя.
+// Ключ, зашифрованный прежней парой ключей пользователя (выведенной из ключа хранилища профиля),
+// перешифровывается текущим открытым ключом и публикуется на сервере.
+func (v *Vault) openKey(vault *model.Vault) ([]byte, error) {
+	keys, err := v.keyring.Keys()
+	if err != nil {
+		return nil, err
+	}
+
+	key, err := keys.Encryption.Open(vault.WrappedKey)
+	if err == nil || !errors.Is(err, keybox.ErrDecrypt) {
+		return key, err
+	}
+
+	legacy, legacyErr := v.keyring.LegacyKeys()
+	if legacyErr != nil {
+		return nil, err
+	}
+
+	if key, legacyErr = legacy.Open(vault.WrappedKey); legacyErr != nil {
+		return nil, err
+	}
+
+	if err = v.rewrapKey(vault, key, keys); err != nil {
+		return nil, fmt.Errorf("vault key rewrap error: %w", err)
+	}
+
+	return key, nil
+}
+
+// rewrapKey метод шифрует ключ хранилища текущим открытым ключом пользователя,
+// публикует его на сервере и обновляет локальный кеш.
+func (v *Vault) rewrapKey(vault *model.Vault, key []byte, keys *keyring.Keys) error {
+	wrappedKey, err := keybox.Seal(key, keys.Encryption.Public[:])
+	if err != nil {
+		return err
+	}
+
+	response, err := v.client.R().
+		SetBody(model.VaultKeyRequest{WrappedKey: wrappedKey}).
+		Put("/vaults/" + vault.UUID + "/key")
+	if err != nil {
+		return err
+	}
+
+	if err = statusError(response); err != nil {
+		return err
+	}
+
+	vault.WrappedKey = wrappedKey
+	if v.vaults != nil {
+		return v.writeCache(v.vaults)
+	}
+	return nil
+}
+
+// readCache метод читает список хранилищ из локального кеша.
+func (v *Vault) readCache() ([]*model.Vault, error) {
+	vaults := make([]*model.Vault, 0)
+
+	bCache, err := os.ReadFile(v.cacheFile)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return vaults, nil
+		}
+		return nil, err
+	}
+
+	if err = json.Unmarshal(bCache, &vaults); err != nil {
+		return nil, err
+	}
+
+	v.vaults = vaults
+	return vaults, nil
+}
+
+// writeCache метод сохраняет список хранилищ в локальный кеш.
+// Ключи хранилищ в кеше зашифрованы открытым ключом пользователя.
+func (v *Vault) writeCache(vaults []*model.Vault) error {
+	bCache, err := json.Marshal(vaults)
+	if err != nil {
+		return err
+	}
+
+	if err = os.MkdirAll(filepath.Dir(v.cacheFile), 0700); err != nil {
+		return err
+	}
+
+	return os.WriteFile(v.cacheFile, bCache, cacheFileMode)
+}
+
+// statusError возвращает ошибку по коду ответа сервера.
+func statusError(response *resty.Response) error {
+	switch response.StatusCode() {
+	case http.StatusOK:
+		return nil
+	case http.StatusBadRequest:
+		return fmt.Errorf("incorrect values: %w", errors.New(string(response.Body())))
+	case http.StatusUnauthorized:
+		return ErrUnauthorized
+	case http.StatusForbidden:
+		return ErrForbidden
+	case http.StatusNotFound:
+		return ErrNotFound
+	case http.StatusConflict:
+		return ErrLastOwner
+	}
+
+	return fmt.Errorf("internal server error: %w", errors.New(response.Status()))
+}
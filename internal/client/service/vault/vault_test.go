@@ -0,0 +1,190 @@
+package vault
+
+import (
+	"encoding/json"
+	"net/http"
+	"path/filepath"
+	"testing"
+
+	"github.com/casnerano/seckeep/internal/client/service/keyring"
+	mock_vault "github.com/casnerano/seckeep/internal/client/service/vault/mock"
+	"github.com/casnerano/seckeep/internal/server/model"
+	"github.com/casnerano/seckeep/pkg/cipher"
+	"github.com/casnerano/seckeep/pkg/keybox"
+	"github.com/go-resty/resty/v2"
+	"github.com/golang/mock/gomock"
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/suite"
+)
+
+var jsonHeader = http.Header{"Content-Type": []string{"application/json"}}
+
+type VaultServiceTestSuite struct {
+	suite.Suite
+	client  *resty.Client
+	keyring *mock_vault.MockKeyring
+	keys    *keyring.Keys
+	key     []byte
+	wrapped []byte
+}
+
+func (s *VaultServiceTestSuite) SetupSuite() {
+	ctrl := gomock.NewController(s.T())
+	defer ctrl.Finish()
+
+	s.client = resty.New()
+	s.client.SetBaseURL("http://127.0.0.1/api")
+
+	encryption, err := keybox.GenerateKeyPair()
+	s.Require().NoError(err)
+
+	s.keys = &keyring.Keys{Encryption: encryption}
+	s.keyring = mock_vault.NewMockKeyring(ctrl)
+	s.keyring.EXPECT().Keys().Return(s.keys, nil).AnyTimes()
+
+	s.key, err = keybox.NewKey()
+	s.Require().NoError(err)
+
+	s.wrapped, err = keybox.Seal(s.key, s.keys.Encryption.Public[:])
+	s.Require().NoError(err)
+}
+
+func (s *VaultServiceTestSuite) SetupTest() {
+	httpmock.ActivateNonDefault(s.client.GetClient())
+}
+
+func (s *VaultServiceTestSuite) TearDownTest() {
+	httpmock.DeactivateAndReset()
+}
+
+func (s *VaultServiceTestSuite) newService() *Vault {
+	return New(s.client, s.keyring, filepath.Join(s.T().TempDir(), "vaults.json"))
+}
+
+func (s *VaultServiceTestSuite) vaultsResponder() httpmock.Responder {
+	body, err := json.Marshal([]*model.Vault{
+		{UUID: "4d1f", Name: "family", Role: model.VaultRoleOwner, WrappedKey: s.wrapped},
+	})
+	s.Require().NoError(err)
+
+	return httpmock.NewBytesResponder(http.StatusOK, body).HeaderSet(jsonHeader)
+}
+
+func (s *VaultServiceTestSuite) TestListCache() {
+	cacheFile := filepath.Join(s.T().TempDir(), "vaults.json")
+
+	httpmock.RegisterResponder(http.MethodGet, s.client.BaseURL+"/vaults", s.vaultsResponder())
+
+	vaults, err := New(s.client, s.keyring, cacheFile).List()
+	s.Require().NoError(err)
+	s.Len(vaults, 1)
+
+	httpmock.RegisterResponder(
+		http.MethodGet, s.client.BaseURL+"/vaults",
+		httpmock.NewStringResponder(http.StatusUnauthorized, ""),
+	)
+
+	vault, err := New(s.client, s.keyring, cacheFile).Find("family")
+	s.Require().NoError(err)
+	s.Equal("4d1f", vault.UUID)
+}
+
+func (s *VaultServiceTestSuite) TestCipher() {
+	httpmock.RegisterResponder(http.MethodGet, s.client.BaseURL+"/vaults", s.vaultsResponder())
+
+	service := s.newService()
+
+	c, err := service.Cipher("4d1f")
+	s.Require().NoError(err)
+
+	encrypted, err := c.Encrypt([]byte("example"))
+	s.Require().NoError(err)
+
+	decrypted, err := cipher.New(s.key).Decrypt(encrypted)
+	s.Require().NoError(err)
+	s.Equal([]byte("example"), decrypted)
+
+	_, err = service.Cipher("unknown")
+	s.ErrorIs(err, ErrNotFound)
+}
+
+func (s *VaultServiceTestSuite) TestLegacyKeyRewrap() {
+	legacy, err := keybox.DeriveLegacyKeyPair([]byte("profile secret"))
+	s.Require().NoError(err)
+	s.keyring.EXPECT().LegacyKeys().Return(legacy, nil)
+
+	legacyWrapped, err := keybox.Seal(s.key, legacy.Public[:])
+	s.Require().NoError(err)
+
+	body, err := json.Marshal([]*model.Vault{
+		{UUID: "4d1f", Name: "family", Role: model.VaultRoleViewer, WrappedKey: legacyWrapped},
+	})
+	s.Require().NoError(err)
+	httpmock.RegisterResponder(
+		http.MethodGet, s.client.BaseURL+"/vaults",
+		httpmock.NewBytesResponder(http.StatusOK, body).HeaderSet(jsonHeader),
+	)
+
+	var rewrapped model.VaultKeyRequest
+	httpmock.RegisterResponder(
+		http.MethodPut, s.client.BaseURL+"/vaults/4d1f/key",
+		func(request *http.Request) (*http.Response, error) {
+			s.Require().NoError(json.NewDecoder(request.Body).Decode(&rewrapped))
+			return httpmock.NewStringResponse(http.StatusOK, ""), nil
+		},
+	)
+
+	service := s.newService()
+
+	c, err := service.Cipher("4d1f")
+	s.Require().NoError(err)
+
+	encrypted, err := c.Encrypt([]byte("example"))
+	s.Require().NoError(err)
+	decrypted, err := cipher.New(s.key).Decrypt(encrypted)
+	s.Require().NoError(err)
+	s.Equal([]byte("example"), decrypted)
+
+	key, err := s.keys.Encryption.Open(rewrapped.WrappedKey)
+	s.Require().NoError(err)
+	s.Equal(s.key, key)
+
+	// Повторно ключ открывается текущей парой ключей и не перешифровывается.
+	_, err = service.Cipher("4d1f")
+	s.Require().NoError(err)
+	s.Equal(1, httpmock.GetCallCountInfo()["PUT "+s.client.BaseURL+"/vaults/4d1f/key"])
+}
+
+func (s *VaultServiceTestSuite) TestSaveMember() {
+	member, err := keybox.GenerateKeyPair()
+	s.Require().NoError(err)
+
+	httpmock.RegisterResponder(http.MethodGet, s.client.BaseURL+"/vaults", s.vaultsResponder())
+
+	s.Run("Key sealed to member", func() {
+		s.keyring.EXPECT().PublicKeys("anna").Return(&model.UserKeys{Login: "anna", EncryptionKey: member.Public[:]}, nil)
+
+		var request model.VaultMemberRequest
+		httpmock.RegisterResponder(http.MethodPut, s.client.BaseURL+"/vaults/4d1f/members", func(r *http.Request) (*http.Response, error) {
+			s.NoError(json.NewDecoder(r.Body).Decode(&request))
+			return httpmock.NewStringResponse(http.StatusOK, ""), nil
+		})
+
+		s.Require().NoError(s.newService().SaveMember("family", "anna", model.VaultRoleEditor))
+		s.Equal(model.VaultRoleEditor, request.Role)
+
+		key, err := member.Open(request.WrappedKey)
+		s.Require().NoError(err)
+		s.Equal(s.key, key)
+	})
+
+	s.Run("Member without public key", func() {
+		s.keyring.EXPECT().PublicKeys("ivan").Return(nil, keyring.ErrUserNotFound)
+
+		s.ErrorIs(s.newService().SaveMember("family", "ivan", model.VaultRoleViewer), ErrUserNotFound)
+	})
+}
+
+func TestVaultServiceTestSuite(t *testing.T) {
+	suite.Run(t, new(VaultServiceTestSuite))
+}
@@ -1,29 +1,45 @@
 // Package model содержит общие модели данных.
 package model
 
-import "time"
+import (
+	"regexp"
+	"time"
+)
 
 // Data структура секретных данных.
+// DeletedAt задано только у записей в корзине.
 type Data struct {
-	UUID      string    `json:"uuid"`
-	UserUUID  string    `json:"user_uuid"`
-	Type      DataType  `json:"type"`
+	UUID      string     `json:"uuid"`
+	UserUUID  string     `json:"user_uuid"`
+	VaultUUID string     `json:"vault_uuid,omitempty"`
+	Type      DataType   `json:"type"`
+	Value     []byte     `json:"value"`
+	Version   time.Time  `json:"version"`
+	CreatedAt time.Time  `json:"created_at"`
+	DeletedAt *time.Time `json:"deleted_at,omitempty"`
+}
+
+// DataRevision структура сохраненной на сервере прежней версии (ревизии) записи секретных данных.
+// Revision — порядковый номер ревизии записи, Value — зашифрованное значение,
+// Version — версия значения, CreatedAt — время, когда значение было заменено.
+type DataRevision struct {
+	Revision  int       `json:"revision"`
 	Value     []byte    `json:"value"`
 	Version   time.Time `json:"version"`
 	CreatedAt time.Time `json:"created_at"`
 }
 
 // DataType типы данных.
+// Значения записей зашифрованы, поэтому сервер не различает типы и проверяет только формат имени типа.
+// Структура, проверка и отображение каждого типа описываются в реестре типов клиента.
 type DataType string
 
+// dataTypeFormat формат имени типа данных: заглавные латинские буквы, цифры и подчеркивание.
+var dataTypeFormat = regexp.MustCompile(`^[A-Z][A-Z0-9_]{0,31}$`)
+
 // IsValid проверяет на валидность тип данных.
 func (d DataType) IsValid() bool {
-	switch d {
-	case DataTypeCredential, DataTypeText,
-		DataTypeCard, DataTypeDocument:
-		return true
-	}
-	return false
+	return dataTypeFormat.MatchString(string(d))
 }
 
 // Варианты типов данных.
@@ -39,4 +55,22 @@ const (
 
 	// DataTypeDocument произвольный документ.
 	DataTypeDocument DataType = "DOCUMENT"
+
+	// DataTypeSSHKey SSH-ключ.
+	DataTypeSSHKey DataType = "SSH_KEY"
+
+	// DataTypeAPIToken токен доступа к API.
+	DataTypeAPIToken DataType = "API_TOKEN"
+
+	// DataTypeIdentity личные данные (имя, адрес, паспорт).
+	DataTypeIdentity DataType = "IDENTITY"
+
+	// DataTypeNote защищенная заметка в формате Markdown.
+	DataTypeNote DataType = "NOTE"
+
+	// DataTypeWiFi сеть Wi-Fi.
+	DataTypeWiFi DataType = "WIFI"
+
+	// DataTypeCryptoWallet криптовалютный кошелек.
+	DataTypeCryptoWallet DataType = "CRYPTO_WALLET"
 )
@@ -12,7 +12,10 @@ func TestDataType_IsValid(t *testing.T) {
 		{"Valid DataTypeCard", DataTypeCard, true},
 		{"Valid DataTypeText", DataTypeText, true},
 		{"Valid DataTypeDocument", DataTypeDocument, true},
+		{"Valid DataTypeSSHKey", DataTypeSSHKey, true},
+		{"Valid custom type", DataType("LICENSE_KEY"), true},
 		{"Invalid DataTypeUnknown", DataType("DataTypeUnknown"), false},
+		{"Invalid empty", DataType(""), false},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
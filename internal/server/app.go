@@ -4,14 +4,20 @@ package server
 
 import (
 	"context"
+	"fmt"
+	"os"
 	"os/signal"
 	"syscall"
 
 	"github.com/casnerano/seckeep/internal/server/config"
 	"github.com/casnerano/seckeep/internal/server/http"
+	"github.com/casnerano/seckeep/internal/server/http/middleware"
 	"github.com/casnerano/seckeep/internal/server/repository/pgsql"
 	"github.com/casnerano/seckeep/internal/server/service/account"
 	"github.com/casnerano/seckeep/internal/server/service/data"
+	"github.com/casnerano/seckeep/internal/server/service/emergency"
+	"github.com/casnerano/seckeep/internal/server/service/share"
+	"github.com/casnerano/seckeep/internal/server/service/vault"
 	"github.com/casnerano/seckeep/pkg/config/yaml"
 	"github.com/casnerano/seckeep/pkg/jwtoken"
 	"github.com/casnerano/seckeep/pkg/log"
@@ -22,10 +28,13 @@ import (
 
 // App структура приложения.
 type App struct {
-	config  *config.Config
-	logger  *log.Logger
-	server  *http.Server
-	pgxpool *pgxpool.Pool
+	config    *config.Config
+	logger    *log.Logger
+	server    *http.Server
+	scheduler *emergency.Scheduler
+	trash     *data.Scheduler
+	shares    *share.Scheduler
+	pgxpool   *pgxpool.Pool
 }
 
 // NewApp конструктор.
@@ -58,21 +67,70 @@ func NewApp() (*App, error) {
 		return nil, err
 	}
 
+	// Инициализация набора ключей подписи токенов.
+	keySet, err := app.initKeySet()
+	if err != nil {
+		app.logger.Emergency("Не удалось загрузить ключи подписи токенов.", err)
+		return nil, err
+	}
+
 	// Инициализация зависимостей.
 
 	userRepository := pgsql.NewUserRepository(app.pgxpool)
-	dataRepository := pgsql.NewDataRepository(app.pgxpool)
+	dataRepository := pgsql.NewDataRepository(app.pgxpool, app.config.App.Data.HistoryLimit)
+	sessionRepository := pgsql.NewSessionRepository(app.pgxpool)
+	vaultRepository := pgsql.NewVaultRepository(app.pgxpool)
+	shareRepository := pgsql.NewShareRepository(app.pgxpool)
+	emergencyRepository := pgsql.NewEmergencyRepository(app.pgxpool)
 
 	accountService := account.New(
 		userRepository,
-		jwtoken.New(),
-		app.config.App.Authenticator.Secret,
+		dataRepository,
+		sessionRepository,
+		jwtoken.New(keySet),
+	)
+
+	vaultService := vault.New(vaultRepository, userRepository)
+
+	emergencyService := emergency.New(emergencyRepository, userRepository, dataRepository)
+
+	// Планировщик открывает экстренные доступы, период ожидания которых истек.
+	app.scheduler = emergency.NewScheduler(
+		emergencyService,
+		app.config.App.Emergency.CheckInterval,
+		app.logger,
+	)
+
+	dataService := data.New(dataRepository)
+
+	// Планировщик удаляет из корзины записи с истекшим сроком хранения.
+	app.trash = data.NewScheduler(
+		dataService,
+		app.config.App.Data.TrashCheckInterval,
+		app.config.App.Data.TrashRetention,
+		app.logger,
+	)
+
+	shareService := share.New(shareRepository)
+
+	// Планировщик удаляет ссылки на секреты с истекшим сроком действия.
+	app.shares = share.NewScheduler(
+		shareService,
+		app.config.App.Share.CheckInterval,
+		app.logger,
 	)
 
-	router := http.NewRouter(app.logger, app.config.App.Authenticator.Secret)
+	router := http.NewRouter(app.logger, keySet, accountService)
 	router.InitServiceHandler()
-	router.InitAccountHandler(accountService)
-	router.InitDataHandler(data.New(dataRepository))
+	router.InitJWKSHandler()
+	router.InitAccountHandler(
+		accountService,
+		middleware.RateLimiter(middleware.NewMemoryRateLimitStore(), rateLimiterConfig(app.config.App.RateLimiter)),
+	)
+	router.InitDataHandler(dataService, vaultService)
+	router.InitVaultHandler(vaultService)
+	router.InitShareHandler(shareService)
+	router.InitEmergencyHandler(emergencyService)
 
 	app.server = http.NewServer(
 		app.config.Server.Addr,
@@ -84,11 +142,50 @@ func NewApp() (*App, error) {
 	return &app, nil
 }
 
+// initKeySet метод загружает набор ключей подписи токенов из конфигурации.
+func (a *App) initKeySet() (*jwtoken.KeySet, error) {
+	keySet, err := jwtoken.NewKeySet()
+	if err != nil {
+		return nil, err
+	}
+
+	for _, cfgKey := range a.config.App.Authenticator.Keys {
+		fName := cfgKey.PrivateKeyFile
+		if fName == "" {
+			fName = cfgKey.PublicKeyFile
+		}
+
+		bKey, err := os.ReadFile(fName)
+		if err != nil {
+			return nil, err
+		}
+
+		key, err := jwtoken.ParsePEM(cfgKey.ID, bKey)
+		if err != nil {
+			return nil, fmt.Errorf("key %s: %w", cfgKey.ID, err)
+		}
+
+		if err = keySet.Add(key); err != nil {
+			return nil, err
+		}
+	}
+
+	if err = keySet.SetSigningKey(a.config.App.Authenticator.SigningKey); err != nil {
+		return nil, err
+	}
+
+	return keySet, nil
+}
+
 // Run метод запуска приложения.
 func (a *App) Run() error {
 	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
 	defer stop()
 
+	go a.scheduler.Run(ctx)
+	go a.trash.Run(ctx)
+	go a.shares.Run(ctx)
+
 	if err := a.server.Start(ctx); err != nil {
 		a.logger.Emergency("Ошибка запуска сервера.", err)
 		return err
@@ -109,3 +206,16 @@ func (a *App) Shutdown() {
 
 	a.pgxpool.Close()
 }
+
+// rateLimiterConfig преобразует настройки ограничителя частоты запросов в параметры middleware.
+func rateLimiterConfig(c config.RateLimiter) middleware.RateLimiterConfig {
+	return middleware.RateLimiterConfig{
+		IP:    middleware.Limit{Burst: c.IP.Burst, Interval: c.IP.Interval},
+		Login: middleware.Limit{Burst: c.Login.Burst, Interval: c.Login.Interval},
+		Lockout: middleware.Lockout{
+			Threshold: c.Lockout.Threshold,
+			BaseDelay: c.Lockout.BaseDelay,
+			MaxDelay:  c.Lockout.MaxDelay,
+		},
+	}
+}
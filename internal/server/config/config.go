@@ -1,5 +1,9 @@
 package config
 
+import (
+	"time"
+)
+
 // FileName дефолтный путь к файлу конфигурации сервера.
 const FileName = "./configs/server.yml"
 
@@ -7,8 +11,21 @@ const FileName = "./configs/server.yml"
 type Config struct {
 	App struct {
 		Authenticator struct {
-			Secret string `yaml:"secret"`
+			SigningKey string             `yaml:"signing_key"`
+			Keys       []AuthenticatorKey `yaml:"keys"`
 		} `yaml:"authenticator"`
+		RateLimiter RateLimiter `yaml:"rate_limiter"`
+		Data        struct {
+			HistoryLimit       int           `yaml:"history_limit"`
+			TrashRetention     time.Duration `yaml:"trash_retention"`
+			TrashCheckInterval time.Duration `yaml:"trash_check_interval"`
+		} `yaml:"data"`
+		Emergency struct {
+			CheckInterval time.Duration `yaml:"check_interval"`
+		} `yaml:"emergency"`
+		Share struct {
+			CheckInterval time.Duration `yaml:"check_interval"`
+		} `yaml:"share"`
 	} `yaml:"app"`
 	Server struct {
 		Addr        string `yaml:"addr"`
@@ -18,3 +35,29 @@ type Config struct {
 		DSN string `yaml:"dsn"`
 	} `yaml:"database"`
 }
+
+// AuthenticatorKey ключ подписи (или только проверки) JWT токенов.
+// Для ключа подписи указывается закрытый ключ, для ключей, выведенных из ротации, — достаточно открытого.
+type AuthenticatorKey struct {
+	ID             string `yaml:"id"`
+	PrivateKeyFile string `yaml:"private_key_file"`
+	PublicKeyFile  string `yaml:"public_key_file"`
+}
+
+// RateLimiter настройки ограничителя частоты запросов:
+// корзины токенов по IP-адресу и логину и прогрессивная блокировка логина после неудачных попыток.
+type RateLimiter struct {
+	IP      RateLimit `yaml:"ip"`
+	Login   RateLimit `yaml:"login"`
+	Lockout struct {
+		Threshold int           `yaml:"threshold"`
+		BaseDelay time.Duration `yaml:"base_delay"`
+		MaxDelay  time.Duration `yaml:"max_delay"`
+	} `yaml:"lockout"`
+}
+
+// RateLimit настройки корзины токенов: Burst токенов, пополнение на один токен каждые Interval.
+type RateLimit struct {
+	Burst    int           `yaml:"burst"`
+	Interval time.Duration `yaml:"interval"`
+}
@@ -8,15 +8,26 @@ import (
 	"fmt"
 	"net/http"
 
+	"github.com/casnerano/seckeep/internal/server/http/middleware"
 	"github.com/casnerano/seckeep/internal/server/model"
 	"github.com/casnerano/seckeep/internal/server/service/account"
 	"github.com/casnerano/seckeep/pkg/log"
+	"github.com/go-chi/chi/v5"
 )
 
 // AccountService интерфейс сервиса взаимодействия с аккаунтом.
 type AccountService interface {
 	SignIn(ctx context.Context, login, password string) (string, error)
 	SignUp(ctx context.Context, login, password, fullName string) (string, error)
+	ChangePassword(ctx context.Context, userUUID, oldPassword, newPassword string) (string, error)
+	Delete(ctx context.Context, userUUID, password string) error
+	Export(ctx context.Context, userUUID string) (*model.AccountExport, error)
+	SignOut(ctx context.Context, userUUID, sessionID string) error
+	SetKeys(ctx context.Context, userUUID string, keys model.UserKeys) error
+	Keys(ctx context.Context, userUUID string) (*model.UserKeys, error)
+	PublicKeys(ctx context.Context, login string) (*model.UserKeys, error)
+	SetRecoveryKey(ctx context.Context, userUUID string, verifier, wrappedKey []byte) error
+	Recover(ctx context.Context, login string, verifier []byte, newPassword string) ([]byte, error)
 }
 
 // Account структура обработчика взаимодействия с аккаунтом.
@@ -65,3 +76,208 @@ func (a *Account) SignIn(rd model.UserSignInRequest, w http.ResponseWriter, r *h
 
 	return nil, http.StatusOK
 }
+
+// SignOut обработчик выхода из аккаунта, отзывает текущую сессию.
+func (a *Account) SignOut(w http.ResponseWriter, r *http.Request) (any, int) {
+	userUUID, ok := middleware.GetUserUUID(r.Context())
+	if !ok {
+		return nil, http.StatusUnauthorized
+	}
+
+	sessionID, ok := middleware.GetSessionID(r.Context())
+	if !ok {
+		return nil, http.StatusUnauthorized
+	}
+
+	if err := a.service.SignOut(r.Context(), userUUID, sessionID); err != nil {
+		a.logger.Error("Ошибка выхода из аккаунта.", err)
+		return nil, http.StatusInternalServerError
+	}
+
+	a.logger.Info(fmt.Sprintf("Сессия пользователя \"%s\" отозвана", userUUID))
+	return nil, http.StatusOK
+}
+
+// ChangePassword обработчик смены пароля.
+// Ранее выданные токены становятся недействительными, новый токен возвращается в заголовке.
+func (a *Account) ChangePassword(rd model.UserChangePasswordRequest, w http.ResponseWriter, r *http.Request) (any, int) {
+	userUUID, ok := middleware.GetUserUUID(r.Context())
+	if !ok {
+		return nil, http.StatusUnauthorized
+	}
+
+	token, err := a.service.ChangePassword(r.Context(), userUUID, rd.OldPassword, rd.NewPassword)
+	if err != nil {
+		if errors.Is(err, account.ErrIncorrectCredentials) {
+			return nil, http.StatusForbidden
+		}
+
+		if errors.Is(err, account.ErrUserNotFound) {
+			return nil, http.StatusUnauthorized
+		}
+
+		a.logger.Error("Ошибка смены пароля.", err)
+		return nil, http.StatusInternalServerError
+	}
+
+	a.logger.Info(fmt.Sprintf("Успешная смена пароля пользователя \"%s\"", userUUID))
+	w.Header().Set("Authorization", fmt.Sprintf("Bearer %s", token))
+
+	return nil, http.StatusOK
+}
+
+// Delete обработчик удаления аккаунта.
+func (a *Account) Delete(rd model.UserDeleteRequest, w http.ResponseWriter, r *http.Request) (any, int) {
+	userUUID, ok := middleware.GetUserUUID(r.Context())
+	if !ok {
+		return nil, http.StatusUnauthorized
+	}
+
+	if err := a.service.Delete(r.Context(), userUUID, rd.Password); err != nil {
+		if errors.Is(err, account.ErrIncorrectCredentials) {
+			return nil, http.StatusForbidden
+		}
+
+		if errors.Is(err, account.ErrUserNotFound) {
+			return nil, http.StatusUnauthorized
+		}
+
+		a.logger.Error("Ошибка удаления аккаунта.", err)
+		return nil, http.StatusInternalServerError
+	}
+
+	a.logger.Info(fmt.Sprintf("Аккаунт пользователя \"%s\" удален", userUUID))
+	return nil, http.StatusOK
+}
+
+// Export обработчик выгрузки всех данных аккаунта.
+func (a *Account) Export(w http.ResponseWriter, r *http.Request) (any, int) {
+	userUUID, ok := middleware.GetUserUUID(r.Context())
+	if !ok {
+		return nil, http.StatusUnauthorized
+	}
+
+	result, err := a.service.Export(r.Context(), userUUID)
+	if err != nil {
+		if errors.Is(err, account.ErrUserNotFound) {
+			return nil, http.StatusUnauthorized
+		}
+
+		a.logger.Error("Ошибка выгрузки данных аккаунта.", err)
+		return nil, http.StatusInternalServerError
+	}
+
+	a.logger.Info(fmt.Sprintf("Данные аккаунта пользователя \"%s\" выгружены", userUUID))
+	return result, http.StatusOK
+}
+
+// SetKeys обработчик публикации ключей пользователя.
+func (a *Account) SetKeys(rd model.UserKeysRequest, w http.ResponseWriter, r *http.Request) (any, int) {
+	userUUID, ok := middleware.GetUserUUID(r.Context())
+	if !ok {
+		return nil, http.StatusUnauthorized
+	}
+
+	keys := model.UserKeys{
+		EncryptionKey: rd.EncryptionKey,
+		SigningKey:    rd.SigningKey,
+		PrivateKeys:   rd.PrivateKeys,
+	}
+
+	if err := a.service.SetKeys(r.Context(), userUUID, keys); err != nil {
+		if errors.Is(err, account.ErrUserNotFound) {
+			return nil, http.StatusUnauthorized
+		}
+
+		if errors.Is(err, account.ErrKeysExist) {
+			return nil, http.StatusConflict
+		}
+
+		a.logger.Error("Ошибка публикации ключей.", err)
+		return nil, http.StatusInternalServerError
+	}
+
+	a.logger.Info(fmt.Sprintf("Ключи пользователя \"%s\" опубликованы", userUUID))
+	return nil, http.StatusOK
+}
+
+// Keys обработчик получения собственных ключей пользователя.
+func (a *Account) Keys(w http.ResponseWriter, r *http.Request) (any, int) {
+	userUUID, ok := middleware.GetUserUUID(r.Context())
+	if !ok {
+		return nil, http.StatusUnauthorized
+	}
+
+	result, err := a.service.Keys(r.Context(), userUUID)
+	if err != nil {
+		if errors.Is(err, account.ErrUserNotFound) {
+			return nil, http.StatusUnauthorized
+		}
+
+		if errors.Is(err, account.ErrKeysNotFound) {
+			return nil, http.StatusNotFound
+		}
+
+		a.logger.Error("Ошибка получения ключей.", err)
+		return nil, http.StatusInternalServerError
+	}
+
+	return result, http.StatusOK
+}
+
+// PublicKeys обработчик получения открытых ключей пользователя по логину.
+func (a *Account) PublicKeys(w http.ResponseWriter, r *http.Request) (any, int) {
+	login := chi.URLParam(r, "login")
+	if login == "" {
+		return nil, http.StatusBadRequest
+	}
+
+	result, err := a.service.PublicKeys(r.Context(), login)
+	if err != nil {
+		if errors.Is(err, account.ErrUserNotFound) || errors.Is(err, account.ErrKeysNotFound) {
+			return nil, http.StatusNotFound
+		}
+
+		a.logger.Error("Ошибка получения открытых ключей.", err)
+		return nil, http.StatusInternalServerError
+	}
+
+	return result, http.StatusOK
+}
+
+// SetRecoveryKey обработчик сохранения ключа восстановления.
+func (a *Account) SetRecoveryKey(rd model.UserRecoveryKeyRequest, w http.ResponseWriter, r *http.Request) (any, int) {
+	userUUID, ok := middleware.GetUserUUID(r.Context())
+	if !ok {
+		return nil, http.StatusUnauthorized
+	}
+
+	if err := a.service.SetRecoveryKey(r.Context(), userUUID, rd.Verifier, rd.WrappedKey); err != nil {
+		if errors.Is(err, account.ErrUserNotFound) {
+			return nil, http.StatusUnauthorized
+		}
+
+		a.logger.Error("Ошибка сохранения ключа восстановления.", err)
+		return nil, http.StatusInternalServerError
+	}
+
+	a.logger.Info(fmt.Sprintf("Пользователь \"%s\" сохранил ключ восстановления", userUUID))
+	return nil, http.StatusOK
+}
+
+// Recover обработчик восстановления доступа по ключу восстановления.
+// Неверный ключ и неизвестный логин не различаются, чтобы не раскрывать существование аккаунта.
+func (a *Account) Recover(rd model.UserRecoverRequest, w http.ResponseWriter, r *http.Request) (any, int) {
+	wrappedKey, err := a.service.Recover(r.Context(), rd.Login, rd.Verifier, rd.NewPassword)
+	if err != nil {
+		if errors.Is(err, account.ErrIncorrectCredentials) || errors.Is(err, account.ErrUserNotFound) {
+			return nil, http.StatusUnauthorized
+		}
+
+		a.logger.Error("Ошибка восстановления доступа.", err)
+		return nil, http.StatusInternalServerError
+	}
+
+	a.logger.Info(fmt.Sprintf("Доступ пользователя \"%s\" восстановлен по ключу восстановления", rd.Login))
+	return model.UserRecoverResponse{WrappedKey: wrappedKey}, http.StatusOK
+}
@@ -1,14 +1,19 @@
 package handler
 
 import (
+	"context"
 	"errors"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
+	"time"
 
 	mock_handler "github.com/casnerano/seckeep/internal/server/http/handler/mock"
+	"github.com/casnerano/seckeep/internal/server/http/middleware"
 	"github.com/casnerano/seckeep/internal/server/model"
 	"github.com/casnerano/seckeep/internal/server/service/account"
+	"github.com/casnerano/seckeep/pkg/http/simple"
 	"github.com/casnerano/seckeep/pkg/log"
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/suite"
@@ -80,6 +85,201 @@ func (s *AccountHandlerTestSuite) TestSignInHandler() {
 	})
 }
 
+func (s *AccountHandlerTestSuite) requestWithUserUUID(method, target, userUUID string) *http.Request {
+	request := httptest.NewRequest(method, target, nil)
+	ctx := context.WithValue(request.Context(), middleware.CtxUserUUIDKey, userUUID)
+	return request.WithContext(ctx)
+}
+
+func (s *AccountHandlerTestSuite) TestChangePasswordHandler() {
+	userUUID := "ba3cfc2c-f7fd-11ed-b67e-0242ac120002"
+	rd := model.UserChangePasswordRequest{
+		OldPassword: "ur3G28u%3fD",
+		NewPassword: "n3w-Pa$$word",
+	}
+
+	s.Run("Correct old password", func() {
+		s.accountService.EXPECT().ChangePassword(gomock.Any(), userUUID, rd.OldPassword, rd.NewPassword).Return("eyJhbGci.e30.Et9HFtf9R3GEM", nil)
+
+		w := httptest.NewRecorder()
+		_, status := s.handler.ChangePassword(rd, w, s.requestWithUserUUID(http.MethodPut, "/api/user/password", userUUID))
+
+		s.Equal(http.StatusOK, status)
+		s.Equal("Bearer eyJhbGci.e30.Et9HFtf9R3GEM", w.Header().Get("Authorization"))
+	})
+
+	s.Run("Incorrect old password", func() {
+		s.accountService.EXPECT().ChangePassword(gomock.Any(), userUUID, rd.OldPassword, rd.NewPassword).Return("", account.ErrIncorrectCredentials)
+		_, status := s.handler.ChangePassword(rd, httptest.NewRecorder(), s.requestWithUserUUID(http.MethodPut, "/api/user/password", userUUID))
+		s.Equal(http.StatusForbidden, status)
+	})
+
+	s.Run("Without user UUID", func() {
+		_, status := s.handler.ChangePassword(rd, httptest.NewRecorder(), httptest.NewRequest(http.MethodPut, "/api/user/password", nil))
+		s.Equal(http.StatusUnauthorized, status)
+	})
+}
+
+func (s *AccountHandlerTestSuite) TestSignOutHandler() {
+	userUUID := "ba3cfc2c-f7fd-11ed-b67e-0242ac120002"
+	sessionID := "8f1b0f5c3c2a4d7e9b6a1c0d2e3f4a5b"
+
+	request := s.requestWithUserUUID(http.MethodPost, "/api/user/logout", userUUID)
+	request = request.WithContext(context.WithValue(request.Context(), middleware.CtxSessionIDKey, sessionID))
+
+	s.Run("Revoke session", func() {
+		s.accountService.EXPECT().SignOut(gomock.Any(), userUUID, sessionID).Return(nil)
+		_, status := s.handler.SignOut(httptest.NewRecorder(), request)
+		s.Equal(http.StatusOK, status)
+	})
+
+	s.Run("Unknown error returning", func() {
+		s.accountService.EXPECT().SignOut(gomock.Any(), userUUID, sessionID).Return(errors.New("unknown error"))
+		_, status := s.handler.SignOut(httptest.NewRecorder(), request)
+		s.Equal(http.StatusInternalServerError, status)
+	})
+
+	s.Run("Without session", func() {
+		_, status := s.handler.SignOut(httptest.NewRecorder(), s.requestWithUserUUID(http.MethodPost, "/api/user/logout", userUUID))
+		s.Equal(http.StatusUnauthorized, status)
+	})
+}
+
+func (s *AccountHandlerTestSuite) TestDeleteHandler() {
+	userUUID := "ba3cfc2c-f7fd-11ed-b67e-0242ac120002"
+	rd := model.UserDeleteRequest{Password: "ur3G28u%3fD"}
+
+	s.Run("Correct password", func() {
+		s.accountService.EXPECT().Delete(gomock.Any(), userUUID, rd.Password).Return(nil)
+		_, status := s.handler.Delete(rd, httptest.NewRecorder(), s.requestWithUserUUID(http.MethodDelete, "/api/user", userUUID))
+		s.Equal(http.StatusOK, status)
+	})
+
+	s.Run("Incorrect password", func() {
+		s.accountService.EXPECT().Delete(gomock.Any(), userUUID, rd.Password).Return(account.ErrIncorrectCredentials)
+		_, status := s.handler.Delete(rd, httptest.NewRecorder(), s.requestWithUserUUID(http.MethodDelete, "/api/user", userUUID))
+		s.Equal(http.StatusForbidden, status)
+	})
+
+	s.Run("Unknown error returning", func() {
+		s.accountService.EXPECT().Delete(gomock.Any(), userUUID, rd.Password).Return(errors.New("unknown error"))
+		_, status := s.handler.Delete(rd, httptest.NewRecorder(), s.requestWithUserUUID(http.MethodDelete, "/api/user", userUUID))
+		s.Equal(http.StatusInternalServerError, status)
+	})
+}
+
+func (s *AccountHandlerTestSuite) TestPasswordGuessingLockout() {
+	config := middleware.RateLimiterConfig{
+		IP:      middleware.Limit{Burst: 100, Interval: time.Second},
+		Login:   middleware.Limit{Burst: 100, Interval: time.Second},
+		Lockout: middleware.Lockout{Threshold: 2, BaseDelay: time.Minute, MaxDelay: time.Hour},
+	}
+
+	request := func(handler http.Handler, method, target, userUUID, body string) int {
+		r := httptest.NewRequest(method, target, strings.NewReader(body))
+		r.RemoteAddr = "192.0.2.1:1234"
+		w := httptest.NewRecorder()
+		handler.ServeHTTP(w, r.WithContext(context.WithValue(r.Context(), middleware.CtxUserUUIDKey, userUUID)))
+		return w.Code
+	}
+
+	s.Run("Change password", func() {
+		userUUID := "ba3cfc2c-f7fd-11ed-b67e-0242ac120002"
+		handler := middleware.RateLimiter(middleware.NewMemoryRateLimitStore(), config)(simple.TypedHandler(s.handler.ChangePassword))
+		body := `{"old_password":"wrong","new_password":"n3w-Pa$$word"}`
+
+		s.accountService.EXPECT().ChangePassword(gomock.Any(), userUUID, "wrong", "n3w-Pa$$word").Return("", account.ErrIncorrectCredentials).Times(2)
+
+		s.Equal(http.StatusForbidden, request(handler, http.MethodPut, "/api/user/password", userUUID, body))
+		s.Equal(http.StatusForbidden, request(handler, http.MethodPut, "/api/user/password", userUUID, body))
+		s.Equal(http.StatusTooManyRequests, request(handler, http.MethodPut, "/api/user/password", userUUID, body))
+	})
+
+	s.Run("Delete account", func() {
+		userUUID := "ba3cfc2c-f7fd-11ed-b67e-0242ac120002"
+		otherUUID := "c41b3d2a-f7fd-11ed-b67e-0242ac120002"
+		handler := middleware.RateLimiter(middleware.NewMemoryRateLimitStore(), config)(simple.TypedHandler(s.handler.Delete))
+		body := `{"password":"wrong"}`
+
+		s.accountService.EXPECT().Delete(gomock.Any(), userUUID, "wrong").Return(account.ErrIncorrectCredentials).Times(2)
+		s.accountService.EXPECT().Delete(gomock.Any(), otherUUID, "wrong").Return(account.ErrIncorrectCredentials)
+
+		s.Equal(http.StatusForbidden, request(handler, http.MethodDelete, "/api/user", userUUID, body))
+		s.Equal(http.StatusForbidden, request(handler, http.MethodDelete, "/api/user", userUUID, body))
+		s.Equal(http.StatusTooManyRequests, request(handler, http.MethodDelete, "/api/user", userUUID, body))
+
+		// Блокировка одного пользователя не затрагивает других.
+		s.Equal(http.StatusForbidden, request(handler, http.MethodDelete, "/api/user", otherUUID, body))
+	})
+}
+
+func (s *AccountHandlerTestSuite) TestExportHandler() {
+	userUUID := "ba3cfc2c-f7fd-11ed-b67e-0242ac120002"
+	export := &model.AccountExport{User: model.UserProfile{UUID: userUUID, Login: "ivan"}}
+
+	s.Run("Existing user", func() {
+		s.accountService.EXPECT().Export(gomock.Any(), userUUID).Return(export, nil)
+		result, status := s.handler.Export(httptest.NewRecorder(), s.requestWithUserUUID(http.MethodGet, "/api/user/export", userUUID))
+		s.Equal(http.StatusOK, status)
+		s.Equal(export, result)
+	})
+
+	s.Run("Unknown error returning", func() {
+		s.accountService.EXPECT().Export(gomock.Any(), userUUID).Return(nil, errors.New("unknown error"))
+		_, status := s.handler.Export(httptest.NewRecorder(), s.requestWithUserUUID(http.MethodGet, "/api/user/export", userUUID))
+		s.Equal(http.StatusInternalServerError, status)
+	})
+}
+
+func (s *AccountHandlerTestSuite) TestKeysHandler() {
+	userUUID := "ba3cfc2c-f7fd-11ed-b67e-0242ac120002"
+	rd := model.UserKeysRequest{
+		EncryptionKey: []byte("0123456789abcdef0123456789abcdef"),
+		SigningKey:    []byte("fedcba9876543210fedcba9876543210"),
+		PrivateKeys:   []byte("encrypted private keys"),
+	}
+	keys := model.UserKeys{EncryptionKey: rd.EncryptionKey, SigningKey: rd.SigningKey, PrivateKeys: rd.PrivateKeys}
+
+	s.Run("Publish keys", func() {
+		s.accountService.EXPECT().SetKeys(gomock.Any(), userUUID, keys).Return(nil)
+		_, status := s.handler.SetKeys(rd, httptest.NewRecorder(), s.requestWithUserUUID(http.MethodPut, "/api/user/keys", userUUID))
+		s.Equal(http.StatusOK, status)
+	})
+
+	s.Run("Replace existing keys", func() {
+		s.accountService.EXPECT().SetKeys(gomock.Any(), userUUID, keys).Return(account.ErrKeysExist)
+		_, status := s.handler.SetKeys(rd, httptest.NewRecorder(), s.requestWithUserUUID(http.MethodPut, "/api/user/keys", userUUID))
+		s.Equal(http.StatusConflict, status)
+	})
+
+	s.Run("Keys are not published", func() {
+		s.accountService.EXPECT().Keys(gomock.Any(), userUUID).Return(nil, account.ErrKeysNotFound)
+		_, status := s.handler.Keys(httptest.NewRecorder(), s.requestWithUserUUID(http.MethodGet, "/api/user/keys", userUUID))
+		s.Equal(http.StatusNotFound, status)
+	})
+}
+
+func (s *AccountHandlerTestSuite) TestRecoverHandler() {
+	rd := model.UserRecoverRequest{
+		Login:       "ivan",
+		Verifier:    []byte("0123456789abcdef0123456789abcdef"),
+		NewPassword: "n3w-Pa$$word",
+	}
+
+	s.Run("Correct recovery key", func() {
+		s.accountService.EXPECT().Recover(gomock.Any(), rd.Login, rd.Verifier, rd.NewPassword).Return([]byte("wrapped key"), nil)
+		result, status := s.handler.Recover(rd, httptest.NewRecorder(), httptest.NewRequest(http.MethodPost, "/api/user/recover", nil))
+		s.Equal(http.StatusOK, status)
+		s.Equal(model.UserRecoverResponse{WrappedKey: []byte("wrapped key")}, result)
+	})
+
+	s.Run("Incorrect recovery key", func() {
+		s.accountService.EXPECT().Recover(gomock.Any(), rd.Login, rd.Verifier, rd.NewPassword).Return(nil, account.ErrIncorrectCredentials)
+		_, status := s.handler.Recover(rd, httptest.NewRecorder(), httptest.NewRequest(http.MethodPost, "/api/user/recover", nil))
+		s.Equal(http.StatusUnauthorized, status)
+	})
+}
+
 func TestDataTestSuite(t *testing.T) {
 	suite.Run(t, new(AccountHandlerTestSuite))
 }
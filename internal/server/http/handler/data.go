@@ -5,6 +5,7 @@ package handler
 import (
 	"context"
 	"errors"
+	"fmt"
 	"net/http"
 	"time"
 
@@ -20,13 +21,19 @@ import (
 // DataService интерфейс сервиса взаимодействия с секретными данными.
 type DataService interface {
 	Create(ctx context.Context, data smodel.Data) (*smodel.Data, error)
-	FindByUUID(ctx context.Context, userUUID, uuid string) (*smodel.Data, error)
-	FindByUserUUID(ctx context.Context, userUUID string) ([]*smodel.Data, error)
-	Update(ctx context.Context, userUUID, uuid string, value []byte, version time.Time) (*smodel.Data, error)
-	Delete(ctx context.Context, userUUID, uuid string) error
+	FindByUUID(ctx context.Context, scope model.DataScope, uuid string) (*smodel.Data, error)
+	FindByScope(ctx context.Context, scope model.DataScope) ([]*smodel.Data, error)
+	Update(ctx context.Context, scope model.DataScope, uuid string, value []byte, version time.Time) (*smodel.Data, error)
+	Delete(ctx context.Context, scope model.DataScope, uuid string) error
+	History(ctx context.Context, scope model.DataScope, uuid string) ([]*smodel.DataRevision, error)
+	Trash(ctx context.Context, scope model.DataScope) ([]*smodel.Data, error)
+	Restore(ctx context.Context, scope model.DataScope, uuid string) (*smodel.Data, error)
+	EmptyTrash(ctx context.Context, scope model.DataScope) (int64, error)
 }
 
 // Data структура обработчика взаимодействия с секретными данными.
+// Обслуживает как личные данные пользователя, так и данные общего хранилища
+// (если запрос прошел через middleware.VaultAccess).
 type Data struct {
 	service DataService
 	logger  log.Loggable
@@ -42,13 +49,14 @@ func NewData(service DataService, logger log.Loggable) *Data {
 
 // Create обработчик создания данных.
 func (d Data) Create(rd model.DataCreateRequest, w http.ResponseWriter, r *http.Request) (any, int) {
-	userUUID, ok := middleware.GetUserUUID(r.Context())
+	scope, ok := dataScope(r)
 	if !ok {
 		return nil, http.StatusUnauthorized
 	}
 
 	dt := smodel.Data{
-		UserUUID:  userUUID,
+		UserUUID:  scope.UserUUID,
+		VaultUUID: scope.VaultUUID,
 		Type:      rd.Type,
 		Value:     rd.Value,
 		Version:   rd.Version,
@@ -67,7 +75,7 @@ func (d Data) Create(rd model.DataCreateRequest, w http.ResponseWriter, r *http.
 
 // Update обработчик обновления данных по uuid.
 func (d Data) Update(rd model.DataUpdateRequest, w http.ResponseWriter, r *http.Request) (any, int) {
-	userUUID, ok := middleware.GetUserUUID(r.Context())
+	scope, ok := dataScope(r)
 	if !ok {
 		return nil, http.StatusUnauthorized
 	}
@@ -77,18 +85,18 @@ func (d Data) Update(rd model.DataUpdateRequest, w http.ResponseWriter, r *http.
 		return nil, http.StatusBadRequest
 	}
 
-	result, err := d.service.Update(r.Context(), userUUID, uuid, rd.Value, rd.Version)
+	result, err := d.service.Update(r.Context(), scope, uuid, rd.Value, rd.Version)
 	if err != nil {
 		errCtx := struct {
-			UserUUID string
-			UUID     string
-			Value    []byte
-			Version  time.Time
+			Scope   model.DataScope
+			UUID    string
+			Value   []byte
+			Version time.Time
 		}{
-			UserUUID: userUUID,
-			UUID:     uuid,
-			Value:    rd.Value,
-			Version:  rd.Version,
+			Scope:   scope,
+			UUID:    uuid,
+			Value:   rd.Value,
+			Version: rd.Version,
 		}
 		d.logger.Error("Ошибка при обновлении записи.", err.Error(), errCtx)
 		return nil, http.StatusInternalServerError
@@ -100,7 +108,7 @@ func (d Data) Update(rd model.DataUpdateRequest, w http.ResponseWriter, r *http.
 
 // Get обработчик получения данных по uuid.
 func (d Data) Get(w http.ResponseWriter, r *http.Request) (any, int) {
-	userUUID, ok := middleware.GetUserUUID(r.Context())
+	scope, ok := dataScope(r)
 	if !ok {
 		return nil, http.StatusUnauthorized
 	}
@@ -110,18 +118,18 @@ func (d Data) Get(w http.ResponseWriter, r *http.Request) (any, int) {
 		return nil, http.StatusBadRequest
 	}
 
-	result, err := d.service.FindByUUID(r.Context(), userUUID, uuid)
+	result, err := d.service.FindByUUID(r.Context(), scope, uuid)
 	if err != nil {
 		if errors.Is(err, data.ErrNotFound) {
 			return nil, http.StatusNotFound
 		}
 
 		errCtx := struct {
-			UserUUID string
-			UUID     string
+			Scope model.DataScope
+			UUID  string
 		}{
-			UserUUID: userUUID,
-			UUID:     uuid,
+			Scope: scope,
+			UUID:  uuid,
 		}
 
 		d.logger.Error("Ошибка при получении записи.", err.Error(), errCtx)
@@ -134,17 +142,17 @@ func (d Data) Get(w http.ResponseWriter, r *http.Request) (any, int) {
 
 // GetList обработчик получения списка данных.
 func (d Data) GetList(w http.ResponseWriter, r *http.Request) (any, int) {
-	userUUID, ok := middleware.GetUserUUID(r.Context())
+	scope, ok := dataScope(r)
 	if !ok {
 		return nil, http.StatusUnauthorized
 	}
 
-	result, err := d.service.FindByUserUUID(r.Context(), userUUID)
+	result, err := d.service.FindByScope(r.Context(), scope)
 	if err != nil {
 		errCtx := struct {
-			UserUUID string
+			Scope model.DataScope
 		}{
-			UserUUID: userUUID,
+			Scope: scope,
 		}
 		d.logger.Error("Ошибка при получении списка записей.", err.Error(), errCtx)
 		return nil, http.StatusInternalServerError
@@ -154,9 +162,119 @@ func (d Data) GetList(w http.ResponseWriter, r *http.Request) (any, int) {
 	return result, http.StatusOK
 }
 
-// Delete обработчик удаления данных по uuid.
+// History обработчик получения ревизий данных по uuid.
+func (d Data) History(w http.ResponseWriter, r *http.Request) (any, int) {
+	scope, ok := dataScope(r)
+	if !ok {
+		return nil, http.StatusUnauthorized
+	}
+
+	uuid := chi.URLParam(r, "uuid")
+	if uuid == "" {
+		return nil, http.StatusBadRequest
+	}
+
+	result, err := d.service.History(r.Context(), scope, uuid)
+	if err != nil {
+		if errors.Is(err, data.ErrNotFound) {
+			return nil, http.StatusNotFound
+		}
+
+		errCtx := struct {
+			Scope model.DataScope
+			UUID  string
+		}{
+			Scope: scope,
+			UUID:  uuid,
+		}
+		d.logger.Error("Ошибка при получении истории записи.", err.Error(), errCtx)
+		return nil, http.StatusInternalServerError
+	}
+
+	d.logger.Info("История записи успешно получена.")
+	return result, http.StatusOK
+}
+
+// Trash обработчик получения списка данных в корзине.
+func (d Data) Trash(w http.ResponseWriter, r *http.Request) (any, int) {
+	scope, ok := dataScope(r)
+	if !ok {
+		return nil, http.StatusUnauthorized
+	}
+
+	result, err := d.service.Trash(r.Context(), scope)
+	if err != nil {
+		errCtx := struct {
+			Scope model.DataScope
+		}{
+			Scope: scope,
+		}
+		d.logger.Error("Ошибка при получении списка записей корзины.", err.Error(), errCtx)
+		return nil, http.StatusInternalServerError
+	}
+
+	d.logger.Info("Список записей корзины успешно получен.")
+	return result, http.StatusOK
+}
+
+// Restore обработчик восстановления данных из корзины по uuid.
+func (d Data) Restore(w http.ResponseWriter, r *http.Request) (any, int) {
+	scope, ok := dataScope(r)
+	if !ok {
+		return nil, http.StatusUnauthorized
+	}
+
+	uuid := chi.URLParam(r, "uuid")
+	if uuid == "" {
+		return nil, http.StatusBadRequest
+	}
+
+	result, err := d.service.Restore(r.Context(), scope, uuid)
+	if err != nil {
+		if errors.Is(err, data.ErrNotFound) {
+			return nil, http.StatusNotFound
+		}
+
+		errCtx := struct {
+			Scope model.DataScope
+			UUID  string
+		}{
+			Scope: scope,
+			UUID:  uuid,
+		}
+		d.logger.Error("Ошибка при восстановлении записи из корзины.", err.Error(), errCtx)
+		return nil, http.StatusInternalServerError
+	}
+
+	d.logger.Info("Запись успешно восстановлена из корзины.", result)
+	return result, http.StatusOK
+}
+
+// EmptyTrash обработчик очистки корзины.
+func (d Data) EmptyTrash(w http.ResponseWriter, r *http.Request) (any, int) {
+	scope, ok := dataScope(r)
+	if !ok {
+		return nil, http.StatusUnauthorized
+	}
+
+	deleted, err := d.service.EmptyTrash(r.Context(), scope)
+	if err != nil {
+		errCtx := struct {
+			Scope model.DataScope
+		}{
+			Scope: scope,
+		}
+		d.logger.Error("Ошибка при очистке корзины.", err.Error(), errCtx)
+		return nil, http.StatusInternalServerError
+	}
+
+	d.logger.Info(fmt.Sprintf("Корзина очищена, удалено записей: %d", deleted))
+	return nil, http.StatusOK
+}
+
+// Delete обработчик перемещения данных в корзину по uuid.
 func (d Data) Delete(w http.ResponseWriter, r *http.Request) (any, int) {
-	userUUID, ok := middleware.GetUserUUID(r.Context())
+	scope, ok := dataScope(r)
 	if !ok {
 		return nil, http.StatusUnauthorized
 	}
@@ -166,18 +284,18 @@ func (d Data) Delete(w http.ResponseWriter, r *http.Request) (any, int) {
 		return nil, http.StatusBadRequest
 	}
 
-	err := d.service.Delete(r.Context(), userUUID, uuid)
+	err := d.service.Delete(r.Context(), scope, uuid)
 	if err != nil {
 		if errors.Is(err, data.ErrNotFound) {
 			return nil, http.StatusNotFound
 		}
 
 		errCtx := struct {
-			UserUUID string
-			UUID     string
+			Scope model.DataScope
+			UUID  string
 		}{
-			UserUUID: userUUID,
-			UUID:     uuid,
+			Scope: scope,
+			UUID:  uuid,
 		}
 		d.logger.Error("Ошибка при удалении записи.", err.Error(), errCtx)
 		return nil, http.StatusInternalServerError
@@ -186,3 +304,15 @@ func (d Data) Delete(w http.ResponseWriter, r *http.Request) (any, int) {
 	d.logger.Info("Запись успешно удалена.")
 	return nil, http.StatusOK
 }
+
+// dataScope возвращает область видимости данных запроса:
+// общее хранилище (если задано в контексте), иначе — личные данные пользователя.
+func dataScope(r *http.Request) (model.DataScope, bool) {
+	userUUID, ok := middleware.GetUserUUID(r.Context())
+	if !ok {
+		return model.DataScope{}, false
+	}
+
+	vaultUUID, _ := middleware.GetVaultUUID(r.Context())
+	return model.DataScope{UserUUID: userUUID, VaultUUID: vaultUUID}, true
+}
@@ -111,7 +111,7 @@ func (s *DataHandlerTestSuite) TestUpdateHandler() {
 	requestWithDataAndUserCtx := request.WithContext(ctx)
 
 	s.Run("Correct data with user uuid", func() {
-		s.dataService.EXPECT().Update(gomock.Any(), userUUID, uuid, rd.Value, rd.Version).Return(&data, nil)
+		s.dataService.EXPECT().Update(gomock.Any(), model.DataScope{UserUUID: userUUID}, uuid, rd.Value, rd.Version).Return(&data, nil)
 		result, status := s.handler.Update(rd, httptest.NewRecorder(), requestWithDataAndUserCtx)
 
 		s.Equal(result, &data)
@@ -126,7 +126,7 @@ func (s *DataHandlerTestSuite) TestUpdateHandler() {
 	})
 
 	s.Run("Has unknown error", func() {
-		s.dataService.EXPECT().Update(gomock.Any(), userUUID, uuid, rd.Value, rd.Version).Return(nil, errors.New("unknown error"))
+		s.dataService.EXPECT().Update(gomock.Any(), model.DataScope{UserUUID: userUUID}, uuid, rd.Value, rd.Version).Return(nil, errors.New("unknown error"))
 		result, status := s.handler.Update(rd, httptest.NewRecorder(), requestWithDataAndUserCtx)
 
 		s.Nil(result)
@@ -164,7 +164,7 @@ func (s *DataHandlerTestSuite) TestGetHandler() {
 	requestWithDataAndUserCtx := request.WithContext(ctx)
 
 	s.Run("Existing data with user uuid", func() {
-		s.dataService.EXPECT().FindByUUID(gomock.Any(), userUUID, uuid).Return(&data, nil)
+		s.dataService.EXPECT().FindByUUID(gomock.Any(), model.DataScope{UserUUID: userUUID}, uuid).Return(&data, nil)
 		w := httptest.NewRecorder()
 		result, status := s.handler.Get(w, requestWithDataAndUserCtx)
 
@@ -180,7 +180,7 @@ func (s *DataHandlerTestSuite) TestGetHandler() {
 	})
 
 	s.Run("Has unknown error", func() {
-		s.dataService.EXPECT().FindByUUID(gomock.Any(), userUUID, uuid).Return(nil, errors.New("unknown error"))
+		s.dataService.EXPECT().FindByUUID(gomock.Any(), model.DataScope{UserUUID: userUUID}, uuid).Return(nil, errors.New("unknown error"))
 		result, status := s.handler.Get(httptest.NewRecorder(), requestWithDataAndUserCtx)
 
 		s.Nil(result)
@@ -195,7 +195,7 @@ func (s *DataHandlerTestSuite) TestGetHandler() {
 	})
 
 	s.Run("Non-existing data", func() {
-		s.dataService.EXPECT().FindByUUID(gomock.Any(), userUUID, uuid).Return(nil, dataService.ErrNotFound)
+		s.dataService.EXPECT().FindByUUID(gomock.Any(), model.DataScope{UserUUID: userUUID}, uuid).Return(nil, dataService.ErrNotFound)
 		result, status := s.handler.Get(httptest.NewRecorder(), requestWithDataAndUserCtx)
 
 		s.Nil(result)
@@ -228,7 +228,7 @@ func (s *DataHandlerTestSuite) TestGetListHandler() {
 	requestWithUserUUIDCtx := request.WithContext(ctx)
 
 	s.Run("Existing data with user uuid", func() {
-		s.dataService.EXPECT().FindByUserUUID(gomock.Any(), userUUID).Return(dataList, nil)
+		s.dataService.EXPECT().FindByScope(gomock.Any(), model.DataScope{UserUUID: userUUID}).Return(dataList, nil)
 		w := httptest.NewRecorder()
 		result, status := s.handler.GetList(w, requestWithUserUUIDCtx)
 
@@ -244,7 +244,7 @@ func (s *DataHandlerTestSuite) TestGetListHandler() {
 	})
 
 	s.Run("Has unknown error", func() {
-		s.dataService.EXPECT().FindByUserUUID(gomock.Any(), userUUID).Return(nil, errors.New("unknown error"))
+		s.dataService.EXPECT().FindByScope(gomock.Any(), model.DataScope{UserUUID: userUUID}).Return(nil, errors.New("unknown error"))
 		result, status := s.handler.GetList(httptest.NewRecorder(), requestWithUserUUIDCtx)
 
 		s.Nil(result)
@@ -267,7 +267,7 @@ func (s *DataHandlerTestSuite) TestDeleteHandler() {
 	requestWithDataAndUserCtx := request.WithContext(ctx)
 
 	s.Run("Correct data with user uuid", func() {
-		s.dataService.EXPECT().Delete(gomock.Any(), userUUID, uuid).Return(nil)
+		s.dataService.EXPECT().Delete(gomock.Any(), model.DataScope{UserUUID: userUUID}, uuid).Return(nil)
 		result, status := s.handler.Delete(httptest.NewRecorder(), requestWithDataAndUserCtx)
 
 		s.Nil(result)
@@ -282,7 +282,7 @@ func (s *DataHandlerTestSuite) TestDeleteHandler() {
 	})
 
 	s.Run("Has unknown error", func() {
-		s.dataService.EXPECT().Delete(gomock.Any(), userUUID, uuid).Return(errors.New("unknown error"))
+		s.dataService.EXPECT().Delete(gomock.Any(), model.DataScope{UserUUID: userUUID}, uuid).Return(errors.New("unknown error"))
 		result, status := s.handler.Delete(httptest.NewRecorder(), requestWithDataAndUserCtx)
 
 		s.Nil(result)
@@ -297,7 +297,7 @@ func (s *DataHandlerTestSuite) TestDeleteHandler() {
 	})
 
 	s.Run("Non-existing data", func() {
-		s.dataService.EXPECT().Delete(gomock.Any(), userUUID, uuid).Return(dataService.ErrNotFound)
+		s.dataService.EXPECT().Delete(gomock.Any(), model.DataScope{UserUUID: userUUID}, uuid).Return(dataService.ErrNotFound)
 		result, status := s.handler.Delete(httptest.NewRecorder(), requestWithDataAndUserCtx)
 
 		s.Nil(result)
@@ -305,6 +305,169 @@ func (s *DataHandlerTestSuite) TestDeleteHandler() {
 	})
 }
 
+func (s *DataHandlerTestSuite) TestHistoryHandler() {
+	uuid := "9b92672a-f7fe-11ed-b67e-0242ac120002"
+	userUUID := "ba3cfc2c-f7fd-11ed-b67e-0242ac120002"
+	revisions := []*smodel.DataRevision{
+		{Revision: 2, Value: []byte("2"), Version: time.Now(), CreatedAt: time.Now()},
+		{Revision: 1, Value: []byte("1"), Version: time.Now(), CreatedAt: time.Now()},
+	}
+
+	request := httptest.NewRequest(http.MethodGet, "/api/data/"+uuid+"/history", nil)
+	ctx := context.WithValue(request.Context(), middleware.CtxUserUUIDKey, userUUID)
+	requestWithUserUUIDCtx := request.WithContext(ctx)
+
+	chiCtx := chi.NewRouteContext()
+	chiCtx.URLParams.Add("uuid", uuid)
+
+	ctx = context.WithValue(requestWithUserUUIDCtx.Context(), chi.RouteCtxKey, chiCtx)
+	requestWithDataAndUserCtx := request.WithContext(ctx)
+
+	s.Run("Correct data with user uuid", func() {
+		s.dataService.EXPECT().History(gomock.Any(), model.DataScope{UserUUID: userUUID}, uuid).Return(revisions, nil)
+		result, status := s.handler.History(httptest.NewRecorder(), requestWithDataAndUserCtx)
+
+		s.Equal(revisions, result)
+		s.Equal(http.StatusOK, status)
+	})
+
+	s.Run("Without user uuid", func() {
+		result, status := s.handler.History(httptest.NewRecorder(), request)
+
+		s.Nil(result)
+		s.Equal(http.StatusUnauthorized, status)
+	})
+
+	s.Run("Without data uuid", func() {
+		result, status := s.handler.History(httptest.NewRecorder(), requestWithUserUUIDCtx)
+
+		s.Nil(result)
+		s.Equal(http.StatusBadRequest, status)
+	})
+
+	s.Run("Non-existing data", func() {
+		s.dataService.EXPECT().History(gomock.Any(), model.DataScope{UserUUID: userUUID}, uuid).Return(nil, dataService.ErrNotFound)
+		result, status := s.handler.History(httptest.NewRecorder(), requestWithDataAndUserCtx)
+
+		s.Nil(result)
+		s.Equal(http.StatusNotFound, status)
+	})
+
+	s.Run("Has unknown error", func() {
+		s.dataService.EXPECT().History(gomock.Any(), model.DataScope{UserUUID: userUUID}, uuid).Return(nil, errors.New("unknown error"))
+		result, status := s.handler.History(httptest.NewRecorder(), requestWithDataAndUserCtx)
+
+		s.Nil(result)
+		s.Equal(http.StatusInternalServerError, status)
+	})
+}
+
+func (s *DataHandlerTestSuite) TestTrashHandlers() {
+	uuid := "9b92672a-f7fe-11ed-b67e-0242ac120002"
+	userUUID := "ba3cfc2c-f7fd-11ed-b67e-0242ac120002"
+	scope := model.DataScope{UserUUID: userUUID}
+	deletedAt := time.Now()
+	trashed := &smodel.Data{UUID: uuid, UserUUID: userUUID, Type: smodel.DataTypeText, DeletedAt: &deletedAt}
+
+	request := httptest.NewRequest(http.MethodGet, "/api/data/trash", nil)
+	ctx := context.WithValue(request.Context(), middleware.CtxUserUUIDKey, userUUID)
+	requestWithUserUUIDCtx := request.WithContext(ctx)
+
+	chiCtx := chi.NewRouteContext()
+	chiCtx.URLParams.Add("uuid", uuid)
+	requestWithDataAndUserCtx := request.WithContext(context.WithValue(ctx, chi.RouteCtxKey, chiCtx))
+
+	s.Run("Trash list", func() {
+		s.dataService.EXPECT().Trash(gomock.Any(), scope).Return([]*smodel.Data{trashed}, nil)
+		result, status := s.handler.Trash(httptest.NewRecorder(), requestWithUserUUIDCtx)
+
+		s.Equal([]*smodel.Data{trashed}, result)
+		s.Equal(http.StatusOK, status)
+	})
+
+	s.Run("Trash list without user uuid", func() {
+		result, status := s.handler.Trash(httptest.NewRecorder(), request)
+
+		s.Nil(result)
+		s.Equal(http.StatusUnauthorized, status)
+	})
+
+	s.Run("Restore", func() {
+		restored := &smodel.Data{UUID: uuid, UserUUID: userUUID, Type: smodel.DataTypeText}
+		s.dataService.EXPECT().Restore(gomock.Any(), scope, uuid).Return(restored, nil)
+		result, status := s.handler.Restore(httptest.NewRecorder(), requestWithDataAndUserCtx)
+
+		s.Equal(restored, result)
+		s.Equal(http.StatusOK, status)
+	})
+
+	s.Run("Restore non-trashed data", func() {
+		s.dataService.EXPECT().Restore(gomock.Any(), scope, uuid).Return(nil, dataService.ErrNotFound)
+		result, status := s.handler.Restore(httptest.NewRecorder(), requestWithDataAndUserCtx)
+
+		s.Nil(result)
+		s.Equal(http.StatusNotFound, status)
+	})
+
+	s.Run("Restore without data uuid", func() {
+		result, status := s.handler.Restore(httptest.NewRecorder(), requestWithUserUUIDCtx)
+
+		s.Nil(result)
+		s.Equal(http.StatusBadRequest, status)
+	})
+
+	s.Run("Empty trash", func() {
+		s.dataService.EXPECT().EmptyTrash(gomock.Any(), scope).Return(int64(2), nil)
+		result, status := s.handler.EmptyTrash(httptest.NewRecorder(), requestWithUserUUIDCtx)
+
+		s.Nil(result)
+		s.Equal(http.StatusOK, status)
+	})
+
+	s.Run("Empty trash with unknown error", func() {
+		s.dataService.EXPECT().EmptyTrash(gomock.Any(), scope).Return(int64(0), errors.New("unknown error"))
+		result, status := s.handler.EmptyTrash(httptest.NewRecorder(), requestWithUserUUIDCtx)
+
+		s.Nil(result)
+		s.Equal(http.StatusInternalServerError, status)
+	})
+}
+
+func (s *DataHandlerTestSuite) TestVaultScope() {
+	userUUID := "ba3cfc2c-f7fd-11ed-b67e-0242ac120002"
+	vaultUUID := "4c9f2a1e-0a6b-4d3c-8e2f-1a2b3c4d5e6f"
+	scope := model.DataScope{UserUUID: userUUID, VaultUUID: vaultUUID}
+
+	request := httptest.NewRequest(http.MethodGet, "/api/vaults/"+vaultUUID+"/data", nil)
+	ctx := context.WithValue(request.Context(), middleware.CtxUserUUIDKey, userUUID)
+	ctx = context.WithValue(ctx, middleware.CtxVaultUUIDKey, vaultUUID)
+	request = request.WithContext(ctx)
+
+	s.Run("Create in vault", func() {
+		rd := model.DataCreateRequest{Type: smodel.DataTypeText, Value: []byte("1"), Version: time.Now(), CreatedAt: time.Now()}
+		data := smodel.Data{
+			UserUUID:  userUUID,
+			VaultUUID: vaultUUID,
+			Type:      rd.Type,
+			Value:     rd.Value,
+			Version:   rd.Version,
+			CreatedAt: rd.CreatedAt,
+		}
+
+		s.dataService.EXPECT().Create(gomock.Any(), data).Return(&data, nil)
+
+		_, status := s.handler.Create(rd, httptest.NewRecorder(), request)
+		s.Equal(http.StatusOK, status)
+	})
+
+	s.Run("List of vault", func() {
+		s.dataService.EXPECT().FindByScope(gomock.Any(), scope).Return([]*smodel.Data{}, nil)
+
+		_, status := s.handler.GetList(httptest.NewRecorder(), request)
+		s.Equal(http.StatusOK, status)
+	})
+}
+
 func TestDataHandlerTestSuite(t *testing.T) {
 	suite.Run(t, new(DataHandlerTestSuite))
 }
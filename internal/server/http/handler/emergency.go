@@ -0,0 +1,194 @@
+package handler
+
+//go:generate mockgen -destination=mock/emergency.go -source=emergency.go
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/casnerano/seckeep/internal/server/http/middleware"
+	"github.com/casnerano/seckeep/internal/server/model"
+	"github.com/casnerano/seckeep/internal/server/service/emergency"
+	"github.com/casnerano/seckeep/pkg/log"
+	"github.com/go-chi/chi/v5"
+)
+
+// EmergencyService интерфейс сервиса экстренного доступа.
+type EmergencyService interface {
+	Grant(
+		ctx context.Context,
+		ownerUUID, login string,
+		wrappedKey []byte,
+		waitPeriod time.Duration,
+	) (*model.EmergencyAccess, error)
+	Contacts(ctx context.Context, ownerUUID string) ([]*model.EmergencyAccess, error)
+	Revoke(ctx context.Context, ownerUUID, login string) error
+	Deny(ctx context.Context, ownerUUID, login string) (*model.EmergencyAccess, error)
+	Grants(ctx context.Context, contactUUID string) ([]*model.EmergencyAccess, error)
+	Request(ctx context.Context, contactUUID, login string) (*model.EmergencyAccess, error)
+	Data(ctx context.Context, contactUUID, login string) (*model.EmergencyData, error)
+}
+
+// Emergency структура обработчика экстренного доступа.
+type Emergency struct {
+	service EmergencyService
+	logger  log.Loggable
+}
+
+// NewEmergency конструктор.
+func NewEmergency(service EmergencyService, logger log.Loggable) *Emergency {
+	return &Emergency{service: service, logger: logger}
+}
+
+// Grant обработчик выдачи экстренного доступа доверенному лицу.
+func (e *Emergency) Grant(rd model.EmergencyGrantRequest, w http.ResponseWriter, r *http.Request) (any, int) {
+	userUUID, ok := middleware.GetUserUUID(r.Context())
+	if !ok {
+		return nil, http.StatusUnauthorized
+	}
+
+	waitPeriod := time.Duration(rd.WaitPeriod) * time.Second
+	result, err := e.service.Grant(r.Context(), userUUID, rd.Login, rd.WrappedKey, waitPeriod)
+	if err != nil {
+		return nil, e.errorStatus("Ошибка выдачи экстренного доступа.", err)
+	}
+
+	e.logger.Info(fmt.Sprintf("Пользователь \"%s\" выдал экстренный доступ \"%s\"", userUUID, rd.Login))
+	return result, http.StatusOK
+}
+
+// GetContacts обработчик получения списка доверенных лиц пользователя.
+func (e *Emergency) GetContacts(w http.ResponseWriter, r *http.Request) (any, int) {
+	userUUID, ok := middleware.GetUserUUID(r.Context())
+	if !ok {
+		return nil, http.StatusUnauthorized
+	}
+
+	result, err := e.service.Contacts(r.Context(), userUUID)
+	if err != nil {
+		e.logger.Error("Ошибка получения списка доверенных лиц.", err)
+		return nil, http.StatusInternalServerError
+	}
+
+	return result, http.StatusOK
+}
+
+// Revoke обработчик отзыва экстренного доступа доверенного лица.
+func (e *Emergency) Revoke(w http.ResponseWriter, r *http.Request) (any, int) {
+	userUUID, ok := middleware.GetUserUUID(r.Context())
+	if !ok {
+		return nil, http.StatusUnauthorized
+	}
+
+	login := chi.URLParam(r, "login")
+	if login == "" {
+		return nil, http.StatusBadRequest
+	}
+
+	if err := e.service.Revoke(r.Context(), userUUID, login); err != nil {
+		return nil, e.errorStatus("Ошибка отзыва экстренного доступа.", err)
+	}
+
+	e.logger.Info(fmt.Sprintf("Пользователь \"%s\" отозвал экстренный доступ \"%s\"", userUUID, login))
+	return nil, http.StatusOK
+}
+
+// Deny обработчик отклонения запроса экстренного доступа.
+func (e *Emergency) Deny(w http.ResponseWriter, r *http.Request) (any, int) {
+	userUUID, ok := middleware.GetUserUUID(r.Context())
+	if !ok {
+		return nil, http.StatusUnauthorized
+	}
+
+	login := chi.URLParam(r, "login")
+	if login == "" {
+		return nil, http.StatusBadRequest
+	}
+
+	result, err := e.service.Deny(r.Context(), userUUID, login)
+	if err != nil {
+		return nil, e.errorStatus("Ошибка отклонения запроса экстренного доступа.", err)
+	}
+
+	e.logger.Info(fmt.Sprintf("Пользователь \"%s\" отклонил запрос экстренного доступа \"%s\"", userUUID, login))
+	return result, http.StatusOK
+}
+
+// GetGrants обработчик получения списка экстренных доступов, выданных пользователю.
+func (e *Emergency) GetGrants(w http.ResponseWriter, r *http.Request) (any, int) {
+	userUUID, ok := middleware.GetUserUUID(r.Context())
+	if !ok {
+		return nil, http.StatusUnauthorized
+	}
+
+	result, err := e.service.Grants(r.Context(), userUUID)
+	if err != nil {
+		e.logger.Error("Ошибка получения списка экстренных доступов.", err)
+		return nil, http.StatusInternalServerError
+	}
+
+	return result, http.StatusOK
+}
+
+// Request обработчик запроса экстренного доступа к данным владельца.
+func (e *Emergency) Request(w http.ResponseWriter, r *http.Request) (any, int) {
+	userUUID, ok := middleware.GetUserUUID(r.Context())
+	if !ok {
+		return nil, http.StatusUnauthorized
+	}
+
+	login := chi.URLParam(r, "login")
+	if login == "" {
+		return nil, http.StatusBadRequest
+	}
+
+	result, err := e.service.Request(r.Context(), userUUID, login)
+	if err != nil {
+		return nil, e.errorStatus("Ошибка запроса экстренного доступа.", err)
+	}
+
+	e.logger.Info(fmt.Sprintf("Пользователь \"%s\" запросил экстренный доступ к данным \"%s\"", userUUID, login))
+	return result, http.StatusOK
+}
+
+// GetData обработчик получения данных владельца по открытому экстренному доступу.
+func (e *Emergency) GetData(w http.ResponseWriter, r *http.Request) (any, int) {
+	userUUID, ok := middleware.GetUserUUID(r.Context())
+	if !ok {
+		return nil, http.StatusUnauthorized
+	}
+
+	login := chi.URLParam(r, "login")
+	if login == "" {
+		return nil, http.StatusBadRequest
+	}
+
+	result, err := e.service.Data(r.Context(), userUUID, login)
+	if err != nil {
+		return nil, e.errorStatus("Ошибка получения данных по экстренному доступу.", err)
+	}
+
+	e.logger.Info(fmt.Sprintf("Пользователь \"%s\" получил данные \"%s\" по экстренному доступу", userUUID, login))
+	return result, http.StatusOK
+}
+
+// errorStatus метод возвращает код ответа для ошибки сервиса, неизвестные ошибки логируются.
+func (e *Emergency) errorStatus(message string, err error) int {
+	switch {
+	case errors.Is(err, emergency.ErrNotFound),
+		errors.Is(err, emergency.ErrUserNotFound):
+		return http.StatusNotFound
+	case errors.Is(err, emergency.ErrSelfGrant):
+		return http.StatusBadRequest
+	case errors.Is(err, emergency.ErrNotReleased):
+		return http.StatusForbidden
+	case errors.Is(err, emergency.ErrNotRequested):
+		return http.StatusConflict
+	}
+
+	e.logger.Error(message, err)
+	return http.StatusInternalServerError
+}
@@ -0,0 +1,123 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	mock_handler "github.com/casnerano/seckeep/internal/server/http/handler/mock"
+	"github.com/casnerano/seckeep/internal/server/http/middleware"
+	"github.com/casnerano/seckeep/internal/server/model"
+	"github.com/casnerano/seckeep/internal/server/service/emergency"
+	"github.com/casnerano/seckeep/pkg/log"
+	"github.com/go-chi/chi/v5"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/suite"
+)
+
+type EmergencyHandlerTestSuite struct {
+	suite.Suite
+	handler          *Emergency
+	emergencyService *mock_handler.MockEmergencyService
+}
+
+func (s *EmergencyHandlerTestSuite) SetupSuite() {
+	ctrl := gomock.NewController(s.T())
+	defer ctrl.Finish()
+
+	s.emergencyService = mock_handler.NewMockEmergencyService(ctrl)
+
+	s.handler = NewEmergency(s.emergencyService, log.NewStub())
+}
+
+func (s *EmergencyHandlerTestSuite) request(method, target, userUUID string, params map[string]string) *http.Request {
+	r := httptest.NewRequest(method, target, nil)
+	ctx := context.WithValue(r.Context(), middleware.CtxUserUUIDKey, userUUID)
+
+	rctx := chi.NewRouteContext()
+	for key, value := range params {
+		rctx.URLParams.Add(key, value)
+	}
+	ctx = context.WithValue(ctx, chi.RouteCtxKey, rctx)
+
+	return r.WithContext(ctx)
+}
+
+func (s *EmergencyHandlerTestSuite) TestGrantHandler() {
+	userUUID := "ba3cfc2c-f7fd-11ed-b67e-0242ac120002"
+	rd := model.EmergencyGrantRequest{Login: "petr", WrappedKey: []byte("wrapped key"), WaitPeriod: 86400}
+	request := s.request(http.MethodPut, "/api/emergency/contacts", userUUID, nil)
+
+	tests := []struct {
+		name   string
+		err    error
+		status int
+	}{
+		{"Success", nil, http.StatusOK},
+		{"Unknown user", emergency.ErrUserNotFound, http.StatusNotFound},
+		{"Self grant", emergency.ErrSelfGrant, http.StatusBadRequest},
+		{"Unknown error", errors.New("unknown error"), http.StatusInternalServerError},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			s.emergencyService.EXPECT().
+				Grant(gomock.Any(), userUUID, rd.Login, rd.WrappedKey, 24*time.Hour).
+				Return(&model.EmergencyAccess{}, tt.err)
+
+			_, status := s.handler.Grant(rd, httptest.NewRecorder(), request)
+			s.Equal(tt.status, status)
+		})
+	}
+}
+
+func (s *EmergencyHandlerTestSuite) TestDenyHandler() {
+	userUUID := "ba3cfc2c-f7fd-11ed-b67e-0242ac120002"
+
+	s.Run("Not requested", func() {
+		s.emergencyService.EXPECT().Deny(gomock.Any(), userUUID, "petr").Return(nil, emergency.ErrNotRequested)
+
+		request := s.request(http.MethodPost, "/api/emergency/contacts/petr/deny", userUUID, map[string]string{"login": "petr"})
+		_, status := s.handler.Deny(httptest.NewRecorder(), request)
+		s.Equal(http.StatusConflict, status)
+	})
+
+	s.Run("Without login", func() {
+		request := s.request(http.MethodPost, "/api/emergency/contacts//deny", userUUID, nil)
+		_, status := s.handler.Deny(httptest.NewRecorder(), request)
+		s.Equal(http.StatusBadRequest, status)
+	})
+}
+
+func (s *EmergencyHandlerTestSuite) TestGetDataHandler() {
+	userUUID := "ba3cfc2c-f7fd-11ed-b67e-0242ac120002"
+	request := s.request(http.MethodGet, "/api/emergency/grants/ivan/data", userUUID, map[string]string{"login": "ivan"})
+
+	s.Run("Released", func() {
+		result := &model.EmergencyData{OwnerLogin: "ivan", WrappedKey: []byte("wrapped key")}
+		s.emergencyService.EXPECT().Data(gomock.Any(), userUUID, "ivan").Return(result, nil)
+
+		got, status := s.handler.GetData(httptest.NewRecorder(), request)
+		s.Equal(http.StatusOK, status)
+		s.Equal(result, got)
+	})
+
+	s.Run("Waiting period", func() {
+		s.emergencyService.EXPECT().Data(gomock.Any(), userUUID, "ivan").Return(nil, emergency.ErrNotReleased)
+
+		_, status := s.handler.GetData(httptest.NewRecorder(), request)
+		s.Equal(http.StatusForbidden, status)
+	})
+
+	s.Run("Without user uuid", func() {
+		_, status := s.handler.GetData(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/api/emergency/grants/ivan/data", nil))
+		s.Equal(http.StatusUnauthorized, status)
+	})
+}
+
+func TestEmergencyHandlerTestSuite(t *testing.T) {
+	suite.Run(t, new(EmergencyHandlerTestSuite))
+}
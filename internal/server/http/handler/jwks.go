@@ -0,0 +1,32 @@
+package handler
+
+//go:generate mockgen -destination=mock/jwks.go -source=jwks.go
+
+import (
+	"net/http"
+
+	"github.com/casnerano/seckeep/pkg/jwtoken"
+	"github.com/casnerano/seckeep/pkg/log"
+)
+
+// KeySet интерфейс набора ключей подписи токенов.
+type KeySet interface {
+	JWKS() jwtoken.JWKS
+}
+
+// JWKS структура обработчика публикации открытых ключей.
+type JWKS struct {
+	keys   KeySet
+	logger log.Loggable
+}
+
+// NewJWKS конструктор.
+func NewJWKS(keys KeySet, logger log.Loggable) *JWKS {
+	return &JWKS{keys: keys, logger: logger}
+}
+
+// Get обработчик получения набора открытых ключей (JWKS).
+func (j *JWKS) Get(w http.ResponseWriter, r *http.Request) (any, int) {
+	w.Header().Set("Cache-Control", "public, max-age=300")
+	return j.keys.JWKS(), http.StatusOK
+}
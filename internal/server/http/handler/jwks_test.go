@@ -0,0 +1,49 @@
+package handler
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	mock_handler "github.com/casnerano/seckeep/internal/server/http/handler/mock"
+	"github.com/casnerano/seckeep/pkg/jwtoken"
+	"github.com/casnerano/seckeep/pkg/log"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/suite"
+)
+
+type JWKSHandlerTestSuite struct {
+	suite.Suite
+	handler *JWKS
+	keySet  *mock_handler.MockKeySet
+}
+
+func (s *JWKSHandlerTestSuite) SetupSuite() {
+	ctrl := gomock.NewController(s.T())
+	defer ctrl.Finish()
+
+	s.keySet = mock_handler.NewMockKeySet(ctrl)
+
+	s.handler = NewJWKS(s.keySet, log.NewStub())
+}
+
+func (s *JWKSHandlerTestSuite) TestGet() {
+	jwks := jwtoken.JWKS{
+		Keys: []jwtoken.JWK{
+			{KeyType: "OKP", KeyID: "2023-05", Use: "sig", Algorithm: "EdDSA", Curve: "Ed25519", X: "11qYAYKxCrfVS_7TyWQHOg7hcvPapiMlrwIaaPcHURo"},
+		},
+	}
+
+	s.keySet.EXPECT().JWKS().Return(jwks)
+
+	w := httptest.NewRecorder()
+	result, status := s.handler.Get(w, httptest.NewRequest(http.MethodGet, "/.well-known/jwks.json", nil))
+
+	s.Equal(http.StatusOK, status)
+	s.Equal(jwks, result)
+	s.NotEmpty(w.Header().Get("Cache-Control"))
+}
+
+func TestJWKSHandlerTestSuite(t *testing.T) {
+	suite.Run(t, new(JWKSHandlerTestSuite))
+}
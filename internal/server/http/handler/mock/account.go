@@ -8,6 +8,7 @@ import (
 	context "context"
 	reflect "reflect"
 
+	model "github.com/casnerano/seckeep/internal/server/model"
 	gomock "github.com/golang/mock/gomock"
 )
 
@@ -34,6 +35,123 @@ func (m *MockAccountService) EXPECT() *MockAccountServiceMockRecorder {
 	return m.recorder
 }
 
+// ChangePassword mocks base method.
+func (m *MockAccountService) ChangePassword(ctx context.Context, userUUID, oldPassword, newPassword string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ChangePassword", ctx, userUUID, oldPassword, newPassword)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ChangePassword indicates an expected call of ChangePassword.
+func (mr *MockAccountServiceMockRecorder) ChangePassword(ctx, userUUID, oldPassword, newPassword interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ChangePassword", reflect.TypeOf((*MockAccountService)(nil).ChangePassword), ctx, userUUID, oldPassword, newPassword)
+}
+
+// Delete mocks base method.
+func (m *MockAccountService) Delete(ctx context.Context, userUUID, password string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, userUUID, password)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockAccountServiceMockRecorder) Delete(ctx, userUUID, password interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockAccountService)(nil).Delete), ctx, userUUID, password)
+}
+
+// Export mocks base method.
+func (m *MockAccountService) Export(ctx context.Context, userUUID string) (*model.AccountExport, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Export", ctx, userUUID)
+	ret0, _ := ret[0].(*model.AccountExport)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Export indicates an expected call of Export.
+func (mr *MockAccountServiceMockRecorder) Export(ctx, userUUID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Export", reflect.TypeOf((*MockAccountService)(nil).Export), ctx, userUUID)
+}
+
+// Keys mocks base method.
+func (m *MockAccountService) Keys(ctx context.Context, userUUID string) (*model.UserKeys, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Keys", ctx, userUUID)
+	ret0, _ := ret[0].(*model.UserKeys)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Keys indicates an expected call of Keys.
+func (mr *MockAccountServiceMockRecorder) Keys(ctx, userUUID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Keys", reflect.TypeOf((*MockAccountService)(nil).Keys), ctx, userUUID)
+}
+
+// PublicKeys mocks base method.
+func (m *MockAccountService) PublicKeys(ctx context.Context, login string) (*model.UserKeys, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PublicKeys", ctx, login)
+	ret0, _ := ret[0].(*model.UserKeys)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PublicKeys indicates an expected call of PublicKeys.
+func (mr *MockAccountServiceMockRecorder) PublicKeys(ctx, login interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PublicKeys", reflect.TypeOf((*MockAccountService)(nil).PublicKeys), ctx, login)
+}
+
+// Recover mocks base method.
+func (m *MockAccountService) Recover(ctx context.Context, login string, verifier []byte, newPassword string) ([]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Recover", ctx, login, verifier, newPassword)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Recover indicates an expected call of Recover.
+func (mr *MockAccountServiceMockRecorder) Recover(ctx, login, verifier, newPassword interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Recover", reflect.TypeOf((*MockAccountService)(nil).Recover), ctx, login, verifier, newPassword)
+}
+
+// SetKeys mocks base method.
+func (m *MockAccountService) SetKeys(ctx context.Context, userUUID string, keys model.UserKeys) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetKeys", ctx, userUUID, keys)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetKeys indicates an expected call of SetKeys.
+func (mr *MockAccountServiceMockRecorder) SetKeys(ctx, userUUID, keys interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetKeys", reflect.TypeOf((*MockAccountService)(nil).SetKeys), ctx, userUUID, keys)
+}
+
+// SetRecoveryKey mocks base method.
+func (m *MockAccountService) SetRecoveryKey(ctx context.Context, userUUID string, verifier, wrappedKey []byte) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetRecoveryKey", ctx, userUUID, verifier, wrappedKey)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetRecoveryKey indicates an expected call of SetRecoveryKey.
+func (mr *MockAccountServiceMockRecorder) SetRecoveryKey(ctx, userUUID, verifier, wrappedKey interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetRecoveryKey", reflect.TypeOf((*MockAccountService)(nil).SetRecoveryKey), ctx, userUUID, verifier, wrappedKey)
+}
+
 // SignIn mocks base method.
 func (m *MockAccountService) SignIn(ctx context.Context, login, password string) (string, error) {
 	m.ctrl.T.Helper()
@@ -49,6 +167,20 @@ func (mr *MockAccountServiceMockRecorder) SignIn(ctx, login, password interface{
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SignIn", reflect.TypeOf((*MockAccountService)(nil).SignIn), ctx, login, password)
 }
 
+// SignOut mocks base method.
+func (m *MockAccountService) SignOut(ctx context.Context, userUUID, sessionID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SignOut", ctx, userUUID, sessionID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SignOut indicates an expected call of SignOut.
+func (mr *MockAccountServiceMockRecorder) SignOut(ctx, userUUID, sessionID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SignOut", reflect.TypeOf((*MockAccountService)(nil).SignOut), ctx, userUUID, sessionID)
+}
+
 // SignUp mocks base method.
 func (m *MockAccountService) SignUp(ctx context.Context, login, password, fullName string) (string, error) {
 	m.ctrl.T.Helper()
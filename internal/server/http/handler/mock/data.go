@@ -10,6 +10,7 @@ import (
 	time "time"
 
 	model "github.com/casnerano/seckeep/internal/pkg/model"
+	model0 "github.com/casnerano/seckeep/internal/server/model"
 	gomock "github.com/golang/mock/gomock"
 )
 
@@ -52,60 +53,120 @@ func (mr *MockDataServiceMockRecorder) Create(ctx, data interface{}) *gomock.Cal
 }
 
 // Delete mocks base method.
-func (m *MockDataService) Delete(ctx context.Context, userUUID, uuid string) error {
+func (m *MockDataService) Delete(ctx context.Context, scope model0.DataScope, uuid string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Delete", ctx, userUUID, uuid)
+	ret := m.ctrl.Call(m, "Delete", ctx, scope, uuid)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // Delete indicates an expected call of Delete.
-func (mr *MockDataServiceMockRecorder) Delete(ctx, userUUID, uuid interface{}) *gomock.Call {
+func (mr *MockDataServiceMockRecorder) Delete(ctx, scope, uuid interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockDataService)(nil).Delete), ctx, userUUID, uuid)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockDataService)(nil).Delete), ctx, scope, uuid)
+}
+
+// EmptyTrash mocks base method.
+func (m *MockDataService) EmptyTrash(ctx context.Context, scope model0.DataScope) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EmptyTrash", ctx, scope)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// EmptyTrash indicates an expected call of EmptyTrash.
+func (mr *MockDataServiceMockRecorder) EmptyTrash(ctx, scope interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EmptyTrash", reflect.TypeOf((*MockDataService)(nil).EmptyTrash), ctx, scope)
+}
+
+// FindByScope mocks base method.
+func (m *MockDataService) FindByScope(ctx context.Context, scope model0.DataScope) ([]*model.Data, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByScope", ctx, scope)
+	ret0, _ := ret[0].([]*model.Data)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByScope indicates an expected call of FindByScope.
+func (mr *MockDataServiceMockRecorder) FindByScope(ctx, scope interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByScope", reflect.TypeOf((*MockDataService)(nil).FindByScope), ctx, scope)
 }
 
 // FindByUUID mocks base method.
-func (m *MockDataService) FindByUUID(ctx context.Context, userUUID, uuid string) (*model.Data, error) {
+func (m *MockDataService) FindByUUID(ctx context.Context, scope model0.DataScope, uuid string) (*model.Data, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "FindByUUID", ctx, userUUID, uuid)
+	ret := m.ctrl.Call(m, "FindByUUID", ctx, scope, uuid)
 	ret0, _ := ret[0].(*model.Data)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // FindByUUID indicates an expected call of FindByUUID.
-func (mr *MockDataServiceMockRecorder) FindByUUID(ctx, userUUID, uuid interface{}) *gomock.Call {
+func (mr *MockDataServiceMockRecorder) FindByUUID(ctx, scope, uuid interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByUUID", reflect.TypeOf((*MockDataService)(nil).FindByUUID), ctx, scope, uuid)
+}
+
+// History mocks base method.
+func (m *MockDataService) History(ctx context.Context, scope model0.DataScope, uuid string) ([]*model.DataRevision, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "History", ctx, scope, uuid)
+	ret0, _ := ret[0].([]*model.DataRevision)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// History indicates an expected call of History.
+func (mr *MockDataServiceMockRecorder) History(ctx, scope, uuid interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "History", reflect.TypeOf((*MockDataService)(nil).History), ctx, scope, uuid)
+}
+
+// Restore mocks base method.
+func (m *MockDataService) Restore(ctx context.Context, scope model0.DataScope, uuid string) (*model.Data, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Restore", ctx, scope, uuid)
+	ret0, _ := ret[0].(*model.Data)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Restore indicates an expected call of Restore.
+func (mr *MockDataServiceMockRecorder) Restore(ctx, scope, uuid interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByUUID", reflect.TypeOf((*MockDataService)(nil).FindByUUID), ctx, userUUID, uuid)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Restore", reflect.TypeOf((*MockDataService)(nil).Restore), ctx, scope, uuid)
 }
 
-// FindByUserUUID mocks base method.
-func (m *MockDataService) FindByUserUUID(ctx context.Context, userUUID string) ([]*model.Data, error) {
+// Trash mocks base method.
+func (m *MockDataService) Trash(ctx context.Context, scope model0.DataScope) ([]*model.Data, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "FindByUserUUID", ctx, userUUID)
+	ret := m.ctrl.Call(m, "Trash", ctx, scope)
 	ret0, _ := ret[0].([]*model.Data)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// FindByUserUUID indicates an expected call of FindByUserUUID.
-func (mr *MockDataServiceMockRecorder) FindByUserUUID(ctx, userUUID interface{}) *gomock.Call {
+// Trash indicates an expected call of Trash.
+func (mr *MockDataServiceMockRecorder) Trash(ctx, scope interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByUserUUID", reflect.TypeOf((*MockDataService)(nil).FindByUserUUID), ctx, userUUID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Trash", reflect.TypeOf((*MockDataService)(nil).Trash), ctx, scope)
 }
 
 // Update mocks base method.
-func (m *MockDataService) Update(ctx context.Context, userUUID, uuid string, value []byte, version time.Time) (*model.Data, error) {
+func (m *MockDataService) Update(ctx context.Context, scope model0.DataScope, uuid string, value []byte, version time.Time) (*model.Data, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Update", ctx, userUUID, uuid, value, version)
+	ret := m.ctrl.Call(m, "Update", ctx, scope, uuid, value, version)
 	ret0, _ := ret[0].(*model.Data)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // Update indicates an expected call of Update.
-func (mr *MockDataServiceMockRecorder) Update(ctx, userUUID, uuid, value, version interface{}) *gomock.Call {
+func (mr *MockDataServiceMockRecorder) Update(ctx, scope, uuid, value, version interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockDataService)(nil).Update), ctx, userUUID, uuid, value, version)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockDataService)(nil).Update), ctx, scope, uuid, value, version)
 }
@@ -0,0 +1,141 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: emergency.go
+
+// Package mock_handler is a generated GoMock package.
+package mock_handler
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	model "github.com/casnerano/seckeep/internal/server/model"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockEmergencyService is a mock of EmergencyService interface.
+type MockEmergencyService struct {
+	ctrl     *gomock.Controller
+	recorder *MockEmergencyServiceMockRecorder
+}
+
+// MockEmergencyServiceMockRecorder is the mock recorder for MockEmergencyService.
+type MockEmergencyServiceMockRecorder struct {
+	mock *MockEmergencyService
+}
+
+// NewMockEmergencyService creates a new mock instance.
+func NewMockEmergencyService(ctrl *gomock.Controller) *MockEmergencyService {
+	mock := &MockEmergencyService{ctrl: ctrl}
+	mock.recorder = &MockEmergencyServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockEmergencyService) EXPECT() *MockEmergencyServiceMockRecorder {
+	return m.recorder
+}
+
+// Contacts mocks base method.
+func (m *MockEmergencyService) Contacts(ctx context.Context, ownerUUID string) ([]*model.EmergencyAccess, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Contacts", ctx, ownerUUID)
+	ret0, _ := ret[0].([]*model.EmergencyAccess)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Contacts indicates an expected call of Contacts.
+func (mr *MockEmergencyServiceMockRecorder) Contacts(ctx, ownerUUID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Contacts", reflect.TypeOf((*MockEmergencyService)(nil).Contacts), ctx, ownerUUID)
+}
+
+// Data mocks base method.
+func (m *MockEmergencyService) Data(ctx context.Context, contactUUID, login string) (*model.EmergencyData, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Data", ctx, contactUUID, login)
+	ret0, _ := ret[0].(*model.EmergencyData)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Data indicates an expected call of Data.
+func (mr *MockEmergencyServiceMockRecorder) Data(ctx, contactUUID, login interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Data", reflect.TypeOf((*MockEmergencyService)(nil).Data), ctx, contactUUID, login)
+}
+
+// Deny mocks base method.
+func (m *MockEmergencyService) Deny(ctx context.Context, ownerUUID, login string) (*model.EmergencyAccess, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Deny", ctx, ownerUUID, login)
+	ret0, _ := ret[0].(*model.EmergencyAccess)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Deny indicates an expected call of Deny.
+func (mr *MockEmergencyServiceMockRecorder) Deny(ctx, ownerUUID, login interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Deny", reflect.TypeOf((*MockEmergencyService)(nil).Deny), ctx, ownerUUID, login)
+}
+
+// Grant mocks base method.
+func (m *MockEmergencyService) Grant(ctx context.Context, ownerUUID, login string, wrappedKey []byte, waitPeriod time.Duration) (*model.EmergencyAccess, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Grant", ctx, ownerUUID, login, wrappedKey, waitPeriod)
+	ret0, _ := ret[0].(*model.EmergencyAccess)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Grant indicates an expected call of Grant.
+func (mr *MockEmergencyServiceMockRecorder) Grant(ctx, ownerUUID, login, wrappedKey, waitPeriod interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Grant", reflect.TypeOf((*MockEmergencyService)(nil).Grant), ctx, ownerUUID, login, wrappedKey, waitPeriod)
+}
+
+// Grants mocks base method.
+func (m *MockEmergencyService) Grants(ctx context.Context, contactUUID string) ([]*model.EmergencyAccess, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Grants", ctx, contactUUID)
+	ret0, _ := ret[0].([]*model.EmergencyAccess)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Grants indicates an expected call of Grants.
+func (mr *MockEmergencyServiceMockRecorder) Grants(ctx, contactUUID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Grants", reflect.TypeOf((*MockEmergencyService)(nil).Grants), ctx, contactUUID)
+}
+
+// Request mocks base method.
+func (m *MockEmergencyService) Request(ctx context.Context, contactUUID, login string) (*model.EmergencyAccess, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Request", ctx, contactUUID, login)
+	ret0, _ := ret[0].(*model.EmergencyAccess)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Request indicates an expected call of Request.
+func (mr *MockEmergencyServiceMockRecorder) Request(ctx, contactUUID, login interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Request", reflect.TypeOf((*MockEmergencyService)(nil).Request), ctx, contactUUID, login)
+}
+
+// Revoke mocks base method.
+func (m *MockEmergencyService) Revoke(ctx context.Context, ownerUUID, login string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Revoke", ctx, ownerUUID, login)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Revoke indicates an expected call of Revoke.
+func (mr *MockEmergencyServiceMockRecorder) Revoke(ctx, ownerUUID, login interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Revoke", reflect.TypeOf((*MockEmergencyService)(nil).Revoke), ctx, ownerUUID, login)
+}
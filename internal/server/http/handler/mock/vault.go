@@ -0,0 +1,124 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: vault.go
+
+// Package mock_handler is a generated GoMock package.
+package mock_handler
+
+import (
+	context "context"
+	reflect "reflect"
+
+	model "github.com/casnerano/seckeep/internal/server/model"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockVaultService is a mock of VaultService interface.
+type MockVaultService struct {
+	ctrl     *gomock.Controller
+	recorder *MockVaultServiceMockRecorder
+}
+
+// MockVaultServiceMockRecorder is the mock recorder for MockVaultService.
+type MockVaultServiceMockRecorder struct {
+	mock *MockVaultService
+}
+
+// NewMockVaultService creates a new mock instance.
+func NewMockVaultService(ctrl *gomock.Controller) *MockVaultService {
+	mock := &MockVaultService{ctrl: ctrl}
+	mock.recorder = &MockVaultServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockVaultService) EXPECT() *MockVaultServiceMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockVaultService) Create(ctx context.Context, userUUID, name string, wrappedKey []byte) (*model.Vault, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, userUUID, name, wrappedKey)
+	ret0, _ := ret[0].(*model.Vault)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockVaultServiceMockRecorder) Create(ctx, userUUID, name, wrappedKey interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockVaultService)(nil).Create), ctx, userUUID, name, wrappedKey)
+}
+
+// FindByUserUUID mocks base method.
+func (m *MockVaultService) FindByUserUUID(ctx context.Context, userUUID string) ([]*model.Vault, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByUserUUID", ctx, userUUID)
+	ret0, _ := ret[0].([]*model.Vault)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByUserUUID indicates an expected call of FindByUserUUID.
+func (mr *MockVaultServiceMockRecorder) FindByUserUUID(ctx, userUUID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByUserUUID", reflect.TypeOf((*MockVaultService)(nil).FindByUserUUID), ctx, userUUID)
+}
+
+// Members mocks base method.
+func (m *MockVaultService) Members(ctx context.Context, userUUID, vaultUUID string) ([]*model.VaultMember, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Members", ctx, userUUID, vaultUUID)
+	ret0, _ := ret[0].([]*model.VaultMember)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Members indicates an expected call of Members.
+func (mr *MockVaultServiceMockRecorder) Members(ctx, userUUID, vaultUUID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Members", reflect.TypeOf((*MockVaultService)(nil).Members), ctx, userUUID, vaultUUID)
+}
+
+// RemoveMember mocks base method.
+func (m *MockVaultService) RemoveMember(ctx context.Context, userUUID, vaultUUID, login string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveMember", ctx, userUUID, vaultUUID, login)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveMember indicates an expected call of RemoveMember.
+func (mr *MockVaultServiceMockRecorder) RemoveMember(ctx, userUUID, vaultUUID, login interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveMember", reflect.TypeOf((*MockVaultService)(nil).RemoveMember), ctx, userUUID, vaultUUID, login)
+}
+
+// SaveMember mocks base method.
+func (m *MockVaultService) SaveMember(ctx context.Context, userUUID, vaultUUID, login string, role model.VaultRole, wrappedKey []byte) (*model.VaultMember, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveMember", ctx, userUUID, vaultUUID, login, role, wrappedKey)
+	ret0, _ := ret[0].(*model.VaultMember)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SaveMember indicates an expected call of SaveMember.
+func (mr *MockVaultServiceMockRecorder) SaveMember(ctx, userUUID, vaultUUID, login, role, wrappedKey interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveMember", reflect.TypeOf((*MockVaultService)(nil).SaveMember), ctx, userUUID, vaultUUID, login, role, wrappedKey)
+}
+
+// SetWrappedKey mocks base method.
+func (m *MockVaultService) SetWrappedKey(ctx context.Context, userUUID, vaultUUID string, wrappedKey []byte) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetWrappedKey", ctx, userUUID, vaultUUID, wrappedKey)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetWrappedKey indicates an expected call of SetWrappedKey.
+func (mr *MockVaultServiceMockRecorder) SetWrappedKey(ctx, userUUID, vaultUUID, wrappedKey interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetWrappedKey", reflect.TypeOf((*MockVaultService)(nil).SetWrappedKey), ctx, userUUID, vaultUUID, wrappedKey)
+}
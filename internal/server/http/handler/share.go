@@ -0,0 +1,71 @@
+package handler
+
+//go:generate mockgen -destination=mock/share.go -source=share.go
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/casnerano/seckeep/internal/server/http/middleware"
+	"github.com/casnerano/seckeep/internal/server/model"
+	"github.com/casnerano/seckeep/internal/server/service/share"
+	"github.com/casnerano/seckeep/pkg/log"
+	"github.com/go-chi/chi/v5"
+)
+
+// ShareService интерфейс сервиса ссылок на секреты.
+type ShareService interface {
+	Create(ctx context.Context, userUUID string, value []byte, ttl time.Duration, views int) (*model.Share, error)
+	Open(ctx context.Context, uuid string) (*model.Share, error)
+}
+
+// Share структура обработчика ссылок на секреты.
+type Share struct {
+	service ShareService
+	logger  log.Loggable
+}
+
+// NewShare конструктор.
+func NewShare(service ShareService, logger log.Loggable) *Share {
+	return &Share{service: service, logger: logger}
+}
+
+// Create обработчик создания ссылки на секрет.
+func (s *Share) Create(rd model.ShareCreateRequest, w http.ResponseWriter, r *http.Request) (any, int) {
+	userUUID, ok := middleware.GetUserUUID(r.Context())
+	if !ok {
+		return nil, http.StatusUnauthorized
+	}
+
+	result, err := s.service.Create(r.Context(), userUUID, rd.Value, time.Duration(rd.TTL)*time.Second, rd.Views)
+	if err != nil {
+		s.logger.Error("Ошибка создания ссылки на секрет.", err)
+		return nil, http.StatusInternalServerError
+	}
+
+	s.logger.Info(fmt.Sprintf("Пользователь \"%s\" создал ссылку на секрет \"%s\"", userUUID, result.UUID))
+	return result, http.StatusOK
+}
+
+// Open обработчик просмотра секрета по ссылке, доступен без авторизации.
+func (s *Share) Open(w http.ResponseWriter, r *http.Request) (any, int) {
+	uuid := chi.URLParam(r, "share")
+	if uuid == "" {
+		return nil, http.StatusBadRequest
+	}
+
+	result, err := s.service.Open(r.Context(), uuid)
+	if err != nil {
+		if errors.Is(err, share.ErrNotFound) {
+			return nil, http.StatusNotFound
+		}
+
+		s.logger.Error("Ошибка открытия ссылки на секрет.", err)
+		return nil, http.StatusInternalServerError
+	}
+
+	return result, http.StatusOK
+}
@@ -0,0 +1,87 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	mock_handler "github.com/casnerano/seckeep/internal/server/http/handler/mock"
+	"github.com/casnerano/seckeep/internal/server/http/middleware"
+	"github.com/casnerano/seckeep/internal/server/model"
+	"github.com/casnerano/seckeep/internal/server/service/share"
+	"github.com/casnerano/seckeep/pkg/log"
+	"github.com/go-chi/chi/v5"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/suite"
+)
+
+type ShareHandlerTestSuite struct {
+	suite.Suite
+	handler      *Share
+	shareService *mock_handler.MockShareService
+}
+
+func (s *ShareHandlerTestSuite) SetupSuite() {
+	ctrl := gomock.NewController(s.T())
+	defer ctrl.Finish()
+
+	s.shareService = mock_handler.NewMockShareService(ctrl)
+
+	s.handler = NewShare(s.shareService, log.NewStub())
+}
+
+func (s *ShareHandlerTestSuite) TestCreateHandler() {
+	userUUID := "ba3cfc2c-f7fd-11ed-b67e-0242ac120002"
+	rd := model.ShareCreateRequest{Value: []byte("ciphertext"), TTL: 3600, Views: 1}
+
+	s.Run("Authorized user", func() {
+		s.shareService.EXPECT().Create(gomock.Any(), userUUID, rd.Value, time.Hour, 1).Return(&model.Share{UUID: "0b8e5c1a"}, nil)
+
+		r := httptest.NewRequest(http.MethodPost, "/api/shares", nil)
+		r = r.WithContext(context.WithValue(r.Context(), middleware.CtxUserUUIDKey, userUUID))
+
+		_, status := s.handler.Create(rd, httptest.NewRecorder(), r)
+		s.Equal(http.StatusOK, status)
+	})
+
+	s.Run("Without user UUID", func() {
+		_, status := s.handler.Create(rd, httptest.NewRecorder(), httptest.NewRequest(http.MethodPost, "/api/shares", nil))
+		s.Equal(http.StatusUnauthorized, status)
+	})
+}
+
+func (s *ShareHandlerTestSuite) TestOpenHandler() {
+	uuid := "0b8e5c1a-7f3d-4e2b-9a6c-5d4e3f2a1b0c"
+
+	request := func() *http.Request {
+		r := httptest.NewRequest(http.MethodPost, "/api/shares/"+uuid+"/open", nil)
+		rctx := chi.NewRouteContext()
+		rctx.URLParams.Add("share", uuid)
+		return r.WithContext(context.WithValue(r.Context(), chi.RouteCtxKey, rctx))
+	}
+
+	s.Run("Available share", func() {
+		s.shareService.EXPECT().Open(gomock.Any(), uuid).Return(&model.Share{UUID: uuid}, nil)
+		_, status := s.handler.Open(httptest.NewRecorder(), request())
+		s.Equal(http.StatusOK, status)
+	})
+
+	s.Run("Expired share", func() {
+		s.shareService.EXPECT().Open(gomock.Any(), uuid).Return(nil, share.ErrNotFound)
+		_, status := s.handler.Open(httptest.NewRecorder(), request())
+		s.Equal(http.StatusNotFound, status)
+	})
+
+	s.Run("Unknown error returning", func() {
+		s.shareService.EXPECT().Open(gomock.Any(), uuid).Return(nil, errors.New("unknown error"))
+		_, status := s.handler.Open(httptest.NewRecorder(), request())
+		s.Equal(http.StatusInternalServerError, status)
+	})
+}
+
+func TestShareHandlerTestSuite(t *testing.T) {
+	suite.Run(t, new(ShareHandlerTestSuite))
+}
@@ -0,0 +1,173 @@
+package handler
+
+//go:generate mockgen -destination=mock/vault.go -source=vault.go
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/casnerano/seckeep/internal/server/http/middleware"
+	"github.com/casnerano/seckeep/internal/server/model"
+	"github.com/casnerano/seckeep/internal/server/service/vault"
+	"github.com/casnerano/seckeep/pkg/log"
+	"github.com/go-chi/chi/v5"
+)
+
+// VaultService интерфейс сервиса взаимодействия с общими хранилищами.
+type VaultService interface {
+	Create(ctx context.Context, userUUID, name string, wrappedKey []byte) (*model.Vault, error)
+	FindByUserUUID(ctx context.Context, userUUID string) ([]*model.Vault, error)
+	Members(ctx context.Context, userUUID, vaultUUID string) ([]*model.VaultMember, error)
+	SaveMember(
+		ctx context.Context,
+		userUUID, vaultUUID, login string,
+		role model.VaultRole,
+		wrappedKey []byte,
+	) (*model.VaultMember, error)
+	RemoveMember(ctx context.Context, userUUID, vaultUUID, login string) error
+	SetWrappedKey(ctx context.Context, userUUID, vaultUUID string, wrappedKey []byte) error
+}
+
+// Vault структура обработчика взаимодействия с общими хранилищами.
+type Vault struct {
+	service VaultService
+	logger  log.Loggable
+}
+
+// NewVault конструктор.
+func NewVault(service VaultService, logger log.Loggable) *Vault {
+	return &Vault{service: service, logger: logger}
+}
+
+// Create обработчик создания общего хранилища.
+func (v *Vault) Create(rd model.VaultCreateRequest, w http.ResponseWriter, r *http.Request) (any, int) {
+	userUUID, ok := middleware.GetUserUUID(r.Context())
+	if !ok {
+		return nil, http.StatusUnauthorized
+	}
+
+	result, err := v.service.Create(r.Context(), userUUID, rd.Name, rd.WrappedKey)
+	if err != nil {
+		v.logger.Error("Ошибка создания общего хранилища.", err)
+		return nil, http.StatusInternalServerError
+	}
+
+	v.logger.Info(fmt.Sprintf("Пользователь \"%s\" создал общее хранилище \"%s\"", userUUID, result.UUID))
+	return result, http.StatusOK
+}
+
+// GetList обработчик получения списка общих хранилищ пользователя.
+func (v *Vault) GetList(w http.ResponseWriter, r *http.Request) (any, int) {
+	userUUID, ok := middleware.GetUserUUID(r.Context())
+	if !ok {
+		return nil, http.StatusUnauthorized
+	}
+
+	result, err := v.service.FindByUserUUID(r.Context(), userUUID)
+	if err != nil {
+		v.logger.Error("Ошибка получения списка общих хранилищ.", err)
+		return nil, http.StatusInternalServerError
+	}
+
+	return result, http.StatusOK
+}
+
+// GetMembers обработчик получения списка участников общего хранилища.
+func (v *Vault) GetMembers(w http.ResponseWriter, r *http.Request) (any, int) {
+	userUUID, ok := middleware.GetUserUUID(r.Context())
+	if !ok {
+		return nil, http.StatusUnauthorized
+	}
+
+	vaultUUID := chi.URLParam(r, "vault")
+	if vaultUUID == "" {
+		return nil, http.StatusBadRequest
+	}
+
+	result, err := v.service.Members(r.Context(), userUUID, vaultUUID)
+	if err != nil {
+		return nil, v.errorStatus("Ошибка получения списка участников общего хранилища.", err)
+	}
+
+	return result, http.StatusOK
+}
+
+// SaveMember обработчик добавления участника общего хранилища (или изменения его роли).
+func (v *Vault) SaveMember(rd model.VaultMemberRequest, w http.ResponseWriter, r *http.Request) (any, int) {
+	userUUID, ok := middleware.GetUserUUID(r.Context())
+	if !ok {
+		return nil, http.StatusUnauthorized
+	}
+
+	vaultUUID := chi.URLParam(r, "vault")
+	if vaultUUID == "" {
+		return nil, http.StatusBadRequest
+	}
+
+	result, err := v.service.SaveMember(r.Context(), userUUID, vaultUUID, rd.Login, rd.Role, rd.WrappedKey)
+	if err != nil {
+		return nil, v.errorStatus("Ошибка сохранения участника общего хранилища.", err)
+	}
+
+	v.logger.Info(fmt.Sprintf("В общее хранилище \"%s\" добавлен участник \"%s\"", vaultUUID, rd.Login))
+	return result, http.StatusOK
+}
+
+// SetKey обработчик замены участником собственного зашифрованного ключа общего хранилища.
+func (v *Vault) SetKey(rd model.VaultKeyRequest, w http.ResponseWriter, r *http.Request) (any, int) {
+	userUUID, ok := middleware.GetUserUUID(r.Context())
+	if !ok {
+		return nil, http.StatusUnauthorized
+	}
+
+	vaultUUID := chi.URLParam(r, "vault")
+	if vaultUUID == "" {
+		return nil, http.StatusBadRequest
+	}
+
+	if err := v.service.SetWrappedKey(r.Context(), userUUID, vaultUUID, rd.WrappedKey); err != nil {
+		return nil, v.errorStatus("Ошибка замены ключа общего хранилища.", err)
+	}
+
+	v.logger.Info(fmt.Sprintf("Пользователь \"%s\" заменил ключ общего хранилища \"%s\"", userUUID, vaultUUID))
+	return nil, http.StatusOK
+}
+
+// DeleteMember обработчик удаления участника общего хранилища.
+func (v *Vault) DeleteMember(w http.ResponseWriter, r *http.Request) (any, int) {
+	userUUID, ok := middleware.GetUserUUID(r.Context())
+	if !ok {
+		return nil, http.StatusUnauthorized
+	}
+
+	vaultUUID, login := chi.URLParam(r, "vault"), chi.URLParam(r, "login")
+	if vaultUUID == "" || login == "" {
+		return nil, http.StatusBadRequest
+	}
+
+	if err := v.service.RemoveMember(r.Context(), userUUID, vaultUUID, login); err != nil {
+		return nil, v.errorStatus("Ошибка удаления участника общего хранилища.", err)
+	}
+
+	v.logger.Info(fmt.Sprintf("Из общего хранилища \"%s\" удален участник \"%s\"", vaultUUID, login))
+	return nil, http.StatusOK
+}
+
+// errorStatus метод возвращает код ответа для ошибки сервиса, неизвестные ошибки логируются.
+func (v *Vault) errorStatus(message string, err error) int {
+	switch {
+	case errors.Is(err, vault.ErrNotFound),
+		errors.Is(err, vault.ErrUserNotFound),
+		errors.Is(err, vault.ErrMemberNotFound):
+		return http.StatusNotFound
+	case errors.Is(err, vault.ErrForbidden):
+		return http.StatusForbidden
+	case errors.Is(err, vault.ErrLastOwner):
+		return http.StatusConflict
+	}
+
+	v.logger.Error(message, err)
+	return http.StatusInternalServerError
+}
@@ -0,0 +1,146 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	mock_handler "github.com/casnerano/seckeep/internal/server/http/handler/mock"
+	"github.com/casnerano/seckeep/internal/server/http/middleware"
+	"github.com/casnerano/seckeep/internal/server/model"
+	"github.com/casnerano/seckeep/internal/server/service/vault"
+	"github.com/casnerano/seckeep/pkg/log"
+	"github.com/go-chi/chi/v5"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/suite"
+)
+
+type VaultHandlerTestSuite struct {
+	suite.Suite
+	handler      *Vault
+	vaultService *mock_handler.MockVaultService
+}
+
+func (s *VaultHandlerTestSuite) SetupSuite() {
+	ctrl := gomock.NewController(s.T())
+	defer ctrl.Finish()
+
+	s.vaultService = mock_handler.NewMockVaultService(ctrl)
+
+	s.handler = NewVault(s.vaultService, log.NewStub())
+}
+
+func (s *VaultHandlerTestSuite) request(method, target, userUUID string, params map[string]string) *http.Request {
+	r := httptest.NewRequest(method, target, nil)
+	ctx := context.WithValue(r.Context(), middleware.CtxUserUUIDKey, userUUID)
+
+	rctx := chi.NewRouteContext()
+	for key, value := range params {
+		rctx.URLParams.Add(key, value)
+	}
+	ctx = context.WithValue(ctx, chi.RouteCtxKey, rctx)
+
+	return r.WithContext(ctx)
+}
+
+func (s *VaultHandlerTestSuite) TestCreateHandler() {
+	userUUID := "ba3cfc2c-f7fd-11ed-b67e-0242ac120002"
+	rd := model.VaultCreateRequest{Name: "Team", WrappedKey: []byte("wrapped key")}
+
+	s.Run("Success", func() {
+		result := &model.Vault{UUID: "4c9f2a1e", Name: rd.Name, Role: model.VaultRoleOwner}
+		s.vaultService.EXPECT().Create(gomock.Any(), userUUID, rd.Name, rd.WrappedKey).Return(result, nil)
+
+		got, status := s.handler.Create(rd, httptest.NewRecorder(), s.request(http.MethodPost, "/api/vaults", userUUID, nil))
+		s.Equal(http.StatusOK, status)
+		s.Equal(result, got)
+	})
+
+	s.Run("Without user uuid", func() {
+		_, status := s.handler.Create(rd, httptest.NewRecorder(), httptest.NewRequest(http.MethodPost, "/api/vaults", nil))
+		s.Equal(http.StatusUnauthorized, status)
+	})
+}
+
+func (s *VaultHandlerTestSuite) TestSaveMemberHandler() {
+	userUUID := "ba3cfc2c-f7fd-11ed-b67e-0242ac120002"
+	vaultUUID := "4c9f2a1e"
+	rd := model.VaultMemberRequest{Login: "petr", Role: model.VaultRoleViewer, WrappedKey: []byte("wrapped key")}
+	request := s.request(http.MethodPut, "/api/vaults/"+vaultUUID+"/members", userUUID, map[string]string{"vault": vaultUUID})
+
+	tests := []struct {
+		name   string
+		err    error
+		status int
+	}{
+		{"Success", nil, http.StatusOK},
+		{"Not an owner", vault.ErrForbidden, http.StatusForbidden},
+		{"Unknown user", vault.ErrUserNotFound, http.StatusNotFound},
+		{"Last owner", vault.ErrLastOwner, http.StatusConflict},
+		{"Unknown error", errors.New("unknown error"), http.StatusInternalServerError},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			s.vaultService.EXPECT().
+				SaveMember(gomock.Any(), userUUID, vaultUUID, rd.Login, rd.Role, rd.WrappedKey).
+				Return(&model.VaultMember{}, tt.err)
+
+			_, status := s.handler.SaveMember(rd, httptest.NewRecorder(), request)
+			s.Equal(tt.status, status)
+		})
+	}
+}
+
+func (s *VaultHandlerTestSuite) TestSetKeyHandler() {
+	userUUID := "ba3cfc2c-f7fd-11ed-b67e-0242ac120002"
+	vaultUUID := "4c9f2a1e"
+	rd := model.VaultKeyRequest{WrappedKey: []byte("rewrapped key")}
+	request := s.request(http.MethodPut, "/api/vaults/"+vaultUUID+"/key", userUUID, map[string]string{"vault": vaultUUID})
+
+	tests := []struct {
+		name   string
+		err    error
+		status int
+	}{
+		{"Success", nil, http.StatusOK},
+		{"Not a member", vault.ErrNotFound, http.StatusNotFound},
+		{"Unknown error", errors.New("unknown error"), http.StatusInternalServerError},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			s.vaultService.EXPECT().SetWrappedKey(gomock.Any(), userUUID, vaultUUID, rd.WrappedKey).Return(tt.err)
+
+			_, status := s.handler.SetKey(rd, httptest.NewRecorder(), request)
+			s.Equal(tt.status, status)
+		})
+	}
+}
+
+func (s *VaultHandlerTestSuite) TestDeleteMemberHandler() {
+	userUUID := "ba3cfc2c-f7fd-11ed-b67e-0242ac120002"
+	vaultUUID := "4c9f2a1e"
+
+	s.Run("Success", func() {
+		s.vaultService.EXPECT().RemoveMember(gomock.Any(), userUUID, vaultUUID, "petr").Return(nil)
+
+		request := s.request(http.MethodDelete, "/api/vaults/"+vaultUUID+"/members/petr", userUUID,
+			map[string]string{"vault": vaultUUID, "login": "petr"})
+		_, status := s.handler.DeleteMember(httptest.NewRecorder(), request)
+		s.Equal(http.StatusOK, status)
+	})
+
+	s.Run("Without login", func() {
+		request := s.request(http.MethodDelete, "/api/vaults/"+vaultUUID+"/members/", userUUID,
+			map[string]string{"vault": vaultUUID})
+		_, status := s.handler.DeleteMember(httptest.NewRecorder(), request)
+		s.Equal(http.StatusBadRequest, status)
+	})
+}
+
+func TestVaultHandlerTestSuite(t *testing.T) {
+	suite.Run(t, new(VaultHandlerTestSuite))
+}
@@ -2,19 +2,34 @@ package middleware
 
 import (
 	"context"
+	"errors"
 	"net/http"
 	"strings"
 
+	"github.com/casnerano/seckeep/internal/server/service/account"
 	"github.com/casnerano/seckeep/pkg/jwtoken"
 )
 
 type ctxUserUUIDType string
 
+type ctxSessionIDType string
+
 // CtxUserUUIDKey ключ параметра контекста для UUID пользователя.
 const CtxUserUUIDKey ctxUserUUIDType = "user_uuid"
 
+// CtxSessionIDKey ключ параметра контекста для идентификатора сессии.
+const CtxSessionIDKey ctxSessionIDType = "session_id"
+
+// SessionValidator интерфейс проверки актуальности сессии пользователя.
+type SessionValidator interface {
+	ValidateSession(ctx context.Context, payload jwtoken.Payload) error
+}
+
 // JWTAuthenticator middleware выполняет аутентификацию по JWT токену из заголовка запроса.
-func JWTAuthenticator(secret string) func(next http.Handler) http.Handler {
+// Ключ проверки подписи выбирается из набора keys по заголовку "kid" токена,
+// после чего sessions проверяет, что сессия не была отозвана.
+// Недействительная сессия отклоняется с кодом 401, ошибка проверки — с кодом 500.
+func JWTAuthenticator(keys *jwtoken.KeySet, sessions SessionValidator) func(next http.Handler) http.Handler {
 	return func(next http.Handler) http.Handler {
 		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 			parts := strings.Split(r.Header.Get("Authorization"), " ")
@@ -25,14 +40,26 @@ func JWTAuthenticator(secret string) func(next http.Handler) http.Handler {
 
 			tokenString := parts[1]
 
-			jwt := jwtoken.New()
-			payload, err := jwt.Verify(tokenString, []byte(secret))
+			jwt := jwtoken.New(keys)
+			payload, err := jwt.Verify(tokenString)
 			if err != nil {
 				w.WriteHeader(http.StatusUnauthorized)
 				return
 			}
 
+			if err = sessions.ValidateSession(r.Context(), *payload); err != nil {
+				if errors.Is(err, account.ErrSessionRevoked) ||
+					errors.Is(err, account.ErrSessionExpired) ||
+					errors.Is(err, account.ErrUserNotFound) {
+					w.WriteHeader(http.StatusUnauthorized)
+					return
+				}
+				w.WriteHeader(http.StatusInternalServerError)
+				return
+			}
+
 			ctx := context.WithValue(r.Context(), CtxUserUUIDKey, payload.UUID)
+			ctx = context.WithValue(ctx, CtxSessionIDKey, payload.Session)
 			next.ServeHTTP(w, r.WithContext(ctx))
 		})
 	}
@@ -46,3 +73,12 @@ func GetUserUUID(ctx context.Context) (string, bool) {
 	}
 	return uuid, true
 }
+
+// GetSessionID функция возвращает идентификатор сессии из заданного контекста.
+func GetSessionID(ctx context.Context) (string, bool) {
+	sessionID, ok := ctx.Value(CtxSessionIDKey).(string)
+	if !ok {
+		return "", false
+	}
+	return sessionID, true
+}
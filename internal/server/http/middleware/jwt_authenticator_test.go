@@ -0,0 +1,87 @@
+package middleware
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/casnerano/seckeep/internal/server/service/account"
+	"github.com/casnerano/seckeep/pkg/jwtoken"
+	"github.com/stretchr/testify/suite"
+)
+
+type sessionsStub map[string]error
+
+func (s sessionsStub) ValidateSession(_ context.Context, payload jwtoken.Payload) error {
+	return s[payload.UUID]
+}
+
+type JWTAuthenticatorTestSuite struct {
+	suite.Suite
+	jwt     *jwtoken.JWToken
+	handler http.Handler
+}
+
+func (s *JWTAuthenticatorTestSuite) SetupSuite() {
+	key, err := jwtoken.GenerateEd25519Key("ed25519")
+	s.Require().NoError(err)
+
+	keys, err := jwtoken.NewKeySet(key)
+	s.Require().NoError(err)
+	s.Require().NoError(keys.SetSigningKey(key.ID))
+
+	sessions := sessionsStub{
+		"revoked": account.ErrSessionRevoked,
+		"expired": account.ErrSessionExpired,
+		"deleted": account.ErrUserNotFound,
+		"failed":  errors.New("connection refused"),
+	}
+
+	s.jwt = jwtoken.New(keys)
+	s.handler = JWTAuthenticator(keys, sessions)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		userUUID, _ := GetUserUUID(r.Context())
+		_, _ = w.Write([]byte(userUUID))
+	}))
+}
+
+func (s *JWTAuthenticatorTestSuite) request(userUUID string) *httptest.ResponseRecorder {
+	token, err := s.jwt.Create(jwtoken.Payload{UUID: userUUID}, time.Minute)
+	s.Require().NoError(err)
+
+	w := httptest.NewRecorder()
+	r := httptest.NewRequest(http.MethodGet, "/api/data", nil)
+	r.Header.Set("Authorization", "Bearer "+token)
+	s.handler.ServeHTTP(w, r)
+	return w
+}
+
+func (s *JWTAuthenticatorTestSuite) TestAuthenticate() {
+	s.Run("Valid session", func() {
+		w := s.request("active")
+		s.Equal(http.StatusOK, w.Code)
+		s.Equal("active", w.Body.String())
+	})
+
+	s.Run("Invalid session", func() {
+		for _, userUUID := range []string{"revoked", "expired", "deleted"} {
+			s.Equal(http.StatusUnauthorized, s.request(userUUID).Code, userUUID)
+		}
+	})
+
+	s.Run("Validation error", func() {
+		s.Equal(http.StatusInternalServerError, s.request("failed").Code)
+	})
+
+	s.Run("Without token", func() {
+		w := httptest.NewRecorder()
+		s.handler.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/api/data", nil))
+		s.Equal(http.StatusUnauthorized, w.Code)
+	})
+}
+
+func TestJWTAuthenticatorTestSuite(t *testing.T) {
+	suite.Run(t, new(JWTAuthenticatorTestSuite))
+}
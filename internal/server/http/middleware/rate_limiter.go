@@ -0,0 +1,310 @@
+package middleware
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"math"
+	"net"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+)
+
+const (
+	// maxLoginBodySize максимальный размер тела запроса, из которого извлекается логин.
+	maxLoginBodySize = 1 << 20
+
+	// memoryStoreSweepInterval кол-во операций, через которое хранилище очищается от устаревших записей.
+	memoryStoreSweepInterval = 1024
+)
+
+// Limit параметры корзины токенов.
+// Корзина вмещает Burst токенов, и пополняется на один токен каждые Interval.
+type Limit struct {
+	Burst    int
+	Interval time.Duration
+}
+
+// Lockout параметры прогрессивной блокировки.
+// После Threshold неудачных попыток подряд учетная запись блокируется на BaseDelay,
+// каждая последующая неудача удваивает время блокировки, но не более MaxDelay.
+type Lockout struct {
+	Threshold int
+	BaseDelay time.Duration
+	MaxDelay  time.Duration
+}
+
+// RateLimiterConfig конфигурация ограничителя запросов.
+type RateLimiterConfig struct {
+	IP      Limit
+	Login   Limit
+	Lockout Lockout
+}
+
+// RateLimitStore интерфейс хранилища состояния ограничителя.
+// Реализация должна быть безопасной для конкурентного использования.
+type RateLimitStore interface {
+	// Take забирает токен из корзины key, при отказе возвращает время ожидания.
+	Take(key string, limit Limit, now time.Time) (time.Duration, bool)
+
+	// Fail регистрирует неудачную попытку, возвращает время блокировки (0 — без блокировки).
+	Fail(key string, lockout Lockout, now time.Time) time.Duration
+
+	// Locked возвращает оставшееся время блокировки.
+	Locked(key string, now time.Time) time.Duration
+
+	// Reset сбрасывает счетчик неудачных попыток.
+	Reset(key string)
+}
+
+// RateLimiter middleware ограничивает частоту запросов по IP-адресу клиента и по учетной записи,
+// и блокирует учетную запись после серии неудачных попыток ввода пароля.
+// Учетная запись определяется по логину из тела запроса, а для запросов авторизованного пользователя
+// (смена пароля, удаление аккаунта) — по UUID пользователя из токена.
+// Неудачной попыткой считаются ответы 401 и 403 (неверный пароль авторизованного пользователя).
+// При превышении лимита возвращает 429 с заголовком Retry-After.
+func RateLimiter(store RateLimitStore, config RateLimiterConfig) func(next http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			now := time.Now()
+
+			if wait, ok := store.Take("ip:"+clientIP(r), config.IP, now); !ok {
+				tooManyRequests(w, wait)
+				return
+			}
+
+			accountKey := accountKey(r)
+			if accountKey == "" {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			if wait := store.Locked(accountKey, now); wait > 0 {
+				tooManyRequests(w, wait)
+				return
+			}
+
+			if wait, ok := store.Take(accountKey, config.Login, now); !ok {
+				tooManyRequests(w, wait)
+				return
+			}
+
+			sw := &statusWriter{ResponseWriter: w}
+			next.ServeHTTP(sw, r)
+
+			switch sw.status {
+			case http.StatusUnauthorized, http.StatusForbidden:
+				store.Fail(accountKey, config.Lockout, time.Now())
+			case http.StatusOK:
+				store.Reset(accountKey)
+			}
+		})
+	}
+}
+
+// accountKey возвращает ключ учетной записи запроса: логин из тела запроса
+// или UUID авторизованного пользователя, пустую строку — если учетная запись не известна.
+func accountKey(r *http.Request) string {
+	if login := extractLogin(r); login != "" {
+		return "login:" + login
+	}
+
+	if userUUID, ok := GetUserUUID(r.Context()); ok && userUUID != "" {
+		return "user:" + userUUID
+	}
+
+	return ""
+}
+
+// tooManyRequests пишет ответ 429 с заголовком Retry-After (в секундах).
+func tooManyRequests(w http.ResponseWriter, wait time.Duration) {
+	w.Header().Set("Retry-After", strconv.Itoa(int(math.Ceil(wait.Seconds()))))
+	http.Error(w, http.StatusText(http.StatusTooManyRequests), http.StatusTooManyRequests)
+}
+
+// clientIP возвращает IP-адрес клиента.
+func clientIP(r *http.Request) string {
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		return r.RemoteAddr
+	}
+	return host
+}
+
+// extractLogin извлекает логин из json тела запроса, сохраняя тело для следующих обработчиков.
+func extractLogin(r *http.Request) string {
+	if r.Body == nil {
+		return ""
+	}
+
+	body, err := io.ReadAll(io.LimitReader(r.Body, maxLoginBodySize))
+	_ = r.Body.Close()
+	r.Body = io.NopCloser(bytes.NewReader(body))
+	if err != nil {
+		return ""
+	}
+
+	rd := struct {
+		Login string `json:"login"`
+	}{}
+	if err = json.Unmarshal(body, &rd); err != nil {
+		return ""
+	}
+
+	return rd.Login
+}
+
+// statusWriter обертка над http.ResponseWriter для получения кода ответа.
+type statusWriter struct {
+	http.ResponseWriter
+	status int
+}
+
+// WriteHeader запоминает код ответа.
+func (sw *statusWriter) WriteHeader(status int) {
+	if sw.status == 0 {
+		sw.status = status
+	}
+	sw.ResponseWriter.WriteHeader(status)
+}
+
+// Write при неявном ответе запоминает код 200.
+func (sw *statusWriter) Write(b []byte) (int, error) {
+	if sw.status == 0 {
+		sw.status = http.StatusOK
+	}
+	return sw.ResponseWriter.Write(b)
+}
+
+type bucket struct {
+	updated time.Time
+	tokens  float64
+}
+
+type failures struct {
+	lockedUntil time.Time
+	updated     time.Time
+	count       int
+}
+
+// MemoryRateLimitStore хранилище состояния ограничителя в памяти процесса.
+type MemoryRateLimitStore struct {
+	mu       sync.Mutex
+	buckets  map[string]*bucket
+	failures map[string]*failures
+	ops      int
+}
+
+// NewMemoryRateLimitStore конструктор.
+func NewMemoryRateLimitStore() *MemoryRateLimitStore {
+	return &MemoryRateLimitStore{
+		buckets:  make(map[string]*bucket),
+		failures: make(map[string]*failures),
+	}
+}
+
+// Take забирает токен из корзины key, при отказе возвращает время ожидания.
+func (m *MemoryRateLimitStore) Take(key string, limit Limit, now time.Time) (time.Duration, bool) {
+	if limit.Burst <= 0 || limit.Interval <= 0 {
+		return 0, true
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.sweep(now)
+
+	b, ok := m.buckets[key]
+	if !ok {
+		b = &bucket{tokens: float64(limit.Burst), updated: now}
+		m.buckets[key] = b
+	}
+
+	b.tokens = math.Min(
+		float64(limit.Burst),
+		b.tokens+float64(now.Sub(b.updated))/float64(limit.Interval),
+	)
+	b.updated = now
+
+	if b.tokens < 1 {
+		return time.Duration((1 - b.tokens) * float64(limit.Interval)), false
+	}
+
+	b.tokens--
+	return 0, true
+}
+
+// Fail регистрирует неудачную попытку, возвращает время блокировки (0 — без блокировки).
+func (m *MemoryRateLimitStore) Fail(key string, lockout Lockout, now time.Time) time.Duration {
+	if lockout.Threshold <= 0 {
+		return 0
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	f, ok := m.failures[key]
+	if !ok {
+		f = &failures{}
+		m.failures[key] = f
+	}
+
+	f.count++
+	f.updated = now
+	if f.count < lockout.Threshold {
+		return 0
+	}
+
+	delay := lockout.BaseDelay << (f.count - lockout.Threshold)
+	if delay <= 0 || (lockout.MaxDelay > 0 && delay > lockout.MaxDelay) {
+		delay = lockout.MaxDelay
+	}
+
+	f.lockedUntil = now.Add(delay)
+	return delay
+}
+
+// Locked возвращает оставшееся время блокировки.
+func (m *MemoryRateLimitStore) Locked(key string, now time.Time) time.Duration {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	f, ok := m.failures[key]
+	if !ok || !f.lockedUntil.After(now) {
+		return 0
+	}
+
+	return f.lockedUntil.Sub(now)
+}
+
+// Reset сбрасывает счетчик неудачных попыток.
+func (m *MemoryRateLimitStore) Reset(key string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	delete(m.failures, key)
+}
+
+// sweep периодически удаляет корзины и счетчики неудачных попыток, не изменявшиеся дольше часа.
+// Счетчики заблокированных логинов удаляются только после окончания блокировки.
+func (m *MemoryRateLimitStore) sweep(now time.Time) {
+	m.ops++
+	if m.ops < memoryStoreSweepInterval {
+		return
+	}
+	m.ops = 0
+
+	for key, b := range m.buckets {
+		if now.Sub(b.updated) > time.Hour {
+			delete(m.buckets, key)
+		}
+	}
+
+	for key, f := range m.failures {
+		if f.lockedUntil.Before(now) && now.Sub(f.updated) > time.Hour {
+			delete(m.failures, key)
+		}
+	}
+}
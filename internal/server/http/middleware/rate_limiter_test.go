@@ -0,0 +1,117 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type RateLimiterTestSuite struct {
+	suite.Suite
+	config RateLimiterConfig
+}
+
+func (s *RateLimiterTestSuite) SetupSuite() {
+	s.config = RateLimiterConfig{
+		IP:      Limit{Burst: 100, Interval: time.Second},
+		Login:   Limit{Burst: 3, Interval: time.Minute},
+		Lockout: Lockout{Threshold: 2, BaseDelay: time.Minute, MaxDelay: 3 * time.Minute},
+	}
+}
+
+func (s *RateLimiterTestSuite) request(handler http.Handler, login string) *httptest.ResponseRecorder {
+	w := httptest.NewRecorder()
+	r := httptest.NewRequest(
+		http.MethodPost,
+		"/api/user/login",
+		strings.NewReader(`{"login":"`+login+`","password":"example"}`),
+	)
+	handler.ServeHTTP(w, r)
+	return w
+}
+
+func (s *RateLimiterTestSuite) TestIPLimit() {
+	config := s.config
+	config.IP = Limit{Burst: 2, Interval: time.Minute}
+
+	handler := RateLimiter(NewMemoryRateLimitStore(), config)(
+		http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}),
+	)
+
+	s.Equal(http.StatusOK, s.request(handler, "ivan").Code)
+	s.Equal(http.StatusOK, s.request(handler, "petr").Code)
+
+	w := s.request(handler, "semen")
+	s.Equal(http.StatusTooManyRequests, w.Code)
+	s.Equal("60", w.Header().Get("Retry-After"))
+}
+
+func (s *RateLimiterTestSuite) TestLoginLimit() {
+	handler := RateLimiter(NewMemoryRateLimitStore(), s.config)(
+		http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}),
+	)
+
+	for i := 0; i < s.config.Login.Burst; i++ {
+		s.Equal(http.StatusOK, s.request(handler, "ivan").Code)
+	}
+
+	s.Equal(http.StatusTooManyRequests, s.request(handler, "ivan").Code)
+	s.Equal(http.StatusOK, s.request(handler, "petr").Code)
+}
+
+func (s *RateLimiterTestSuite) TestLockout() {
+	status := http.StatusUnauthorized
+	handler := RateLimiter(NewMemoryRateLimitStore(), s.config)(
+		http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(status)
+		}),
+	)
+
+	s.Equal(http.StatusUnauthorized, s.request(handler, "ivan").Code)
+	s.Equal(http.StatusUnauthorized, s.request(handler, "ivan").Code)
+
+	status = http.StatusOK
+	w := s.request(handler, "ivan")
+	s.Equal(http.StatusTooManyRequests, w.Code)
+	s.Equal("60", w.Header().Get("Retry-After"))
+}
+
+func (s *RateLimiterTestSuite) TestMemoryStoreProgressiveLockout() {
+	store := NewMemoryRateLimitStore()
+	now := time.Now()
+
+	s.Zero(store.Fail("login:ivan", s.config.Lockout, now))
+	s.Equal(time.Minute, store.Fail("login:ivan", s.config.Lockout, now))
+	s.Equal(2*time.Minute, store.Fail("login:ivan", s.config.Lockout, now))
+	s.Equal(3*time.Minute, store.Fail("login:ivan", s.config.Lockout, now))
+
+	s.Equal(time.Minute, store.Locked("login:ivan", now.Add(2*time.Minute)))
+	s.Zero(store.Locked("login:ivan", now.Add(4*time.Minute)))
+
+	store.Reset("login:ivan")
+	s.Zero(store.Fail("login:ivan", s.config.Lockout, now))
+}
+
+func (s *RateLimiterTestSuite) TestMemoryStoreRefill() {
+	store := NewMemoryRateLimitStore()
+	limit := Limit{Burst: 1, Interval: time.Minute}
+	now := time.Now()
+
+	_, ok := store.Take("ip:127.0.0.1", limit, now)
+	s.True(ok)
+
+	wait, ok := store.Take("ip:127.0.0.1", limit, now.Add(20*time.Second))
+	s.False(ok)
+	s.Equal(40*time.Second, wait)
+
+	_, ok = store.Take("ip:127.0.0.1", limit, now.Add(time.Minute))
+	s.True(ok)
+}
+
+func TestRateLimiterTestSuite(t *testing.T) {
+	suite.Run(t, new(RateLimiterTestSuite))
+}
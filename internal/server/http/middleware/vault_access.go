@@ -0,0 +1,70 @@
+package middleware
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"github.com/casnerano/seckeep/internal/server/model"
+	"github.com/casnerano/seckeep/internal/server/service/vault"
+	"github.com/go-chi/chi/v5"
+)
+
+type ctxVaultUUIDType string
+
+// CtxVaultUUIDKey ключ параметра контекста для UUID общего хранилища.
+const CtxVaultUUIDKey ctxVaultUUIDType = "vault_uuid"
+
+// VaultRoleResolver интерфейс получения роли пользователя в общем хранилище.
+type VaultRoleResolver interface {
+	Role(ctx context.Context, vaultUUID, userUUID string) (model.VaultRole, error)
+}
+
+// VaultAccess middleware проверяет, что пользователь является участником общего хранилища
+// из параметра маршрута "vault", а для изменяющих запросов — что роль позволяет изменять записи.
+// UUID хранилища передается дальше в контексте.
+// Должен применяться после JWTAuthenticator.
+func VaultAccess(vaults VaultRoleResolver) func(next http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			userUUID, ok := GetUserUUID(r.Context())
+			if !ok {
+				w.WriteHeader(http.StatusUnauthorized)
+				return
+			}
+
+			vaultUUID := chi.URLParam(r, "vault")
+			if vaultUUID == "" {
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+
+			role, err := vaults.Role(r.Context(), vaultUUID, userUUID)
+			if err != nil {
+				if errors.Is(err, vault.ErrNotFound) {
+					w.WriteHeader(http.StatusNotFound)
+					return
+				}
+				w.WriteHeader(http.StatusInternalServerError)
+				return
+			}
+
+			if r.Method != http.MethodGet && !role.CanWrite() {
+				w.WriteHeader(http.StatusForbidden)
+				return
+			}
+
+			ctx := context.WithValue(r.Context(), CtxVaultUUIDKey, vaultUUID)
+			next.ServeHTTP(w, r.WithContext(ctx))
+		})
+	}
+}
+
+// GetVaultUUID функция возвращает UUID общего хранилища из заданного контекста.
+func GetVaultUUID(ctx context.Context) (string, bool) {
+	uuid, ok := ctx.Value(CtxVaultUUIDKey).(string)
+	if !ok {
+		return "", false
+	}
+	return uuid, true
+}
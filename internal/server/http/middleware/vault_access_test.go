@@ -0,0 +1,76 @@
+package middleware
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/casnerano/seckeep/internal/server/model"
+	"github.com/casnerano/seckeep/internal/server/service/vault"
+	"github.com/go-chi/chi/v5"
+	"github.com/stretchr/testify/suite"
+)
+
+type vaultRolesStub map[string]model.VaultRole
+
+func (v vaultRolesStub) Role(_ context.Context, _, userUUID string) (model.VaultRole, error) {
+	role, ok := v[userUUID]
+	if !ok {
+		return "", vault.ErrNotFound
+	}
+	return role, nil
+}
+
+type VaultAccessTestSuite struct {
+	suite.Suite
+	router *chi.Mux
+}
+
+func (s *VaultAccessTestSuite) SetupSuite() {
+	roles := vaultRolesStub{
+		"owner":  model.VaultRoleOwner,
+		"viewer": model.VaultRoleViewer,
+	}
+
+	s.router = chi.NewRouter()
+	s.router.Route("/api/vaults/{vault}/data", func(r chi.Router) {
+		r.Use(VaultAccess(roles))
+		r.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+			vaultUUID, _ := GetVaultUUID(r.Context())
+			_, _ = w.Write([]byte(vaultUUID))
+		})
+	})
+}
+
+func (s *VaultAccessTestSuite) request(method, userUUID string) *httptest.ResponseRecorder {
+	w := httptest.NewRecorder()
+	r := httptest.NewRequest(method, "/api/vaults/4c9f2a1e/data/", nil)
+	r = r.WithContext(context.WithValue(r.Context(), CtxUserUUIDKey, userUUID))
+	s.router.ServeHTTP(w, r)
+	return w
+}
+
+func (s *VaultAccessTestSuite) TestAccess() {
+	s.Run("Owner writes", func() {
+		w := s.request(http.MethodPost, "owner")
+		s.Equal(http.StatusOK, w.Code)
+		s.Equal("4c9f2a1e", w.Body.String())
+	})
+
+	s.Run("Viewer reads", func() {
+		s.Equal(http.StatusOK, s.request(http.MethodGet, "viewer").Code)
+	})
+
+	s.Run("Viewer writes", func() {
+		s.Equal(http.StatusForbidden, s.request(http.MethodPost, "viewer").Code)
+	})
+
+	s.Run("Not a member", func() {
+		s.Equal(http.StatusNotFound, s.request(http.MethodGet, "stranger").Code)
+	})
+}
+
+func TestVaultAccessTestSuite(t *testing.T) {
+	suite.Run(t, new(VaultAccessTestSuite))
+}
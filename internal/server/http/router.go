@@ -1,11 +1,17 @@
 package http
 
 import (
+	"net/http"
+
 	"github.com/casnerano/seckeep/internal/server/http/handler"
 	"github.com/casnerano/seckeep/internal/server/http/middleware"
 	"github.com/casnerano/seckeep/internal/server/service/account"
 	"github.com/casnerano/seckeep/internal/server/service/data"
+	"github.com/casnerano/seckeep/internal/server/service/emergency"
+	"github.com/casnerano/seckeep/internal/server/service/share"
+	"github.com/casnerano/seckeep/internal/server/service/vault"
 	"github.com/casnerano/seckeep/pkg/http/simple"
+	"github.com/casnerano/seckeep/pkg/jwtoken"
 	"github.com/casnerano/seckeep/pkg/log"
 	"github.com/go-chi/chi/v5"
 	chiMiddleware "github.com/go-chi/chi/v5/middleware"
@@ -15,11 +21,12 @@ import (
 type Router struct {
 	chiRouter *chi.Mux
 	logger    *log.Logger
-	secret    string
+	keys      *jwtoken.KeySet
+	sessions  middleware.SessionValidator
 }
 
 // NewRouter конструктор.
-func NewRouter(logger *log.Logger, secret string) *Router {
+func NewRouter(logger *log.Logger, keys *jwtoken.KeySet, sessions middleware.SessionValidator) *Router {
 	chiRouter := chi.NewRouter()
 
 	chiRouter.Use(chiMiddleware.RequestID)
@@ -29,7 +36,8 @@ func NewRouter(logger *log.Logger, secret string) *Router {
 	return &Router{
 		chiRouter: chiRouter,
 		logger:    logger,
-		secret:    secret,
+		keys:      keys,
+		sessions:  sessions,
 	}
 }
 
@@ -37,30 +45,105 @@ func NewRouter(logger *log.Logger, secret string) *Router {
 func (router *Router) InitServiceHandler() {
 	h := handler.NewService(router.logger)
 	router.chiRouter.Group(func(r chi.Router) {
-		r.Use(middleware.JWTAuthenticator(router.secret))
+		r.Use(middleware.JWTAuthenticator(router.keys, router.sessions))
 		r.Get("/api/ping", h.Ping())
 	})
 }
 
+// InitJWKSHandler метод инициализации роута публикации открытых ключей подписи токенов.
+func (router *Router) InitJWKSHandler() {
+	h := handler.NewJWKS(router.keys, router.logger)
+	router.chiRouter.Get("/.well-known/jwks.json", simple.Handler(h.Get))
+}
+
 // InitAccountHandler метод инициализации роутов для обработчиков аккаунта пользователя.
-func (router *Router) InitAccountHandler(service *account.Account) {
+// Роуты, принимающие пароль, защищены ограничителем частоты запросов limiter.
+func (router *Router) InitAccountHandler(service *account.Account, limiter func(next http.Handler) http.Handler) {
 	h := handler.NewAccount(service, router.logger)
 	router.chiRouter.Group(func(r chi.Router) {
+		r.Use(limiter)
 		r.Post("/api/user/register", simple.TypedHandler(h.SignUp))
 		r.Post("/api/user/login", simple.TypedHandler(h.SignIn))
+		r.Post("/api/user/recover", simple.TypedHandler(h.Recover))
+	})
+	router.chiRouter.Group(func(r chi.Router) {
+		r.Use(middleware.JWTAuthenticator(router.keys, router.sessions))
+		r.Post("/api/user/logout", simple.Handler(h.SignOut))
+		// Смена пароля и удаление аккаунта проверяют пароль: при подборе блокируется пользователь из токена.
+		r.With(limiter).Put("/api/user/password", simple.TypedHandler(h.ChangePassword))
+		r.With(limiter).Delete("/api/user", simple.TypedHandler(h.Delete))
+		r.Get("/api/user/export", simple.Handler(h.Export))
+		r.Put("/api/user/keys", simple.TypedHandler(h.SetKeys))
+		r.Get("/api/user/keys", simple.Handler(h.Keys))
+		r.Put("/api/user/recovery-key", simple.TypedHandler(h.SetRecoveryKey))
+		r.Get("/api/users/{login}/keys", simple.Handler(h.PublicKeys))
 	})
 }
 
 // InitDataHandler метод инициализации роутов для обработчиков взаимодействия с секретными данными.
-func (router *Router) InitDataHandler(service *data.Data) {
+// Записи общих хранилищ обслуживаются теми же обработчиками, доступ к ним проверяет vaults.
+func (router *Router) InitDataHandler(service *data.Data, vaults middleware.VaultRoleResolver) {
 	h := handler.NewData(service, router.logger)
+	routes := func(r chi.Router) {
+		r.Post("/", simple.TypedHandler(h.Create))
+		r.Get("/", simple.Handler(h.GetList))
+		r.Put("/{uuid}", simple.TypedHandler(h.Update))
+		r.Get("/{uuid}", simple.Handler(h.Get))
+		r.Delete("/{uuid}", simple.Handler(h.Delete))
+		r.Get("/{uuid}/history", simple.Handler(h.History))
+		r.Post("/{uuid}/restore", simple.Handler(h.Restore))
+		r.Get("/trash", simple.Handler(h.Trash))
+		r.Delete("/trash", simple.Handler(h.EmptyTrash))
+	}
+
+	router.chiRouter.Group(func(r chi.Router) {
+		r.Use(middleware.JWTAuthenticator(router.keys, router.sessions))
+		r.Route("/api/data", routes)
+		r.Route("/api/vaults/{vault}/data", func(r chi.Router) {
+			r.Use(middleware.VaultAccess(vaults))
+			routes(r)
+		})
+	})
+}
+
+// InitVaultHandler метод инициализации роутов для обработчиков общих хранилищ и их участников.
+func (router *Router) InitVaultHandler(service *vault.Vault) {
+	h := handler.NewVault(service, router.logger)
+	router.chiRouter.Group(func(r chi.Router) {
+		r.Use(middleware.JWTAuthenticator(router.keys, router.sessions))
+		r.Post("/api/vaults", simple.TypedHandler(h.Create))
+		r.Get("/api/vaults", simple.Handler(h.GetList))
+		r.Get("/api/vaults/{vault}/members", simple.Handler(h.GetMembers))
+		r.Put("/api/vaults/{vault}/members", simple.TypedHandler(h.SaveMember))
+		r.Delete("/api/vaults/{vault}/members/{login}", simple.Handler(h.DeleteMember))
+		r.Put("/api/vaults/{vault}/key", simple.TypedHandler(h.SetKey))
+	})
+}
+
+// InitShareHandler метод инициализации роутов для обработчиков ссылок на секреты.
+// Просмотр выполняется методом POST, чтобы предпросмотр ссылок в мессенджерах не расходовал просмотры.
+func (router *Router) InitShareHandler(service *share.Share) {
+	h := handler.NewShare(service, router.logger)
+	router.chiRouter.Group(func(r chi.Router) {
+		r.Use(middleware.JWTAuthenticator(router.keys, router.sessions))
+		r.Post("/api/shares", simple.TypedHandler(h.Create))
+	})
+	router.chiRouter.Post("/api/shares/{share}/open", simple.Handler(h.Open))
+}
+
+// InitEmergencyHandler метод инициализации роутов для обработчиков экстренного доступа.
+// Роуты contacts — для владельца данных, grants — для доверенного лица.
+func (router *Router) InitEmergencyHandler(service *emergency.Emergency) {
+	h := handler.NewEmergency(service, router.logger)
 	router.chiRouter.Group(func(r chi.Router) {
-		r.Use(middleware.JWTAuthenticator(router.secret))
-		r.Post("/api/data", simple.TypedHandler(h.Create))
-		r.Get("/api/data", simple.Handler(h.GetList))
-		r.Put("/api/data/{uuid}", simple.TypedHandler(h.Update))
-		r.Get("/api/data/{uuid}", simple.Handler(h.Get))
-		r.Delete("/api/data/{uuid}", simple.Handler(h.Delete))
+		r.Use(middleware.JWTAuthenticator(router.keys, router.sessions))
+		r.Put("/api/emergency/contacts", simple.TypedHandler(h.Grant))
+		r.Get("/api/emergency/contacts", simple.Handler(h.GetContacts))
+		r.Delete("/api/emergency/contacts/{login}", simple.Handler(h.Revoke))
+		r.Post("/api/emergency/contacts/{login}/deny", simple.Handler(h.Deny))
+		r.Get("/api/emergency/grants", simple.Handler(h.GetGrants))
+		r.Post("/api/emergency/grants/{login}/request", simple.Handler(h.Request))
+		r.Get("/api/emergency/grants/{login}/data", simple.Handler(h.GetData))
 	})
 }
 
@@ -19,3 +19,10 @@ type DataUpdateRequest struct {
 	Value   []byte    `json:"value" validate:"required"`
 	Version time.Time `json:"version" validate:"required"`
 }
+
+// DataScope область видимости секретных данных:
+// личные данные пользователя UserUUID, или данные общего хранилища VaultUUID (если задан).
+type DataScope struct {
+	UserUUID  string
+	VaultUUID string
+}
@@ -0,0 +1,54 @@
+package model
+
+import (
+	"time"
+
+	"github.com/casnerano/seckeep/internal/pkg/model"
+)
+
+// EmergencyStatus состояние экстренного доступа.
+type EmergencyStatus string
+
+// Варианты состояний экстренного доступа.
+const (
+	// EmergencyStatusGranted доступ выдан, доверенное лицо его не запрашивало.
+	EmergencyStatusGranted EmergencyStatus = "GRANTED"
+
+	// EmergencyStatusRequested доступ запрошен, идет период ожидания.
+	EmergencyStatusRequested EmergencyStatus = "REQUESTED"
+
+	// EmergencyStatusReleased период ожидания истек, доступ открыт.
+	EmergencyStatusReleased EmergencyStatus = "RELEASED"
+)
+
+// EmergencyAccess структура экстренного доступа доверенного лица (Contact) к данным владельца (Owner).
+// WrappedKey — ключ данных владельца, зашифрованный открытым ключом доверенного лица,
+// выдается доверенному лицу только после открытия доступа.
+// WaitPeriod — период ожидания в секундах, в течение которого владелец может отклонить запрос.
+type EmergencyAccess struct {
+	OwnerUUID    string          `json:"-"`
+	OwnerLogin   string          `json:"owner_login"`
+	ContactUUID  string          `json:"-"`
+	ContactLogin string          `json:"contact_login"`
+	WrappedKey   []byte          `json:"wrapped_key,omitempty"`
+	WaitPeriod   int64           `json:"wait_period"`
+	Status       EmergencyStatus `json:"status"`
+	RequestedAt  *time.Time      `json:"requested_at,omitempty"`
+	ReleaseAt    *time.Time      `json:"release_at,omitempty"`
+	CreatedAt    time.Time       `json:"created_at"`
+}
+
+// EmergencyGrantRequest структура запроса выдачи экстренного доступа.
+// WaitPeriod — период ожидания в секундах (от часа до 30 дней).
+type EmergencyGrantRequest struct {
+	Login      string `json:"login" validate:"required"`
+	WrappedKey []byte `json:"wrapped_key" validate:"required"`
+	WaitPeriod int64  `json:"wait_period" validate:"required,min=3600,max=2592000"`
+}
+
+// EmergencyData структура открытых по экстренному доступу данных владельца.
+type EmergencyData struct {
+	OwnerLogin string        `json:"owner_login"`
+	WrappedKey []byte        `json:"wrapped_key"`
+	Data       []*model.Data `json:"data"`
+}
@@ -0,0 +1,21 @@
+package model
+
+import "time"
+
+// Share структура ссылки на секрет с ограниченным сроком действия и числом просмотров.
+// Value — запись, зашифрованная на клиенте случайным ключом, который передается только в самой ссылке.
+type Share struct {
+	UUID      string    `json:"uuid"`
+	Value     []byte    `json:"value"`
+	ViewsLeft int       `json:"views_left"`
+	ExpiresAt time.Time `json:"expires_at"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// ShareCreateRequest структура запроса создания ссылки на секрет.
+// TTL — срок действия ссылки в секундах (от минуты до недели), Views — допустимое число просмотров.
+type ShareCreateRequest struct {
+	Value []byte `json:"value" validate:"required"`
+	TTL   int64  `json:"ttl" validate:"required,min=60,max=604800"`
+	Views int    `json:"views" validate:"required,min=1,max=100"`
+}
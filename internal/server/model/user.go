@@ -1,14 +1,21 @@
 package model
 
-import "time"
+import (
+	"time"
+
+	"github.com/casnerano/seckeep/internal/pkg/model"
+)
 
 // User структура пользователя (представляет модель БД).
 type User struct {
-	UUID      string
-	Login     string
-	Password  string
-	FullName  string
-	CreatedAt time.Time
+	UUID         string
+	Login        string
+	Password     string
+	FullName     string
+	CreatedAt    time.Time
+	TokenVersion int
+	Keys         UserKeys
+	Recovery     UserRecovery
 }
 
 // UserSignUpRequest структура запроса на регистрацию.
@@ -23,3 +30,72 @@ type UserSignInRequest struct {
 	Login    string `json:"login" validate:"required"`
 	Password string `json:"password" validate:"required"`
 }
+
+// UserChangePasswordRequest структура запроса на смену пароля.
+type UserChangePasswordRequest struct {
+	OldPassword string `json:"old_password" validate:"required"`
+	NewPassword string `json:"new_password" validate:"required"`
+}
+
+// UserDeleteRequest структура запроса на удаление аккаунта.
+type UserDeleteRequest struct {
+	Password string `json:"password" validate:"required"`
+}
+
+// UserProfile структура публичных данных пользователя.
+type UserProfile struct {
+	UUID      string    `json:"uuid"`
+	Login     string    `json:"login"`
+	FullName  string    `json:"full_name"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// AccountExport структура выгрузки всех данных аккаунта.
+type AccountExport struct {
+	User       UserProfile   `json:"user"`
+	Data       []*model.Data `json:"data"`
+	ExportedAt time.Time     `json:"exported_at"`
+}
+
+// UserKeysRequest структура запроса публикации ключей пользователя.
+// Закрытые ключи передаются зашифрованными ключом хранилища клиента и серверу недоступны.
+type UserKeysRequest struct {
+	EncryptionKey []byte `json:"encryption_key" validate:"required,len=32"`
+	SigningKey    []byte `json:"signing_key" validate:"required,len=32"`
+	PrivateKeys   []byte `json:"private_keys" validate:"required"`
+}
+
+// UserKeys структура ключей пользователя: открытые ключи шифрования (X25519) и подписи (Ed25519),
+// зашифрованные закрытые ключи передаются только их владельцу.
+type UserKeys struct {
+	Login         string `json:"login"`
+	EncryptionKey []byte `json:"encryption_key"`
+	SigningKey    []byte `json:"signing_key"`
+	PrivateKeys   []byte `json:"private_keys,omitempty"`
+}
+
+// UserRecovery структура ключа восстановления пользователя.
+// Verifier — хеш проверочного значения, выведенного клиентом из ключа восстановления,
+// WrappedKey — ключ данных, зашифрованный ключом восстановления. Сам ключ восстановления серверу неизвестен.
+type UserRecovery struct {
+	Verifier   []byte
+	WrappedKey []byte
+}
+
+// UserRecoveryKeyRequest структура запроса сохранения ключа восстановления.
+type UserRecoveryKeyRequest struct {
+	Verifier   []byte `json:"verifier" validate:"required,len=32"`
+	WrappedKey []byte `json:"wrapped_key" validate:"required"`
+}
+
+// UserRecoverRequest структура запроса восстановления доступа по ключу восстановления с установкой нового пароля.
+type UserRecoverRequest struct {
+	Login       string `json:"login" validate:"required"`
+	Verifier    []byte `json:"verifier" validate:"required,len=32"`
+	NewPassword string `json:"new_password" validate:"required"`
+}
+
+// UserRecoverResponse структура ответа на запрос восстановления доступа.
+type UserRecoverResponse struct {
+	WrappedKey []byte `json:"wrapped_key"`
+}
@@ -0,0 +1,75 @@
+package model
+
+import "time"
+
+// VaultRole роль участника общего хранилища.
+type VaultRole string
+
+// Варианты ролей участника общего хранилища.
+const (
+	// VaultRoleOwner владелец: управляет участниками, читает и изменяет записи.
+	VaultRoleOwner VaultRole = "OWNER"
+
+	// VaultRoleEditor редактор: читает и изменяет записи.
+	VaultRoleEditor VaultRole = "EDITOR"
+
+	// VaultRoleViewer читатель: только читает записи.
+	VaultRoleViewer VaultRole = "VIEWER"
+)
+
+// IsValid проверяет на валидность роль.
+func (r VaultRole) IsValid() bool {
+	switch r {
+	case VaultRoleOwner, VaultRoleEditor, VaultRoleViewer:
+		return true
+	}
+	return false
+}
+
+// CanWrite проверяет, может ли роль изменять записи хранилища.
+func (r VaultRole) CanWrite() bool {
+	return r == VaultRoleOwner || r == VaultRoleEditor
+}
+
+// Vault структура общего хранилища.
+// Записи хранилища зашифрованы ключом хранилища, который хранится
+// у каждого участника зашифрованным его открытым ключом (WrappedKey).
+type Vault struct {
+	UUID       string    `json:"uuid"`
+	Name       string    `json:"name"`
+	Role       VaultRole `json:"role"`
+	WrappedKey []byte    `json:"wrapped_key"`
+	CreatedAt  time.Time `json:"created_at"`
+}
+
+// VaultMember структура участника общего хранилища.
+type VaultMember struct {
+	VaultUUID  string    `json:"vault_uuid"`
+	UserUUID   string    `json:"user_uuid"`
+	Login      string    `json:"login"`
+	FullName   string    `json:"full_name"`
+	Role       VaultRole `json:"role"`
+	WrappedKey []byte    `json:"wrapped_key,omitempty"`
+	CreatedAt  time.Time `json:"created_at"`
+}
+
+// VaultCreateRequest структура запроса создания общего хранилища.
+// WrappedKey — ключ хранилища, зашифрованный открытым ключом создателя.
+type VaultCreateRequest struct {
+	Name       string `json:"name" validate:"required,max=100"`
+	WrappedKey []byte `json:"wrapped_key" validate:"required"`
+}
+
+// VaultMemberRequest структура запроса добавления (изменения роли) участника общего хранилища.
+// WrappedKey — ключ хранилища, зашифрованный открытым ключом участника.
+type VaultMemberRequest struct {
+	Login      string    `json:"login" validate:"required"`
+	Role       VaultRole `json:"role" validate:"required,enum"`
+	WrappedKey []byte    `json:"wrapped_key" validate:"required"`
+}
+
+// VaultKeyRequest структура запроса замены собственного ключа общего хранилища участником.
+// WrappedKey — ключ хранилища, зашифрованный текущим открытым ключом участника.
+type VaultKeyRequest struct {
+	WrappedKey []byte `json:"wrapped_key" validate:"required"`
+}
@@ -52,6 +52,20 @@ func (mr *MockUserMockRecorder) Add(ctx, login, password, fullName interface{})
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Add", reflect.TypeOf((*MockUser)(nil).Add), ctx, login, password, fullName)
 }
 
+// Delete mocks base method.
+func (m *MockUser) Delete(ctx context.Context, uuid string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, uuid)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockUserMockRecorder) Delete(ctx, uuid interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockUser)(nil).Delete), ctx, uuid)
+}
+
 // FindByLogin mocks base method.
 func (m *MockUser) FindByLogin(ctx context.Context, login string) (*model0.User, error) {
 	m.ctrl.T.Helper()
@@ -82,6 +96,102 @@ func (mr *MockUserMockRecorder) FindByUUID(ctx, uuid interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByUUID", reflect.TypeOf((*MockUser)(nil).FindByUUID), ctx, uuid)
 }
 
+// UpdateKeys mocks base method.
+func (m *MockUser) UpdateKeys(ctx context.Context, uuid string, keys model0.UserKeys) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateKeys", ctx, uuid, keys)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateKeys indicates an expected call of UpdateKeys.
+func (mr *MockUserMockRecorder) UpdateKeys(ctx, uuid, keys interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateKeys", reflect.TypeOf((*MockUser)(nil).UpdateKeys), ctx, uuid, keys)
+}
+
+// UpdatePassword mocks base method.
+func (m *MockUser) UpdatePassword(ctx context.Context, uuid, password string) (*model0.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdatePassword", ctx, uuid, password)
+	ret0, _ := ret[0].(*model0.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdatePassword indicates an expected call of UpdatePassword.
+func (mr *MockUserMockRecorder) UpdatePassword(ctx, uuid, password interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePassword", reflect.TypeOf((*MockUser)(nil).UpdatePassword), ctx, uuid, password)
+}
+
+// UpdateRecovery mocks base method.
+func (m *MockUser) UpdateRecovery(ctx context.Context, uuid string, recovery model0.UserRecovery) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateRecovery", ctx, uuid, recovery)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateRecovery indicates an expected call of UpdateRecovery.
+func (mr *MockUserMockRecorder) UpdateRecovery(ctx, uuid, recovery interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateRecovery", reflect.TypeOf((*MockUser)(nil).UpdateRecovery), ctx, uuid, recovery)
+}
+
+// MockSession is a mock of Session interface.
+type MockSession struct {
+	ctrl     *gomock.Controller
+	recorder *MockSessionMockRecorder
+}
+
+// MockSessionMockRecorder is the mock recorder for MockSession.
+type MockSessionMockRecorder struct {
+	mock *MockSession
+}
+
+// NewMockSession creates a new mock instance.
+func NewMockSession(ctrl *gomock.Controller) *MockSession {
+	mock := &MockSession{ctrl: ctrl}
+	mock.recorder = &MockSessionMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSession) EXPECT() *MockSessionMockRecorder {
+	return m.recorder
+}
+
+// Revoke mocks base method.
+func (m *MockSession) Revoke(ctx context.Context, userUUID, sessionID string, expiresAt time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Revoke", ctx, userUUID, sessionID, expiresAt)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Revoke indicates an expected call of Revoke.
+func (mr *MockSessionMockRecorder) Revoke(ctx, userUUID, sessionID, expiresAt interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Revoke", reflect.TypeOf((*MockSession)(nil).Revoke), ctx, userUUID, sessionID, expiresAt)
+}
+
+// State mocks base method.
+func (m *MockSession) State(ctx context.Context, userUUID, sessionID string) (int, bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "State", ctx, userUUID, sessionID)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(bool)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// State indicates an expected call of State.
+func (mr *MockSessionMockRecorder) State(ctx, userUUID, sessionID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "State", reflect.TypeOf((*MockSession)(nil).State), ctx, userUUID, sessionID)
+}
+
 // MockData is a mock of Data interface.
 type MockData struct {
 	ctrl     *gomock.Controller
@@ -121,60 +231,440 @@ func (mr *MockDataMockRecorder) Add(ctx, data interface{}) *gomock.Call {
 }
 
 // Delete mocks base method.
-func (m *MockData) Delete(ctx context.Context, userUUID, uuid string) error {
+func (m *MockData) Delete(ctx context.Context, scope model0.DataScope, uuid string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Delete", ctx, userUUID, uuid)
+	ret := m.ctrl.Call(m, "Delete", ctx, scope, uuid)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // Delete indicates an expected call of Delete.
-func (mr *MockDataMockRecorder) Delete(ctx, userUUID, uuid interface{}) *gomock.Call {
+func (mr *MockDataMockRecorder) Delete(ctx, scope, uuid interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockData)(nil).Delete), ctx, scope, uuid)
+}
+
+// EmptyTrash mocks base method.
+func (m *MockData) EmptyTrash(ctx context.Context, scope model0.DataScope) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EmptyTrash", ctx, scope)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// EmptyTrash indicates an expected call of EmptyTrash.
+func (mr *MockDataMockRecorder) EmptyTrash(ctx, scope interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EmptyTrash", reflect.TypeOf((*MockData)(nil).EmptyTrash), ctx, scope)
+}
+
+// FindByScope mocks base method.
+func (m *MockData) FindByScope(ctx context.Context, scope model0.DataScope) ([]*model.Data, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByScope", ctx, scope)
+	ret0, _ := ret[0].([]*model.Data)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByScope indicates an expected call of FindByScope.
+func (mr *MockDataMockRecorder) FindByScope(ctx, scope interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockData)(nil).Delete), ctx, userUUID, uuid)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByScope", reflect.TypeOf((*MockData)(nil).FindByScope), ctx, scope)
 }
 
 // FindByUUID mocks base method.
-func (m *MockData) FindByUUID(ctx context.Context, userUUID, uuid string) (*model.Data, error) {
+func (m *MockData) FindByUUID(ctx context.Context, scope model0.DataScope, uuid string) (*model.Data, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "FindByUUID", ctx, userUUID, uuid)
+	ret := m.ctrl.Call(m, "FindByUUID", ctx, scope, uuid)
 	ret0, _ := ret[0].(*model.Data)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // FindByUUID indicates an expected call of FindByUUID.
-func (mr *MockDataMockRecorder) FindByUUID(ctx, userUUID, uuid interface{}) *gomock.Call {
+func (mr *MockDataMockRecorder) FindByUUID(ctx, scope, uuid interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByUUID", reflect.TypeOf((*MockData)(nil).FindByUUID), ctx, userUUID, uuid)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByUUID", reflect.TypeOf((*MockData)(nil).FindByUUID), ctx, scope, uuid)
 }
 
-// FindByUserUUID mocks base method.
-func (m *MockData) FindByUserUUID(ctx context.Context, userUUID string) ([]*model.Data, error) {
+// FindTrash mocks base method.
+func (m *MockData) FindTrash(ctx context.Context, scope model0.DataScope) ([]*model.Data, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "FindByUserUUID", ctx, userUUID)
+	ret := m.ctrl.Call(m, "FindTrash", ctx, scope)
 	ret0, _ := ret[0].([]*model.Data)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// FindByUserUUID indicates an expected call of FindByUserUUID.
-func (mr *MockDataMockRecorder) FindByUserUUID(ctx, userUUID interface{}) *gomock.Call {
+// FindTrash indicates an expected call of FindTrash.
+func (mr *MockDataMockRecorder) FindTrash(ctx, scope interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindTrash", reflect.TypeOf((*MockData)(nil).FindTrash), ctx, scope)
+}
+
+// History mocks base method.
+func (m *MockData) History(ctx context.Context, scope model0.DataScope, uuid string) ([]*model.DataRevision, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "History", ctx, scope, uuid)
+	ret0, _ := ret[0].([]*model.DataRevision)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// History indicates an expected call of History.
+func (mr *MockDataMockRecorder) History(ctx, scope, uuid interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "History", reflect.TypeOf((*MockData)(nil).History), ctx, scope, uuid)
+}
+
+// PurgeTrash mocks base method.
+func (m *MockData) PurgeTrash(ctx context.Context, before time.Time) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PurgeTrash", ctx, before)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PurgeTrash indicates an expected call of PurgeTrash.
+func (mr *MockDataMockRecorder) PurgeTrash(ctx, before interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PurgeTrash", reflect.TypeOf((*MockData)(nil).PurgeTrash), ctx, before)
+}
+
+// Restore mocks base method.
+func (m *MockData) Restore(ctx context.Context, scope model0.DataScope, uuid string) (*model.Data, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Restore", ctx, scope, uuid)
+	ret0, _ := ret[0].(*model.Data)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Restore indicates an expected call of Restore.
+func (mr *MockDataMockRecorder) Restore(ctx, scope, uuid interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByUserUUID", reflect.TypeOf((*MockData)(nil).FindByUserUUID), ctx, userUUID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Restore", reflect.TypeOf((*MockData)(nil).Restore), ctx, scope, uuid)
 }
 
 // Update mocks base method.
-func (m *MockData) Update(ctx context.Context, userUUID, uuid string, value []byte, version time.Time) (*model.Data, error) {
+func (m *MockData) Update(ctx context.Context, scope model0.DataScope, uuid string, value []byte, version time.Time) (*model.Data, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Update", ctx, userUUID, uuid, value, version)
+	ret := m.ctrl.Call(m, "Update", ctx, scope, uuid, value, version)
 	ret0, _ := ret[0].(*model.Data)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // Update indicates an expected call of Update.
-func (mr *MockDataMockRecorder) Update(ctx, userUUID, uuid, value, version interface{}) *gomock.Call {
+func (mr *MockDataMockRecorder) Update(ctx, scope, uuid, value, version interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockData)(nil).Update), ctx, scope, uuid, value, version)
+}
+
+// MockVault is a mock of Vault interface.
+type MockVault struct {
+	ctrl     *gomock.Controller
+	recorder *MockVaultMockRecorder
+}
+
+// MockVaultMockRecorder is the mock recorder for MockVault.
+type MockVaultMockRecorder struct {
+	mock *MockVault
+}
+
+// NewMockVault creates a new mock instance.
+func NewMockVault(ctrl *gomock.Controller) *MockVault {
+	mock := &MockVault{ctrl: ctrl}
+	mock.recorder = &MockVaultMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockVault) EXPECT() *MockVaultMockRecorder {
+	return m.recorder
+}
+
+// Add mocks base method.
+func (m *MockVault) Add(ctx context.Context, name, ownerUUID string, wrappedKey []byte) (*model0.Vault, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Add", ctx, name, ownerUUID, wrappedKey)
+	ret0, _ := ret[0].(*model0.Vault)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Add indicates an expected call of Add.
+func (mr *MockVaultMockRecorder) Add(ctx, name, ownerUUID, wrappedKey interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Add", reflect.TypeOf((*MockVault)(nil).Add), ctx, name, ownerUUID, wrappedKey)
+}
+
+// DeleteMember mocks base method.
+func (m *MockVault) DeleteMember(ctx context.Context, vaultUUID, userUUID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteMember", ctx, vaultUUID, userUUID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteMember indicates an expected call of DeleteMember.
+func (mr *MockVaultMockRecorder) DeleteMember(ctx, vaultUUID, userUUID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteMember", reflect.TypeOf((*MockVault)(nil).DeleteMember), ctx, vaultUUID, userUUID)
+}
+
+// FindByUserUUID mocks base method.
+func (m *MockVault) FindByUserUUID(ctx context.Context, userUUID string) ([]*model0.Vault, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByUserUUID", ctx, userUUID)
+	ret0, _ := ret[0].([]*model0.Vault)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByUserUUID indicates an expected call of FindByUserUUID.
+func (mr *MockVaultMockRecorder) FindByUserUUID(ctx, userUUID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByUserUUID", reflect.TypeOf((*MockVault)(nil).FindByUserUUID), ctx, userUUID)
+}
+
+// FindMember mocks base method.
+func (m *MockVault) FindMember(ctx context.Context, vaultUUID, userUUID string) (*model0.VaultMember, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindMember", ctx, vaultUUID, userUUID)
+	ret0, _ := ret[0].(*model0.VaultMember)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindMember indicates an expected call of FindMember.
+func (mr *MockVaultMockRecorder) FindMember(ctx, vaultUUID, userUUID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindMember", reflect.TypeOf((*MockVault)(nil).FindMember), ctx, vaultUUID, userUUID)
+}
+
+// FindMembers mocks base method.
+func (m *MockVault) FindMembers(ctx context.Context, vaultUUID string) ([]*model0.VaultMember, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindMembers", ctx, vaultUUID)
+	ret0, _ := ret[0].([]*model0.VaultMember)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindMembers indicates an expected call of FindMembers.
+func (mr *MockVaultMockRecorder) FindMembers(ctx, vaultUUID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindMembers", reflect.TypeOf((*MockVault)(nil).FindMembers), ctx, vaultUUID)
+}
+
+// SaveMember mocks base method.
+func (m *MockVault) SaveMember(ctx context.Context, member model0.VaultMember) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveMember", ctx, member)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SaveMember indicates an expected call of SaveMember.
+func (mr *MockVaultMockRecorder) SaveMember(ctx, member interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveMember", reflect.TypeOf((*MockVault)(nil).SaveMember), ctx, member)
+}
+
+// MockShare is a mock of Share interface.
+type MockShare struct {
+	ctrl     *gomock.Controller
+	recorder *MockShareMockRecorder
+}
+
+// MockShareMockRecorder is the mock recorder for MockShare.
+type MockShareMockRecorder struct {
+	mock *MockShare
+}
+
+// NewMockShare creates a new mock instance.
+func NewMockShare(ctrl *gomock.Controller) *MockShare {
+	mock := &MockShare{ctrl: ctrl}
+	mock.recorder = &MockShareMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockShare) EXPECT() *MockShareMockRecorder {
+	return m.recorder
+}
+
+// Add mocks base method.
+func (m *MockShare) Add(ctx context.Context, userUUID string, share model0.Share) (*model0.Share, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Add", ctx, userUUID, share)
+	ret0, _ := ret[0].(*model0.Share)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Add indicates an expected call of Add.
+func (mr *MockShareMockRecorder) Add(ctx, userUUID, share interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Add", reflect.TypeOf((*MockShare)(nil).Add), ctx, userUUID, share)
+}
+
+// DeleteExpired mocks base method.
+func (m *MockShare) DeleteExpired(ctx context.Context, before time.Time) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteExpired", ctx, before)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteExpired indicates an expected call of DeleteExpired.
+func (mr *MockShareMockRecorder) DeleteExpired(ctx, before interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteExpired", reflect.TypeOf((*MockShare)(nil).DeleteExpired), ctx, before)
+}
+
+// Take mocks base method.
+func (m *MockShare) Take(ctx context.Context, uuid string) (*model0.Share, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Take", ctx, uuid)
+	ret0, _ := ret[0].(*model0.Share)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Take indicates an expected call of Take.
+func (mr *MockShareMockRecorder) Take(ctx, uuid interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Take", reflect.TypeOf((*MockShare)(nil).Take), ctx, uuid)
+}
+
+// MockEmergency is a mock of Emergency interface.
+type MockEmergency struct {
+	ctrl     *gomock.Controller
+	recorder *MockEmergencyMockRecorder
+}
+
+// MockEmergencyMockRecorder is the mock recorder for MockEmergency.
+type MockEmergencyMockRecorder struct {
+	mock *MockEmergency
+}
+
+// NewMockEmergency creates a new mock instance.
+func NewMockEmergency(ctrl *gomock.Controller) *MockEmergency {
+	mock := &MockEmergency{ctrl: ctrl}
+	mock.recorder = &MockEmergencyMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockEmergency) EXPECT() *MockEmergencyMockRecorder {
+	return m.recorder
+}
+
+// Delete mocks base method.
+func (m *MockEmergency) Delete(ctx context.Context, ownerUUID, contactUUID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, ownerUUID, contactUUID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockEmergencyMockRecorder) Delete(ctx, ownerUUID, contactUUID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockEmergency)(nil).Delete), ctx, ownerUUID, contactUUID)
+}
+
+// Find mocks base method.
+func (m *MockEmergency) Find(ctx context.Context, ownerUUID, contactUUID string) (*model0.EmergencyAccess, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Find", ctx, ownerUUID, contactUUID)
+	ret0, _ := ret[0].(*model0.EmergencyAccess)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Find indicates an expected call of Find.
+func (mr *MockEmergencyMockRecorder) Find(ctx, ownerUUID, contactUUID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Find", reflect.TypeOf((*MockEmergency)(nil).Find), ctx, ownerUUID, contactUUID)
+}
+
+// FindByContact mocks base method.
+func (m *MockEmergency) FindByContact(ctx context.Context, contactUUID string) ([]*model0.EmergencyAccess, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByContact", ctx, contactUUID)
+	ret0, _ := ret[0].([]*model0.EmergencyAccess)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByContact indicates an expected call of FindByContact.
+func (mr *MockEmergencyMockRecorder) FindByContact(ctx, contactUUID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByContact", reflect.TypeOf((*MockEmergency)(nil).FindByContact), ctx, contactUUID)
+}
+
+// FindByOwner mocks base method.
+func (m *MockEmergency) FindByOwner(ctx context.Context, ownerUUID string) ([]*model0.EmergencyAccess, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByOwner", ctx, ownerUUID)
+	ret0, _ := ret[0].([]*model0.EmergencyAccess)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByOwner indicates an expected call of FindByOwner.
+func (mr *MockEmergencyMockRecorder) FindByOwner(ctx, ownerUUID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByOwner", reflect.TypeOf((*MockEmergency)(nil).FindByOwner), ctx, ownerUUID)
+}
+
+// ReleaseExpired mocks base method.
+func (m *MockEmergency) ReleaseExpired(ctx context.Context, now time.Time) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReleaseExpired", ctx, now)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReleaseExpired indicates an expected call of ReleaseExpired.
+func (mr *MockEmergencyMockRecorder) ReleaseExpired(ctx, now interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReleaseExpired", reflect.TypeOf((*MockEmergency)(nil).ReleaseExpired), ctx, now)
+}
+
+// Save mocks base method.
+func (m *MockEmergency) Save(ctx context.Context, access model0.EmergencyAccess) (*model0.EmergencyAccess, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Save", ctx, access)
+	ret0, _ := ret[0].(*model0.EmergencyAccess)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Save indicates an expected call of Save.
+func (mr *MockEmergencyMockRecorder) Save(ctx, access interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Save", reflect.TypeOf((*MockEmergency)(nil).Save), ctx, access)
+}
+
+// UpdateStatus mocks base method.
+func (m *MockEmergency) UpdateStatus(ctx context.Context, access model0.EmergencyAccess) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateStatus", ctx, access)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateStatus indicates an expected call of UpdateStatus.
+func (mr *MockEmergencyMockRecorder) UpdateStatus(ctx, access interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockData)(nil).Update), ctx, userUUID, uuid, value, version)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateStatus", reflect.TypeOf((*MockEmergency)(nil).UpdateStatus), ctx, access)
 }
@@ -3,30 +3,46 @@ package pgsql
 import (
 	"context"
 	"errors"
+	"fmt"
 	"time"
 
 	"github.com/casnerano/seckeep/internal/pkg/model"
+	smodel "github.com/casnerano/seckeep/internal/server/model"
 	"github.com/casnerano/seckeep/internal/server/repository"
 	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgxpool"
 )
 
+// dataColumns выбираемые колонки записи секретных данных.
+// Автор записи общего хранилища может быть удален, поэтому UUID пользователя может отсутствовать.
+const dataColumns = "uuid, coalesce(user_uuid::text, ''), coalesce(vault_uuid::text, ''), type, value, created_at, version, deleted_at"
+
+// DefaultHistoryLimit число хранимых ревизий записи по умолчанию.
+const DefaultHistoryLimit = 10
+
 // DataRepository структура репозитория работы с записями секретных данных.
 type DataRepository struct {
-	pgxpool *pgxpool.Pool
+	pgxpool      *pgxpool.Pool
+	historyLimit int
 }
 
 // NewDataRepository конструктор.
-func NewDataRepository(pgxpool *pgxpool.Pool) repository.Data {
-	return &DataRepository{pgxpool}
+// historyLimit — число хранимых ревизий каждой записи, если не задан, используется DefaultHistoryLimit.
+func NewDataRepository(pgxpool *pgxpool.Pool, historyLimit int) repository.Data {
+	if historyLimit <= 0 {
+		historyLimit = DefaultHistoryLimit
+	}
+	return &DataRepository{pgxpool: pgxpool, historyLimit: historyLimit}
 }
 
 // Add добавляет запись.
 func (d DataRepository) Add(ctx context.Context, data model.Data) (*model.Data, error) {
 	err := d.pgxpool.QueryRow(
 		ctx,
-		"insert into data(user_uuid, type, value, created_at, version) values($1, $2, $3, $4, $5) returning uuid",
+		"insert into data(user_uuid, vault_uuid, type, value, created_at, version) "+
+			"values($1, nullif($2, '')::uuid, $3, $4, $5, $6) returning uuid",
 		data.UserUUID,
+		data.VaultUUID,
 		data.Type,
 		data.Value,
 		data.CreatedAt.UTC(),
@@ -43,20 +59,16 @@ func (d DataRepository) Add(ctx context.Context, data model.Data) (*model.Data,
 }
 
 // FindByUUID ищет запись по UUID.
-func (d DataRepository) FindByUUID(ctx context.Context, userUUID string, uuid string) (*model.Data, error) {
-	data := model.Data{UUID: uuid}
-	err := d.pgxpool.QueryRow(
+func (d DataRepository) FindByUUID(ctx context.Context, scope smodel.DataScope, uuid string) (*model.Data, error) {
+	condition, scopeArg := scopeCondition(scope, 1)
+
+	data := model.Data{}
+	err := scanData(d.pgxpool.QueryRow(
 		ctx,
-		"select user_uuid, type, value, created_at, version from data where user_uuid = $1 and uuid = $2",
-		userUUID,
+		"select "+dataColumns+" from data where "+condition+" and uuid = $2 and deleted_at is null",
+		scopeArg,
 		uuid,
-	).Scan(
-		&data.UserUUID,
-		&data.Type,
-		&data.Value,
-		&data.CreatedAt,
-		&data.Version,
-	)
+	), &data)
 
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
@@ -68,14 +80,15 @@ func (d DataRepository) FindByUUID(ctx context.Context, userUUID string, uuid st
 	return &data, nil
 }
 
-// FindByUserUUID ищет запись по UUID пользователя.
-func (d DataRepository) FindByUserUUID(ctx context.Context, userUUID string) ([]*model.Data, error) {
+// FindByScope ищет все записи области видимости.
+func (d DataRepository) FindByScope(ctx context.Context, scope smodel.DataScope) ([]*model.Data, error) {
+	condition, scopeArg := scopeCondition(scope, 1)
 	data := make([]*model.Data, 0)
 
 	rows, err := d.pgxpool.Query(
 		ctx,
-		"select uuid, type, value, created_at, version from data where user_uuid = $1",
-		userUUID,
+		"select "+dataColumns+" from data where "+condition+" and deleted_at is null",
+		scopeArg,
 	)
 
 	if err != nil {
@@ -86,14 +99,7 @@ func (d DataRepository) FindByUserUUID(ctx context.Context, userUUID string) ([]
 
 	for rows.Next() {
 		datum := &model.Data{}
-		err = rows.Scan(
-			&datum.UUID,
-			&datum.Type,
-			&datum.Value,
-			&datum.CreatedAt,
-			&datum.Version,
-		)
-		if err == nil {
+		if err = scanData(rows, datum); err == nil {
 			data = append(data, datum)
 		}
 	}
@@ -102,25 +108,55 @@ func (d DataRepository) FindByUserUUID(ctx context.Context, userUUID string) ([]
 }
 
 // Update обновляет запись.
-func (d DataRepository) Update(ctx context.Context, userUUID string, uuid string, value []byte, version time.Time) (*model.Data, error) {
-	data := &model.Data{
-		UUID:    uuid,
-		Value:   value,
-		Version: version,
+// Прежнее значение сохраняется ревизией записи, хранятся только последние historyLimit ревизий.
+func (d DataRepository) Update(ctx context.Context, scope smodel.DataScope, uuid string, value []byte, version time.Time) (*model.Data, error) {
+	tx, err := d.pgxpool.Begin(ctx)
+	if err != nil {
+		return nil, err
 	}
+	defer func() { _ = tx.Rollback(ctx) }()
 
-	err := d.pgxpool.QueryRow(
+	condition, scopeArg := scopeCondition(scope, 1)
+
+	// Блокировка записи до конца транзакции: конкурентные изменения
+	// получат следующий номер ревизии, а не тот же самый.
+	var locked string
+	err = tx.QueryRow(
 		ctx,
-		"update data set value = $1, version = $2 where user_uuid = $3 and uuid = $4 returning user_uuid, type, created_at",
-		value,
-		data.Version.UTC(),
-		userUUID,
+		"select uuid from data where "+condition+" and uuid = $2 and deleted_at is null for update",
+		scopeArg,
+		uuid,
+	).Scan(&locked)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			err = repository.ErrNotFound
+		}
+		return nil, err
+	}
+
+	_, err = tx.Exec(
+		ctx,
+		`insert into data_history(data_uuid, revision, value, version)
+		select uuid, coalesce((select max(revision) from data_history where data_uuid = data.uuid), 0) + 1, value, version
+		from data where `+condition+` and uuid = $2 and deleted_at is null`,
+		scopeArg,
 		uuid,
-	).Scan(
-		&data.UserUUID,
-		&data.Type,
-		&data.CreatedAt,
 	)
+	if err != nil {
+		return nil, err
+	}
+
+	condition, scopeArg = scopeCondition(scope, 3)
+
+	data := &model.Data{}
+	err = scanData(tx.QueryRow(
+		ctx,
+		"update data set value = $1, version = $2 where "+condition+" and uuid = $4 and deleted_at is null returning "+dataColumns,
+		value,
+		version.UTC(),
+		scopeArg,
+		uuid,
+	), data)
 
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
@@ -129,15 +165,63 @@ func (d DataRepository) Update(ctx context.Context, userUUID string, uuid string
 		return nil, err
 	}
 
+	_, err = tx.Exec(
+		ctx,
+		`delete from data_history where data_uuid = $1
+		and revision <= (select max(revision) from data_history where data_uuid = $1) - $2`,
+		uuid,
+		d.historyLimit,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = tx.Commit(ctx); err != nil {
+		return nil, err
+	}
+
 	return data, nil
 }
 
-// Delete удаляет запись.
-func (d DataRepository) Delete(ctx context.Context, userUUID string, uuid string) error {
+// History ищет ревизии записи, начиная с последней.
+func (d DataRepository) History(ctx context.Context, scope smodel.DataScope, uuid string) ([]*model.DataRevision, error) {
+	if _, err := d.FindByUUID(ctx, scope, uuid); err != nil {
+		return nil, err
+	}
+
+	rows, err := d.pgxpool.Query(
+		ctx,
+		"select revision, value, version, created_at from data_history where data_uuid = $1 order by revision desc",
+		uuid,
+	)
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	revisions := make([]*model.DataRevision, 0)
+	for rows.Next() {
+		revision := &model.DataRevision{}
+		if err = rows.Scan(&revision.Revision, &revision.Value, &revision.Version, &revision.CreatedAt); err != nil {
+			return nil, err
+		}
+		revisions = append(revisions, revision)
+	}
+
+	return revisions, rows.Err()
+}
+
+// Delete перемещает запись в корзину.
+func (d DataRepository) Delete(ctx context.Context, scope smodel.DataScope, uuid string) error {
+	condition, scopeArg := scopeCondition(scope, 2)
+
 	res, err := d.pgxpool.Exec(
 		ctx,
-		"delete from data where user_uuid = $1 and uuid = $2",
-		userUUID,
+		"update data set deleted_at = $1 where "+condition+" and uuid = $3 and deleted_at is null",
+		time.Now().UTC(),
+		scopeArg,
 		uuid,
 	)
 
@@ -151,3 +235,102 @@ func (d DataRepository) Delete(ctx context.Context, userUUID string, uuid string
 
 	return repository.ErrNotFound
 }
+
+// FindTrash ищет записи области видимости в корзине, начиная с последней удаленной.
+func (d DataRepository) FindTrash(ctx context.Context, scope smodel.DataScope) ([]*model.Data, error) {
+	condition, scopeArg := scopeCondition(scope, 1)
+	data := make([]*model.Data, 0)
+
+	rows, err := d.pgxpool.Query(
+		ctx,
+		"select "+dataColumns+" from data where "+condition+" and deleted_at is not null order by deleted_at desc",
+		scopeArg,
+	)
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	for rows.Next() {
+		datum := &model.Data{}
+		if err = scanData(rows, datum); err == nil {
+			data = append(data, datum)
+		}
+	}
+
+	return data, nil
+}
+
+// Restore восстанавливает запись из корзины.
+func (d DataRepository) Restore(ctx context.Context, scope smodel.DataScope, uuid string) (*model.Data, error) {
+	condition, scopeArg := scopeCondition(scope, 1)
+
+	data := &model.Data{}
+	err := scanData(d.pgxpool.QueryRow(
+		ctx,
+		"update data set deleted_at = null where "+condition+" and uuid = $2 and deleted_at is not null returning "+dataColumns,
+		scopeArg,
+		uuid,
+	), data)
+
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			err = repository.ErrNotFound
+		}
+		return nil, err
+	}
+
+	return data, nil
+}
+
+// EmptyTrash окончательно удаляет записи области видимости из корзины.
+func (d DataRepository) EmptyTrash(ctx context.Context, scope smodel.DataScope) (int64, error) {
+	condition, scopeArg := scopeCondition(scope, 1)
+
+	res, err := d.pgxpool.Exec(
+		ctx,
+		"delete from data where "+condition+" and deleted_at is not null",
+		scopeArg,
+	)
+
+	if err != nil {
+		return 0, err
+	}
+
+	return res.RowsAffected(), nil
+}
+
+// PurgeTrash окончательно удаляет записи всех пользователей, перемещенные в корзину до момента before.
+func (d DataRepository) PurgeTrash(ctx context.Context, before time.Time) (int64, error) {
+	res, err := d.pgxpool.Exec(ctx, "delete from data where deleted_at < $1", before.UTC())
+	if err != nil {
+		return 0, err
+	}
+
+	return res.RowsAffected(), nil
+}
+
+// scopeCondition возвращает условие выборки записей области видимости scope
+// и значение параметра условия с порядковым номером n.
+func scopeCondition(scope smodel.DataScope, n int) (string, string) {
+	if scope.VaultUUID != "" {
+		return fmt.Sprintf("vault_uuid = $%d", n), scope.VaultUUID
+	}
+	return fmt.Sprintf("user_uuid = $%d and vault_uuid is null", n), scope.UserUUID
+}
+
+// scanData читает колонки dataColumns в структуру записи.
+func scanData(row pgx.Row, data *model.Data) error {
+	return row.Scan(
+		&data.UUID,
+		&data.UserUUID,
+		&data.VaultUUID,
+		&data.Type,
+		&data.Value,
+		&data.CreatedAt,
+		&data.Version,
+		&data.DeletedAt,
+	)
+}
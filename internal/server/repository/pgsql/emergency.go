@@ -0,0 +1,193 @@
+package pgsql
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/casnerano/seckeep/internal/server/model"
+	"github.com/casnerano/seckeep/internal/server/repository"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// emergencySelect общая часть запроса выборки экстренных доступов с логинами участников.
+const emergencySelect = "select e.owner_uuid, o.login, e.contact_uuid, c.login, e.wrapped_key, e.wait_period, " +
+	"e.status, e.requested_at, e.release_at, e.created_at from emergency_access e " +
+	"join users o on o.uuid = e.owner_uuid join users c on c.uuid = e.contact_uuid "
+
+// EmergencyRepository структура репозитория работы с экстренным доступом.
+type EmergencyRepository struct {
+	pgxpool *pgxpool.Pool
+}
+
+// NewEmergencyRepository конструктор.
+func NewEmergencyRepository(pgxpool *pgxpool.Pool) repository.Emergency {
+	return &EmergencyRepository{pgxpool}
+}
+
+// Save добавляет доступ, или обновляет ключ и период ожидания существующего со сбросом запроса.
+func (e EmergencyRepository) Save(ctx context.Context, access model.EmergencyAccess) (*model.EmergencyAccess, error) {
+	access.Status = model.EmergencyStatusGranted
+	access.RequestedAt, access.ReleaseAt = nil, nil
+
+	err := e.pgxpool.QueryRow(
+		ctx,
+		"insert into emergency_access(owner_uuid, contact_uuid, wrapped_key, wait_period) values($1, $2, $3, $4) "+
+			"on conflict (owner_uuid, contact_uuid) do update set wrapped_key = excluded.wrapped_key, "+
+			"wait_period = excluded.wait_period, status = 'GRANTED', requested_at = null, release_at = null "+
+			"returning created_at",
+		access.OwnerUUID,
+		access.ContactUUID,
+		access.WrappedKey,
+		access.WaitPeriod,
+	).Scan(
+		&access.CreatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &access, nil
+}
+
+// Find ищет доступ доверенного лица к данным владельца.
+func (e EmergencyRepository) Find(ctx context.Context, ownerUUID, contactUUID string) (*model.EmergencyAccess, error) {
+	access, err := scanEmergencyAccess(e.pgxpool.QueryRow(
+		ctx,
+		emergencySelect+"where e.owner_uuid = $1 and e.contact_uuid = $2",
+		ownerUUID,
+		contactUUID,
+	))
+
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			err = repository.ErrNotFound
+		}
+		return nil, err
+	}
+
+	return access, nil
+}
+
+// FindByOwner ищет все доступы, выданные владельцем.
+func (e EmergencyRepository) FindByOwner(ctx context.Context, ownerUUID string) ([]*model.EmergencyAccess, error) {
+	return e.findAll(ctx, emergencySelect+"where e.owner_uuid = $1 order by c.login", ownerUUID)
+}
+
+// FindByContact ищет все доступы, выданные доверенному лицу.
+func (e EmergencyRepository) FindByContact(ctx context.Context, contactUUID string) ([]*model.EmergencyAccess, error) {
+	return e.findAll(ctx, emergencySelect+"where e.contact_uuid = $1 order by o.login", contactUUID)
+}
+
+// UpdateStatus обновляет состояние доступа и время запроса и открытия.
+func (e EmergencyRepository) UpdateStatus(ctx context.Context, access model.EmergencyAccess) error {
+	res, err := e.pgxpool.Exec(
+		ctx,
+		"update emergency_access set status = $3, requested_at = $4, release_at = $5 "+
+			"where owner_uuid = $1 and contact_uuid = $2",
+		access.OwnerUUID,
+		access.ContactUUID,
+		access.Status,
+		utcOrNil(access.RequestedAt),
+		utcOrNil(access.ReleaseAt),
+	)
+
+	if err != nil {
+		return err
+	}
+
+	if res.RowsAffected() > 0 {
+		return nil
+	}
+
+	return repository.ErrNotFound
+}
+
+// Delete удаляет доступ.
+func (e EmergencyRepository) Delete(ctx context.Context, ownerUUID, contactUUID string) error {
+	res, err := e.pgxpool.Exec(
+		ctx,
+		"delete from emergency_access where owner_uuid = $1 and contact_uuid = $2",
+		ownerUUID,
+		contactUUID,
+	)
+
+	if err != nil {
+		return err
+	}
+
+	if res.RowsAffected() > 0 {
+		return nil
+	}
+
+	return repository.ErrNotFound
+}
+
+// ReleaseExpired открывает запрошенные доступы, период ожидания которых истек к моменту now.
+func (e EmergencyRepository) ReleaseExpired(ctx context.Context, now time.Time) (int64, error) {
+	res, err := e.pgxpool.Exec(
+		ctx,
+		"update emergency_access set status = 'RELEASED' where status = 'REQUESTED' and release_at <= $1",
+		now.UTC(),
+	)
+
+	if err != nil {
+		return 0, err
+	}
+
+	return res.RowsAffected(), nil
+}
+
+// findAll выбирает доступы по запросу query.
+func (e EmergencyRepository) findAll(ctx context.Context, query string, args ...any) ([]*model.EmergencyAccess, error) {
+	accesses := make([]*model.EmergencyAccess, 0)
+
+	rows, err := e.pgxpool.Query(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	for rows.Next() {
+		access, err := scanEmergencyAccess(rows)
+		if err == nil {
+			accesses = append(accesses, access)
+		}
+	}
+
+	return accesses, nil
+}
+
+// scanEmergencyAccess считывает доступ из строки результата запроса emergencySelect.
+func scanEmergencyAccess(row pgx.Row) (*model.EmergencyAccess, error) {
+	access := &model.EmergencyAccess{}
+	err := row.Scan(
+		&access.OwnerUUID,
+		&access.OwnerLogin,
+		&access.ContactUUID,
+		&access.ContactLogin,
+		&access.WrappedKey,
+		&access.WaitPeriod,
+		&access.Status,
+		&access.RequestedAt,
+		&access.ReleaseAt,
+		&access.CreatedAt,
+	)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return access, nil
+}
+
+// utcOrNil возвращает время в UTC, или nil для незаданного времени.
+func utcOrNil(t *time.Time) *time.Time {
+	if t == nil {
+		return nil
+	}
+	utc := t.UTC()
+	return &utc
+}
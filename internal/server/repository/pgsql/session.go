@@ -0,0 +1,63 @@
+package pgsql
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/casnerano/seckeep/internal/server/repository"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// SessionRepository структура репозитория работы с отозванными сессиями.
+type SessionRepository struct {
+	pgxpool *pgxpool.Pool
+}
+
+// NewSessionRepository конструктор.
+func NewSessionRepository(pgxpool *pgxpool.Pool) repository.Session {
+	return &SessionRepository{pgxpool}
+}
+
+// Revoke отзывает сессию до момента истечения её токена.
+// Попутно удаляет записи об уже истекших сессиях.
+func (s SessionRepository) Revoke(ctx context.Context, userUUID, sessionID string, expiresAt time.Time) error {
+	if _, err := s.pgxpool.Exec(ctx, "delete from revoked_sessions where expires_at < $1", time.Now().UTC()); err != nil {
+		return err
+	}
+
+	_, err := s.pgxpool.Exec(
+		ctx,
+		"insert into revoked_sessions(session_id, user_uuid, expires_at) values($1, $2, $3) on conflict do nothing",
+		sessionID,
+		userUUID,
+		expiresAt.UTC(),
+	)
+
+	return err
+}
+
+// State возвращает текущую версию токенов пользователя и признак отзыва сессии одним запросом.
+func (s SessionRepository) State(ctx context.Context, userUUID, sessionID string) (int, bool, error) {
+	var (
+		tokenVersion int
+		revoked      bool
+	)
+
+	err := s.pgxpool.QueryRow(
+		ctx,
+		"select token_version, exists(select 1 from revoked_sessions where session_id = $2) from users where uuid = $1",
+		userUUID,
+		sessionID,
+	).Scan(&tokenVersion, &revoked)
+
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			err = repository.ErrNotFound
+		}
+		return 0, false, err
+	}
+
+	return tokenVersion, revoked, nil
+}
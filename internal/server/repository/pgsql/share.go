@@ -0,0 +1,101 @@
+package pgsql
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/casnerano/seckeep/internal/server/model"
+	"github.com/casnerano/seckeep/internal/server/repository"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// pgInvalidTextRepresentation код ошибки PostgreSQL для значения некорректного формата (например, UUID).
+const pgInvalidTextRepresentation = "22P02"
+
+// ShareRepository структура репозитория работы со ссылками на секреты.
+type ShareRepository struct {
+	pgxpool *pgxpool.Pool
+}
+
+// NewShareRepository конструктор.
+func NewShareRepository(pgxpool *pgxpool.Pool) repository.Share {
+	return &ShareRepository{pgxpool}
+}
+
+// Add добавляет ссылку.
+func (s ShareRepository) Add(ctx context.Context, userUUID string, share model.Share) (*model.Share, error) {
+	err := s.pgxpool.QueryRow(
+		ctx,
+		"insert into shares(user_uuid, value, views_left, expires_at) values($1, $2, $3, $4) returning uuid, created_at",
+		userUUID,
+		share.Value,
+		share.ViewsLeft,
+		share.ExpiresAt.UTC(),
+	).Scan(
+		&share.UUID,
+		&share.CreatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &share, nil
+}
+
+// Take возвращает ссылку, уменьшая число оставшихся просмотров,
+// после последнего просмотра ссылка удаляется. Истекшие ссылки не возвращаются.
+func (s ShareRepository) Take(ctx context.Context, uuid string) (*model.Share, error) {
+	tx, err := s.pgxpool.Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	share := model.Share{}
+	err = tx.QueryRow(
+		ctx,
+		`update shares set views_left = views_left - 1
+		where uuid = $1 and expires_at > $2 and views_left > 0
+		returning uuid, value, views_left, expires_at, created_at`,
+		uuid,
+		time.Now().UTC(),
+	).Scan(
+		&share.UUID,
+		&share.Value,
+		&share.ViewsLeft,
+		&share.ExpiresAt,
+		&share.CreatedAt,
+	)
+
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.Is(err, pgx.ErrNoRows) || (errors.As(err, &pgErr) && pgErr.Code == pgInvalidTextRepresentation) {
+			err = repository.ErrNotFound
+		}
+		return nil, err
+	}
+
+	if share.ViewsLeft == 0 {
+		if _, err = tx.Exec(ctx, "delete from shares where uuid = $1", uuid); err != nil {
+			return nil, err
+		}
+	}
+
+	if err = tx.Commit(ctx); err != nil {
+		return nil, err
+	}
+
+	return &share, nil
+}
+
+// DeleteExpired удаляет ссылки, срок действия которых истек до момента before.
+func (s ShareRepository) DeleteExpired(ctx context.Context, before time.Time) (int64, error) {
+	tag, err := s.pgxpool.Exec(ctx, "delete from shares where expires_at < $1", before.UTC())
+	if err != nil {
+		return 0, err
+	}
+	return tag.RowsAffected(), nil
+}
@@ -52,13 +52,20 @@ func (u UserRepository) FindByLogin(ctx context.Context, login string) (*model.U
 	user := model.User{Login: login}
 	err := u.pgxpool.QueryRow(
 		ctx,
-		"select uuid, password, full_name, created_at from users where login = $1",
+		"select uuid, password, full_name, created_at, token_version, encryption_key, signing_key, private_keys, "+
+			"recovery_verifier, recovery_key from users where login = $1",
 		login,
 	).Scan(
 		&user.UUID,
 		&user.Password,
 		&user.FullName,
 		&user.CreatedAt,
+		&user.TokenVersion,
+		&user.Keys.EncryptionKey,
+		&user.Keys.SigningKey,
+		&user.Keys.PrivateKeys,
+		&user.Recovery.Verifier,
+		&user.Recovery.WrappedKey,
 	)
 
 	if err != nil {
@@ -76,13 +83,20 @@ func (u UserRepository) FindByUUID(ctx context.Context, uuid string) (*model.Use
 	user := model.User{UUID: uuid}
 	err := u.pgxpool.QueryRow(
 		ctx,
-		"select login, password, full_name, created_at from users where uuid = $1",
+		"select login, password, full_name, created_at, token_version, encryption_key, signing_key, private_keys, "+
+			"recovery_verifier, recovery_key from users where uuid = $1",
 		uuid,
 	).Scan(
 		&user.Login,
 		&user.Password,
 		&user.FullName,
 		&user.CreatedAt,
+		&user.TokenVersion,
+		&user.Keys.EncryptionKey,
+		&user.Keys.SigningKey,
+		&user.Keys.PrivateKeys,
+		&user.Recovery.Verifier,
+		&user.Recovery.WrappedKey,
 	)
 
 	if err != nil {
@@ -94,3 +108,115 @@ func (u UserRepository) FindByUUID(ctx context.Context, uuid string) (*model.Use
 
 	return &user, nil
 }
+
+// UpdatePassword обновляет пароль и увеличивает версию токенов, инвалидируя ранее выданные.
+func (u UserRepository) UpdatePassword(ctx context.Context, uuid, password string) (*model.User, error) {
+	user := model.User{UUID: uuid, Password: password}
+	err := u.pgxpool.QueryRow(
+		ctx,
+		"update users set password = $1, token_version = token_version + 1 where uuid = $2 "+
+			"returning login, full_name, created_at, token_version",
+		password,
+		uuid,
+	).Scan(
+		&user.Login,
+		&user.FullName,
+		&user.CreatedAt,
+		&user.TokenVersion,
+	)
+
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			err = repository.ErrNotFound
+		}
+		return nil, err
+	}
+
+	return &user, nil
+}
+
+// UpdateKeys обновляет открытые и зашифрованные закрытые ключи.
+func (u UserRepository) UpdateKeys(ctx context.Context, uuid string, keys model.UserKeys) error {
+	res, err := u.pgxpool.Exec(
+		ctx,
+		"update users set encryption_key = $1, signing_key = $2, private_keys = $3 where uuid = $4",
+		keys.EncryptionKey,
+		keys.SigningKey,
+		keys.PrivateKeys,
+		uuid,
+	)
+
+	if err != nil {
+		return err
+	}
+
+	if res.RowsAffected() > 0 {
+		return nil
+	}
+
+	return repository.ErrNotFound
+}
+
+// UpdateRecovery обновляет проверочное значение и зашифрованный ключом восстановления ключ данных.
+func (u UserRepository) UpdateRecovery(ctx context.Context, uuid string, recovery model.UserRecovery) error {
+	res, err := u.pgxpool.Exec(
+		ctx,
+		"update users set recovery_verifier = $1, recovery_key = $2 where uuid = $3",
+		recovery.Verifier,
+		recovery.WrappedKey,
+		uuid,
+	)
+
+	if err != nil {
+		return err
+	}
+
+	if res.RowsAffected() > 0 {
+		return nil
+	}
+
+	return repository.ErrNotFound
+}
+
+// Delete удаляет запись вместе со всеми личными секретными данными пользователя
+// и общими хранилищами, в которых не остается других владельцев (каскадно, с их записями).
+func (u UserRepository) Delete(ctx context.Context, uuid string) error {
+	tx, err := u.pgxpool.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	_, err = tx.Exec(
+		ctx,
+		"delete from vaults v where exists ("+
+			"select 1 from vault_members m where m.vault_uuid = v.uuid and m.user_uuid = $1 and m.role = 'OWNER'"+
+			") and not exists ("+
+			"select 1 from vault_members m where m.vault_uuid = v.uuid and m.user_uuid <> $1 and m.role = 'OWNER'"+
+			")",
+		uuid,
+	)
+	if err != nil {
+		return err
+	}
+
+	if _, err = tx.Exec(ctx, "delete from data where user_uuid = $1 and vault_uuid is null", uuid); err != nil {
+		return err
+	}
+
+	res, err := tx.Exec(
+		ctx,
+		"delete from users where uuid = $1",
+		uuid,
+	)
+
+	if err != nil {
+		return err
+	}
+
+	if res.RowsAffected() == 0 {
+		return repository.ErrNotFound
+	}
+
+	return tx.Commit(ctx)
+}
@@ -0,0 +1,192 @@
+package pgsql
+
+import (
+	"context"
+	"errors"
+
+	"github.com/casnerano/seckeep/internal/server/model"
+	"github.com/casnerano/seckeep/internal/server/repository"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// VaultRepository структура репозитория работы с общими хранилищами.
+type VaultRepository struct {
+	pgxpool *pgxpool.Pool
+}
+
+// NewVaultRepository конструктор.
+func NewVaultRepository(pgxpool *pgxpool.Pool) repository.Vault {
+	return &VaultRepository{pgxpool}
+}
+
+// Add добавляет хранилище, создатель становится его владельцем.
+func (v VaultRepository) Add(ctx context.Context, name, ownerUUID string, wrappedKey []byte) (*model.Vault, error) {
+	vault := model.Vault{Name: name, Role: model.VaultRoleOwner, WrappedKey: wrappedKey}
+
+	tx, err := v.pgxpool.Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	err = tx.QueryRow(
+		ctx,
+		"insert into vaults(name) values($1) returning uuid, created_at",
+		name,
+	).Scan(
+		&vault.UUID,
+		&vault.CreatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = tx.Exec(
+		ctx,
+		"insert into vault_members(vault_uuid, user_uuid, role, wrapped_key) values($1, $2, $3, $4)",
+		vault.UUID,
+		ownerUUID,
+		vault.Role,
+		wrappedKey,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = tx.Commit(ctx); err != nil {
+		return nil, err
+	}
+
+	return &vault, nil
+}
+
+// FindByUserUUID ищет хранилища, участником которых является пользователь.
+func (v VaultRepository) FindByUserUUID(ctx context.Context, userUUID string) ([]*model.Vault, error) {
+	vaults := make([]*model.Vault, 0)
+
+	rows, err := v.pgxpool.Query(
+		ctx,
+		"select v.uuid, v.name, m.role, m.wrapped_key, v.created_at from vaults v "+
+			"join vault_members m on m.vault_uuid = v.uuid where m.user_uuid = $1 order by v.name",
+		userUUID,
+	)
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	for rows.Next() {
+		vault := &model.Vault{}
+		err = rows.Scan(
+			&vault.UUID,
+			&vault.Name,
+			&vault.Role,
+			&vault.WrappedKey,
+			&vault.CreatedAt,
+		)
+		if err == nil {
+			vaults = append(vaults, vault)
+		}
+	}
+
+	return vaults, nil
+}
+
+// FindMember ищет участника хранилища.
+func (v VaultRepository) FindMember(ctx context.Context, vaultUUID, userUUID string) (*model.VaultMember, error) {
+	member := model.VaultMember{VaultUUID: vaultUUID, UserUUID: userUUID}
+	err := v.pgxpool.QueryRow(
+		ctx,
+		"select u.login, u.full_name, m.role, m.wrapped_key, m.created_at from vault_members m "+
+			"join users u on u.uuid = m.user_uuid where m.vault_uuid = $1 and m.user_uuid = $2",
+		vaultUUID,
+		userUUID,
+	).Scan(
+		&member.Login,
+		&member.FullName,
+		&member.Role,
+		&member.WrappedKey,
+		&member.CreatedAt,
+	)
+
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			err = repository.ErrNotFound
+		}
+		return nil, err
+	}
+
+	return &member, nil
+}
+
+// FindMembers ищет всех участников хранилища.
+func (v VaultRepository) FindMembers(ctx context.Context, vaultUUID string) ([]*model.VaultMember, error) {
+	members := make([]*model.VaultMember, 0)
+
+	rows, err := v.pgxpool.Query(
+		ctx,
+		"select m.user_uuid, u.login, u.full_name, m.role, m.created_at from vault_members m "+
+			"join users u on u.uuid = m.user_uuid where m.vault_uuid = $1 order by m.created_at",
+		vaultUUID,
+	)
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	for rows.Next() {
+		member := &model.VaultMember{VaultUUID: vaultUUID}
+		err = rows.Scan(
+			&member.UserUUID,
+			&member.Login,
+			&member.FullName,
+			&member.Role,
+			&member.CreatedAt,
+		)
+		if err == nil {
+			members = append(members, member)
+		}
+	}
+
+	return members, nil
+}
+
+// SaveMember добавляет участника, или обновляет роль и ключ существующего.
+func (v VaultRepository) SaveMember(ctx context.Context, member model.VaultMember) error {
+	_, err := v.pgxpool.Exec(
+		ctx,
+		"insert into vault_members(vault_uuid, user_uuid, role, wrapped_key) values($1, $2, $3, $4) "+
+			"on conflict (vault_uuid, user_uuid) do update set role = excluded.role, wrapped_key = excluded.wrapped_key",
+		member.VaultUUID,
+		member.UserUUID,
+		member.Role,
+		member.WrappedKey,
+	)
+
+	return err
+}
+
+// DeleteMember удаляет участника.
+func (v VaultRepository) DeleteMember(ctx context.Context, vaultUUID, userUUID string) error {
+	res, err := v.pgxpool.Exec(
+		ctx,
+		"delete from vault_members where vault_uuid = $1 and user_uuid = $2",
+		vaultUUID,
+		userUUID,
+	)
+
+	if err != nil {
+		return err
+	}
+
+	if res.RowsAffected() > 0 {
+		return nil
+	}
+
+	return repository.ErrNotFound
+}
@@ -35,22 +35,119 @@ type User interface {
 
 	// FindByUUID ищет запись по UUID.
 	FindByUUID(ctx context.Context, uuid string) (*model.User, error)
+
+	// UpdatePassword обновляет пароль и увеличивает версию токенов, инвалидируя ранее выданные.
+	UpdatePassword(ctx context.Context, uuid, password string) (*model.User, error)
+
+	// UpdateKeys обновляет открытые и зашифрованные закрытые ключи.
+	UpdateKeys(ctx context.Context, uuid string, keys model.UserKeys) error
+
+	// UpdateRecovery обновляет проверочное значение и зашифрованный ключом восстановления ключ данных.
+	UpdateRecovery(ctx context.Context, uuid string, recovery model.UserRecovery) error
+
+	// Delete удаляет запись вместе со всеми личными секретными данными пользователя
+	// и общими хранилищами, в которых не остается других владельцев.
+	Delete(ctx context.Context, uuid string) error
+}
+
+// Session интерфейс работы с отозванными сессиями (токенами) пользователей.
+type Session interface {
+	// Revoke отзывает сессию до момента истечения её токена.
+	Revoke(ctx context.Context, userUUID, sessionID string, expiresAt time.Time) error
+
+	// State возвращает текущую версию токенов пользователя и признак отзыва сессии.
+	State(ctx context.Context, userUUID, sessionID string) (tokenVersion int, revoked bool, err error)
 }
 
 // Data интерфейс работы с записями секретных данных.
+// Записи выбираются в области видимости scope: личные данные пользователя или данные общего хранилища.
+// Удаленные записи попадают в корзину и выбираются только методами работы с корзиной.
 type Data interface {
 	// Add добавляет запись.
 	Add(ctx context.Context, data smodel.Data) (*smodel.Data, error)
 
 	// FindByUUID ищет запись по UUID.
-	FindByUUID(ctx context.Context, userUUID string, uuid string) (*smodel.Data, error)
+	FindByUUID(ctx context.Context, scope model.DataScope, uuid string) (*smodel.Data, error)
+
+	// FindByScope ищет все записи области видимости.
+	FindByScope(ctx context.Context, scope model.DataScope) ([]*smodel.Data, error)
+
+	// Update обновляет запись, сохраняя прежнее значение в истории ревизий записи.
+	Update(ctx context.Context, scope model.DataScope, uuid string, value []byte, version time.Time) (*smodel.Data, error)
+
+	// History ищет ревизии записи, начиная с последней.
+	History(ctx context.Context, scope model.DataScope, uuid string) ([]*smodel.DataRevision, error)
+
+	// Delete перемещает запись в корзину.
+	Delete(ctx context.Context, scope model.DataScope, uuid string) error
+
+	// FindTrash ищет записи области видимости в корзине, начиная с последней удаленной.
+	FindTrash(ctx context.Context, scope model.DataScope) ([]*smodel.Data, error)
+
+	// Restore восстанавливает запись из корзины.
+	Restore(ctx context.Context, scope model.DataScope, uuid string) (*smodel.Data, error)
+
+	// EmptyTrash окончательно удаляет записи области видимости из корзины.
+	EmptyTrash(ctx context.Context, scope model.DataScope) (int64, error)
+
+	// PurgeTrash окончательно удаляет записи всех пользователей, перемещенные в корзину до момента before.
+	PurgeTrash(ctx context.Context, before time.Time) (int64, error)
+}
+
+// Vault интерфейс работы с общими хранилищами и их участниками.
+type Vault interface {
+	// Add добавляет хранилище, создатель становится его владельцем.
+	Add(ctx context.Context, name, ownerUUID string, wrappedKey []byte) (*model.Vault, error)
+
+	// FindByUserUUID ищет хранилища, участником которых является пользователь.
+	FindByUserUUID(ctx context.Context, userUUID string) ([]*model.Vault, error)
+
+	// FindMember ищет участника хранилища.
+	FindMember(ctx context.Context, vaultUUID, userUUID string) (*model.VaultMember, error)
+
+	// FindMembers ищет всех участников хранилища.
+	FindMembers(ctx context.Context, vaultUUID string) ([]*model.VaultMember, error)
+
+	// SaveMember добавляет участника, или обновляет роль и ключ существующего.
+	SaveMember(ctx context.Context, member model.VaultMember) error
+
+	// DeleteMember удаляет участника.
+	DeleteMember(ctx context.Context, vaultUUID, userUUID string) error
+}
+
+// Share интерфейс работы со ссылками на секреты.
+type Share interface {
+	// Add добавляет ссылку.
+	Add(ctx context.Context, userUUID string, share model.Share) (*model.Share, error)
+
+	// Take возвращает ссылку, уменьшая число оставшихся просмотров,
+	// после последнего просмотра ссылка удаляется. Истекшие ссылки не возвращаются.
+	Take(ctx context.Context, uuid string) (*model.Share, error)
+
+	// DeleteExpired удаляет ссылки, срок действия которых истек до момента before.
+	DeleteExpired(ctx context.Context, before time.Time) (int64, error)
+}
+
+// Emergency интерфейс работы с экстренным доступом доверенных лиц.
+type Emergency interface {
+	// Save добавляет доступ, или обновляет ключ и период ожидания существующего со сбросом запроса.
+	Save(ctx context.Context, access model.EmergencyAccess) (*model.EmergencyAccess, error)
+
+	// Find ищет доступ доверенного лица к данным владельца.
+	Find(ctx context.Context, ownerUUID, contactUUID string) (*model.EmergencyAccess, error)
+
+	// FindByOwner ищет все доступы, выданные владельцем.
+	FindByOwner(ctx context.Context, ownerUUID string) ([]*model.EmergencyAccess, error)
+
+	// FindByContact ищет все доступы, выданные доверенному лицу.
+	FindByContact(ctx context.Context, contactUUID string) ([]*model.EmergencyAccess, error)
 
-	// FindByUserUUID ищет запись по UUID пользователя.
-	FindByUserUUID(ctx context.Context, userUUID string) ([]*smodel.Data, error)
+	// UpdateStatus обновляет состояние доступа и время запроса и открытия.
+	UpdateStatus(ctx context.Context, access model.EmergencyAccess) error
 
-	// Update обновляет запись.
-	Update(ctx context.Context, userUUID string, uuid string, value []byte, version time.Time) (*smodel.Data, error)
+	// Delete удаляет доступ.
+	Delete(ctx context.Context, ownerUUID, contactUUID string) error
 
-	// Delete удаляет запись.
-	Delete(ctx context.Context, userUUID string, uuid string) error
+	// ReleaseExpired открывает запрошенные доступы, период ожидания которых истек к моменту now.
+	ReleaseExpired(ctx context.Context, now time.Time) (int64, error)
 }
@@ -4,7 +4,12 @@ package account
 //go:generate mockgen -destination=mock/account.go -source=account.go
 
 import (
+	"bytes"
 	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
 	"errors"
 	"time"
 
@@ -16,6 +21,9 @@ import (
 
 const (
 	jwtTTL = 15 * time.Minute
+
+	// sessionIDLength длина (в байтах) случайного идентификатора сессии.
+	sessionIDLength = 16
 )
 
 // Основные ошибки при работе с аккантом.
@@ -28,26 +36,40 @@ var (
 
 	// ErrUserNotFound пользователь не найден.
 	ErrUserNotFound = errors.New("user not found")
+
+	// ErrSessionExpired сессия устарела (например, после смены пароля).
+	ErrSessionExpired = errors.New("session expired")
+
+	// ErrSessionRevoked сессия отозвана (выход из аккаунта).
+	ErrSessionRevoked = errors.New("session revoked")
+
+	// ErrKeysNotFound пользователь не опубликовал ключи.
+	ErrKeysNotFound = errors.New("keys not found")
+
+	// ErrKeysExist у пользователя уже есть другие ключи.
+	ErrKeysExist = errors.New("keys already exist")
 )
 
 // JWT интерфейс работы с JWT токеном.
 type JWT interface {
-	Create(payload jwtoken.Payload, ttl time.Duration, secret []byte) (string, error)
+	Create(payload jwtoken.Payload, ttl time.Duration) (string, error)
 }
 
 // Account структура для работы с аккантом пользователя.
 type Account struct {
-	repo   repository.User
-	jwt    JWT
-	secret string
+	repo        repository.User
+	dataRepo    repository.Data
+	sessionRepo repository.Session
+	jwt         JWT
 }
 
 // New конструктор.
-func New(repo repository.User, jwt JWT, secret string) *Account {
+func New(repo repository.User, dataRepo repository.Data, sessionRepo repository.Session, jwt JWT) *Account {
 	return &Account{
-		repo:   repo,
-		jwt:    jwt,
-		secret: secret,
+		repo:        repo,
+		dataRepo:    dataRepo,
+		sessionRepo: sessionRepo,
+		jwt:         jwt,
 	}
 }
 
@@ -87,7 +109,244 @@ func (a Account) SignUp(ctx context.Context, login, password, fullName string) (
 	return a.createTokenForUser(user)
 }
 
-// createTokenForUser метод генерирует токен для заданного пользователя.
+// ChangePassword метод смены пароля.
+// Все ранее выданные токены пользователя становятся недействительными, возвращается новый токен.
+func (a Account) ChangePassword(ctx context.Context, userUUID, oldPassword, newPassword string) (string, error) {
+	if _, err := a.findAndCheckPassword(ctx, userUUID, oldPassword); err != nil {
+		return "", err
+	}
+
+	hashedPassword, err := bcrypt.GenerateFromPassword([]byte(newPassword), bcrypt.DefaultCost)
+	if err != nil {
+		return "", err
+	}
+
+	user, err := a.repo.UpdatePassword(ctx, userUUID, string(hashedPassword))
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return "", ErrUserNotFound
+		}
+		return "", err
+	}
+
+	return a.createTokenForUser(user)
+}
+
+// Delete метод удаления аккаунта вместе со всеми секретными данными.
+func (a Account) Delete(ctx context.Context, userUUID, password string) error {
+	if _, err := a.findAndCheckPassword(ctx, userUUID, password); err != nil {
+		return err
+	}
+
+	if err := a.repo.Delete(ctx, userUUID); err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return ErrUserNotFound
+		}
+		return err
+	}
+
+	return nil
+}
+
+// Export метод выгружает все данные аккаунта, хранящиеся на сервере.
+func (a Account) Export(ctx context.Context, userUUID string) (*model.AccountExport, error) {
+	user, err := a.repo.FindByUUID(ctx, userUUID)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return nil, ErrUserNotFound
+		}
+		return nil, err
+	}
+
+	data, err := a.dataRepo.FindByScope(ctx, model.DataScope{UserUUID: userUUID})
+	if err != nil {
+		return nil, err
+	}
+
+	return &model.AccountExport{
+		User: model.UserProfile{
+			UUID:      user.UUID,
+			Login:     user.Login,
+			FullName:  user.FullName,
+			CreatedAt: user.CreatedAt,
+		},
+		Data:       data,
+		ExportedAt: time.Now(),
+	}, nil
+}
+
+// SetKeys метод публикует ключи пользователя.
+// Открытые ключи нельзя заменить другими: ими зашифрованы ключи общих хранилищ,
+// при тех же открытых ключах обновляются только зашифрованные закрытые ключи.
+func (a Account) SetKeys(ctx context.Context, userUUID string, keys model.UserKeys) error {
+	user, err := a.repo.FindByUUID(ctx, userUUID)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return ErrUserNotFound
+		}
+		return err
+	}
+
+	if len(user.Keys.PrivateKeys) > 0 &&
+		(!bytes.Equal(user.Keys.EncryptionKey, keys.EncryptionKey) || !bytes.Equal(user.Keys.SigningKey, keys.SigningKey)) {
+		return ErrKeysExist
+	}
+
+	if err = a.repo.UpdateKeys(ctx, userUUID, keys); err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return ErrUserNotFound
+		}
+		return err
+	}
+	return nil
+}
+
+// Keys метод возвращает ключи пользователя вместе с зашифрованными закрытыми ключами.
+func (a Account) Keys(ctx context.Context, userUUID string) (*model.UserKeys, error) {
+	user, err := a.repo.FindByUUID(ctx, userUUID)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return nil, ErrUserNotFound
+		}
+		return nil, err
+	}
+
+	if len(user.Keys.PrivateKeys) == 0 {
+		return nil, ErrKeysNotFound
+	}
+
+	keys := user.Keys
+	keys.Login = user.Login
+	return &keys, nil
+}
+
+// PublicKeys метод возвращает опубликованные открытые ключи пользователя по логину.
+func (a Account) PublicKeys(ctx context.Context, login string) (*model.UserKeys, error) {
+	user, err := a.repo.FindByLogin(ctx, login)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return nil, ErrUserNotFound
+		}
+		return nil, err
+	}
+
+	if len(user.Keys.PrivateKeys) == 0 {
+		return nil, ErrKeysNotFound
+	}
+
+	return &model.UserKeys{
+		Login:         user.Login,
+		EncryptionKey: user.Keys.EncryptionKey,
+		SigningKey:    user.Keys.SigningKey,
+	}, nil
+}
+
+// SetRecoveryKey метод сохраняет ключ восстановления пользователя.
+// verifier — проверочное значение, выведенное клиентом из ключа восстановления, хранится только его хеш;
+// wrappedKey — ключ данных, зашифрованный ключом восстановления.
+func (a Account) SetRecoveryKey(ctx context.Context, userUUID string, verifier, wrappedKey []byte) error {
+	hash := sha256.Sum256(verifier)
+	recovery := model.UserRecovery{Verifier: hash[:], WrappedKey: wrappedKey}
+
+	if err := a.repo.UpdateRecovery(ctx, userUUID, recovery); err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return ErrUserNotFound
+		}
+		return err
+	}
+	return nil
+}
+
+// Recover метод восстанавливает доступ по ключу восстановления: устанавливает новый пароль
+// и возвращает ключ данных, зашифрованный ключом восстановления.
+// Ранее выданные токены пользователя становятся недействительными.
+func (a Account) Recover(ctx context.Context, login string, verifier []byte, newPassword string) ([]byte, error) {
+	user, err := a.repo.FindByLogin(ctx, login)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return nil, ErrUserNotFound
+		}
+		return nil, err
+	}
+
+	hash := sha256.Sum256(verifier)
+	if len(user.Recovery.Verifier) == 0 || subtle.ConstantTimeCompare(user.Recovery.Verifier, hash[:]) != 1 {
+		return nil, ErrIncorrectCredentials
+	}
+
+	hashedPassword, err := bcrypt.GenerateFromPassword([]byte(newPassword), bcrypt.DefaultCost)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err = a.repo.UpdatePassword(ctx, user.UUID, string(hashedPassword)); err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return nil, ErrUserNotFound
+		}
+		return nil, err
+	}
+
+	return user.Recovery.WrappedKey, nil
+}
+
+// SignOut метод выхода из аккаунта, отзывает сессию до истечения срока действия её токена.
+func (a Account) SignOut(ctx context.Context, userUUID, sessionID string) error {
+	return a.sessionRepo.Revoke(ctx, userUUID, sessionID, time.Now().Add(jwtTTL))
+}
+
+// ValidateSession метод проверяет, что сессия не отозвана,
+// и токен выдан для актуальной версии учетных данных пользователя.
+func (a Account) ValidateSession(ctx context.Context, payload jwtoken.Payload) error {
+	tokenVersion, revoked, err := a.sessionRepo.State(ctx, payload.UUID, payload.Session)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return ErrUserNotFound
+		}
+		return err
+	}
+
+	if revoked {
+		return ErrSessionRevoked
+	}
+
+	if tokenVersion != payload.Version {
+		return ErrSessionExpired
+	}
+
+	return nil
+}
+
+// findAndCheckPassword метод ищет пользователя по UUID и проверяет пароль.
+func (a Account) findAndCheckPassword(ctx context.Context, userUUID, password string) (*model.User, error) {
+	user, err := a.repo.FindByUUID(ctx, userUUID)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return nil, ErrUserNotFound
+		}
+		return nil, err
+	}
+
+	if err = bcrypt.CompareHashAndPassword([]byte(user.Password), []byte(password)); err != nil {
+		return nil, ErrIncorrectCredentials
+	}
+
+	return user, nil
+}
+
+// createTokenForUser метод генерирует токен новой сессии для заданного пользователя.
 func (a Account) createTokenForUser(user *model.User) (string, error) {
-	return a.jwt.Create(jwtoken.Payload{UUID: user.UUID, FullName: user.FullName}, jwtTTL, []byte(a.secret))
+	bSessionID := make([]byte, sessionIDLength)
+	if _, err := rand.Read(bSessionID); err != nil {
+		return "", err
+	}
+
+	return a.jwt.Create(
+		jwtoken.Payload{
+			UUID:     user.UUID,
+			FullName: user.FullName,
+			Session:  hex.EncodeToString(bSessionID),
+			Version:  user.TokenVersion,
+		},
+		jwtTTL,
+	)
 }
@@ -2,11 +2,14 @@ package account
 
 import (
 	"context"
+	"crypto/sha256"
 	"errors"
+	"fmt"
 	"strings"
 	"testing"
 	"time"
 
+	smodel "github.com/casnerano/seckeep/internal/pkg/model"
 	"github.com/casnerano/seckeep/internal/server/model"
 	"github.com/casnerano/seckeep/internal/server/repository"
 	mock_repository "github.com/casnerano/seckeep/internal/server/repository/mock"
@@ -21,12 +24,31 @@ var (
 	errUnknown = errors.New("unknown error")
 )
 
+// payloadMatcher сравнивает полезные данные токена без учета случайного идентификатора сессии.
+type payloadMatcher struct {
+	want jwtoken.Payload
+}
+
+func (m payloadMatcher) Matches(x interface{}) bool {
+	got, ok := x.(jwtoken.Payload)
+	if !ok || got.Session == "" {
+		return false
+	}
+	got.Session = m.want.Session
+	return got == m.want
+}
+
+func (m payloadMatcher) String() string {
+	return fmt.Sprintf("payload %+v with any session", m.want)
+}
+
 type AccountTestSuite struct {
 	suite.Suite
 	accountService *Account
 	userRepo       *mock_repository.MockUser
+	dataRepo       *mock_repository.MockData
+	sessionRepo    *mock_repository.MockSession
 	jwt            *mock_account.MockJWT
-	secret         string
 }
 
 func (s *AccountTestSuite) SetupSuite() {
@@ -34,10 +56,11 @@ func (s *AccountTestSuite) SetupSuite() {
 	defer ctrl.Finish()
 
 	s.userRepo = mock_repository.NewMockUser(ctrl)
+	s.dataRepo = mock_repository.NewMockData(ctrl)
+	s.sessionRepo = mock_repository.NewMockSession(ctrl)
 	s.jwt = mock_account.NewMockJWT(ctrl)
-	s.secret = "for-example-secret"
 
-	s.accountService = New(s.userRepo, s.jwt, s.secret)
+	s.accountService = New(s.userRepo, s.dataRepo, s.sessionRepo, s.jwt)
 }
 
 func (s *AccountTestSuite) TestSignUp() {
@@ -58,7 +81,7 @@ func (s *AccountTestSuite) TestSignUp() {
 		wantToken := "eyJhbGci.e30.Et9HFtf9R3GEM"
 
 		s.userRepo.EXPECT().Add(gomock.Any(), user.Login, gomock.Any(), user.FullName).Return(&user, nil)
-		s.jwt.EXPECT().Create(jwtPayload, jwtTTL, []byte(s.secret)).Return(wantToken, nil)
+		s.jwt.EXPECT().Create(payloadMatcher{jwtPayload}, jwtTTL).Return(wantToken, nil)
 
 		gotToken, err := s.accountService.SignUp(context.Background(), user.Login, user.Password, user.FullName)
 		s.Require().NoError(err)
@@ -114,7 +137,7 @@ func (s *AccountTestSuite) TestSignIn() {
 		user.Password = string(hashedPassword)
 
 		s.userRepo.EXPECT().FindByLogin(gomock.Any(), user.Login).Return(&user, nil)
-		s.jwt.EXPECT().Create(jwtPayload, jwtTTL, []byte(s.secret)).Return(wantToken, nil)
+		s.jwt.EXPECT().Create(payloadMatcher{jwtPayload}, jwtTTL).Return(wantToken, nil)
 
 		gotToken, err := s.accountService.SignIn(context.Background(), user.Login, rawPassword)
 		s.Require().NoError(err)
@@ -147,6 +170,264 @@ func (s *AccountTestSuite) TestSignIn() {
 	})
 }
 
+func (s *AccountTestSuite) hashedUser(rawPassword string) model.User {
+	hashedPassword, err := bcrypt.GenerateFromPassword([]byte(rawPassword), bcrypt.MinCost)
+	s.Require().NoError(err)
+
+	return model.User{
+		UUID:         "f9bd9622-f730-11ed-b67e-0242ac120002",
+		Login:        "ivan",
+		Password:     string(hashedPassword),
+		FullName:     "Ivanov Ivan",
+		CreatedAt:    time.Now(),
+		TokenVersion: 1,
+	}
+}
+
+func (s *AccountTestSuite) TestChangePassword() {
+	rawPassword := "iVm20%02fD5O"
+	newPassword := "n3w-Pa$$word"
+	user := s.hashedUser(rawPassword)
+
+	s.Run("Correct old password", func() {
+		updatedUser := user
+		updatedUser.TokenVersion++
+
+		wantToken := "eyJhbGci.e30.Et9HFtf9R3GEM"
+
+		s.userRepo.EXPECT().FindByUUID(gomock.Any(), user.UUID).Return(&user, nil)
+		s.userRepo.EXPECT().UpdatePassword(gomock.Any(), user.UUID, gomock.Any()).Return(&updatedUser, nil)
+		s.jwt.EXPECT().Create(payloadMatcher{jwtoken.Payload{UUID: user.UUID, FullName: user.FullName, Version: 2}}, jwtTTL).Return(wantToken, nil)
+
+		gotToken, err := s.accountService.ChangePassword(context.Background(), user.UUID, rawPassword, newPassword)
+		s.Require().NoError(err)
+
+		s.Equal(wantToken, gotToken)
+	})
+
+	s.Run("Incorrect old password", func() {
+		s.userRepo.EXPECT().FindByUUID(gomock.Any(), user.UUID).Return(&user, nil)
+
+		gotToken, err := s.accountService.ChangePassword(context.Background(), user.UUID, rawPassword+"typo", newPassword)
+
+		s.Empty(gotToken)
+		s.ErrorIs(err, ErrIncorrectCredentials)
+	})
+
+	s.Run("Non-existing user", func() {
+		s.userRepo.EXPECT().FindByUUID(gomock.Any(), user.UUID).Return(nil, repository.ErrNotFound)
+
+		gotToken, err := s.accountService.ChangePassword(context.Background(), user.UUID, rawPassword, newPassword)
+
+		s.Empty(gotToken)
+		s.ErrorIs(err, ErrUserNotFound)
+	})
+}
+
+func (s *AccountTestSuite) TestDelete() {
+	rawPassword := "iVm20%02fD5O"
+	user := s.hashedUser(rawPassword)
+
+	s.Run("Correct password", func() {
+		s.userRepo.EXPECT().FindByUUID(gomock.Any(), user.UUID).Return(&user, nil)
+		s.userRepo.EXPECT().Delete(gomock.Any(), user.UUID).Return(nil)
+
+		s.NoError(s.accountService.Delete(context.Background(), user.UUID, rawPassword))
+	})
+
+	s.Run("Incorrect password", func() {
+		s.userRepo.EXPECT().FindByUUID(gomock.Any(), user.UUID).Return(&user, nil)
+
+		s.ErrorIs(s.accountService.Delete(context.Background(), user.UUID, rawPassword+"typo"), ErrIncorrectCredentials)
+	})
+
+	s.Run("Unknown error", func() {
+		s.userRepo.EXPECT().FindByUUID(gomock.Any(), user.UUID).Return(&user, nil)
+		s.userRepo.EXPECT().Delete(gomock.Any(), user.UUID).Return(errUnknown)
+
+		s.ErrorIs(s.accountService.Delete(context.Background(), user.UUID, rawPassword), errUnknown)
+	})
+}
+
+func (s *AccountTestSuite) TestExport() {
+	user := s.hashedUser("iVm20%02fD5O")
+	data := []*smodel.Data{
+		{UUID: "9b92672a-f7fe-11ed-b67e-0242ac120002", UserUUID: user.UUID, Type: smodel.DataTypeText},
+	}
+
+	s.Run("Existing user", func() {
+		s.userRepo.EXPECT().FindByUUID(gomock.Any(), user.UUID).Return(&user, nil)
+		s.dataRepo.EXPECT().FindByScope(gomock.Any(), model.DataScope{UserUUID: user.UUID}).Return(data, nil)
+
+		export, err := s.accountService.Export(context.Background(), user.UUID)
+		s.Require().NoError(err)
+
+		s.Equal(user.Login, export.User.Login)
+		s.Equal(data, export.Data)
+	})
+
+	s.Run("Data repository error", func() {
+		s.userRepo.EXPECT().FindByUUID(gomock.Any(), user.UUID).Return(&user, nil)
+		s.dataRepo.EXPECT().FindByScope(gomock.Any(), model.DataScope{UserUUID: user.UUID}).Return(nil, errUnknown)
+
+		_, err := s.accountService.Export(context.Background(), user.UUID)
+		s.ErrorIs(err, errUnknown)
+	})
+}
+
+func (s *AccountTestSuite) TestValidateSession() {
+	user := s.hashedUser("iVm20%02fD5O")
+
+	s.Run("Actual version", func() {
+		s.sessionRepo.EXPECT().State(gomock.Any(), user.UUID, "").Return(1, false, nil)
+		s.NoError(s.accountService.ValidateSession(context.Background(), jwtoken.Payload{UUID: user.UUID, Version: 1}))
+	})
+
+	s.Run("Outdated version", func() {
+		s.sessionRepo.EXPECT().State(gomock.Any(), user.UUID, "").Return(1, false, nil)
+		err := s.accountService.ValidateSession(context.Background(), jwtoken.Payload{UUID: user.UUID, Version: 0})
+		s.ErrorIs(err, ErrSessionExpired)
+	})
+
+	s.Run("Deleted user", func() {
+		s.sessionRepo.EXPECT().State(gomock.Any(), user.UUID, "").Return(0, false, repository.ErrNotFound)
+		err := s.accountService.ValidateSession(context.Background(), jwtoken.Payload{UUID: user.UUID, Version: 1})
+		s.ErrorIs(err, ErrUserNotFound)
+	})
+}
+
+func (s *AccountTestSuite) TestSignOut() {
+	userUUID := "f9bd9622-f730-11ed-b67e-0242ac120002"
+	sessionID := "8f1b0f5c3c2a4d7e9b6a1c0d2e3f4a5b"
+
+	s.Run("Revoke session", func() {
+		s.sessionRepo.EXPECT().Revoke(gomock.Any(), userUUID, sessionID, gomock.Any()).Return(nil)
+		s.NoError(s.accountService.SignOut(context.Background(), userUUID, sessionID))
+	})
+
+	s.Run("Revoked session is invalid", func() {
+		s.sessionRepo.EXPECT().State(gomock.Any(), userUUID, sessionID).Return(0, true, nil)
+		err := s.accountService.ValidateSession(context.Background(), jwtoken.Payload{UUID: userUUID, Session: sessionID})
+		s.ErrorIs(err, ErrSessionRevoked)
+	})
+
+	s.Run("Repository error", func() {
+		s.sessionRepo.EXPECT().State(gomock.Any(), userUUID, sessionID).Return(0, false, errUnknown)
+		err := s.accountService.ValidateSession(context.Background(), jwtoken.Payload{UUID: userUUID, Session: sessionID})
+		s.ErrorIs(err, errUnknown)
+	})
+}
+
+func (s *AccountTestSuite) TestKeys() {
+	keys := model.UserKeys{
+		EncryptionKey: []byte("0123456789abcdef0123456789abcdef"),
+		SigningKey:    []byte("fedcba9876543210fedcba9876543210"),
+		PrivateKeys:   []byte("encrypted private keys"),
+	}
+	user := model.User{
+		UUID:  "f9bd9622-f730-11ed-b67e-0242ac120002",
+		Login: "ivan",
+		Keys:  keys,
+	}
+
+	s.Run("Set keys", func() {
+		s.userRepo.EXPECT().FindByUUID(gomock.Any(), user.UUID).Return(&model.User{UUID: user.UUID}, nil)
+		s.userRepo.EXPECT().UpdateKeys(gomock.Any(), user.UUID, keys).Return(nil)
+		s.NoError(s.accountService.SetKeys(context.Background(), user.UUID, keys))
+	})
+
+	s.Run("Re-encrypted private keys", func() {
+		reencrypted := keys
+		reencrypted.PrivateKeys = []byte("re-encrypted private keys")
+
+		s.userRepo.EXPECT().FindByUUID(gomock.Any(), user.UUID).Return(&user, nil)
+		s.userRepo.EXPECT().UpdateKeys(gomock.Any(), user.UUID, reencrypted).Return(nil)
+		s.NoError(s.accountService.SetKeys(context.Background(), user.UUID, reencrypted))
+	})
+
+	s.Run("Replace existing keys", func() {
+		other := keys
+		other.EncryptionKey = []byte("abcdef0123456789abcdef0123456789")
+
+		s.userRepo.EXPECT().FindByUUID(gomock.Any(), user.UUID).Return(&user, nil)
+		s.ErrorIs(s.accountService.SetKeys(context.Background(), user.UUID, other), ErrKeysExist)
+	})
+
+	s.Run("Own keys", func() {
+		s.userRepo.EXPECT().FindByUUID(gomock.Any(), user.UUID).Return(&user, nil)
+
+		result, err := s.accountService.Keys(context.Background(), user.UUID)
+		s.Require().NoError(err)
+		s.Equal(keys.PrivateKeys, result.PrivateKeys)
+	})
+
+	s.Run("Published keys", func() {
+		s.userRepo.EXPECT().FindByLogin(gomock.Any(), user.Login).Return(&user, nil)
+
+		result, err := s.accountService.PublicKeys(context.Background(), user.Login)
+		s.Require().NoError(err)
+		s.Equal(keys.EncryptionKey, result.EncryptionKey)
+		s.Equal(keys.SigningKey, result.SigningKey)
+		s.Empty(result.PrivateKeys)
+	})
+
+	s.Run("Keys are not published", func() {
+		s.userRepo.EXPECT().FindByLogin(gomock.Any(), user.Login).Return(&model.User{Login: user.Login}, nil)
+
+		_, err := s.accountService.PublicKeys(context.Background(), user.Login)
+		s.ErrorIs(err, ErrKeysNotFound)
+	})
+
+	s.Run("Unknown user", func() {
+		s.userRepo.EXPECT().FindByLogin(gomock.Any(), "petr").Return(nil, repository.ErrNotFound)
+
+		_, err := s.accountService.PublicKeys(context.Background(), "petr")
+		s.ErrorIs(err, ErrUserNotFound)
+	})
+}
+
+func (s *AccountTestSuite) TestRecovery() {
+	verifier := []byte("0123456789abcdef0123456789abcdef")
+	hash := sha256.Sum256(verifier)
+	recovery := model.UserRecovery{Verifier: hash[:], WrappedKey: []byte("wrapped key")}
+
+	user := s.hashedUser("iVm20%02fD5O")
+	user.Recovery = recovery
+
+	s.Run("Set recovery key", func() {
+		s.userRepo.EXPECT().UpdateRecovery(gomock.Any(), user.UUID, recovery).Return(nil)
+		s.NoError(s.accountService.SetRecoveryKey(context.Background(), user.UUID, verifier, recovery.WrappedKey))
+	})
+
+	s.Run("Correct recovery key", func() {
+		s.userRepo.EXPECT().FindByLogin(gomock.Any(), user.Login).Return(&user, nil)
+		s.userRepo.EXPECT().UpdatePassword(gomock.Any(), user.UUID, gomock.Any()).DoAndReturn(
+			func(_ context.Context, _, password string) (*model.User, error) {
+				s.NoError(bcrypt.CompareHashAndPassword([]byte(password), []byte("n3w-Pa$$word")))
+				return &user, nil
+			},
+		)
+
+		wrappedKey, err := s.accountService.Recover(context.Background(), user.Login, verifier, "n3w-Pa$$word")
+		s.Require().NoError(err)
+		s.Equal(recovery.WrappedKey, wrappedKey)
+	})
+
+	s.Run("Incorrect recovery key", func() {
+		s.userRepo.EXPECT().FindByLogin(gomock.Any(), user.Login).Return(&user, nil)
+
+		_, err := s.accountService.Recover(context.Background(), user.Login, []byte("fedcba9876543210fedcba9876543210"), "n3w-Pa$$word")
+		s.ErrorIs(err, ErrIncorrectCredentials)
+	})
+
+	s.Run("Recovery key is not set", func() {
+		s.userRepo.EXPECT().FindByLogin(gomock.Any(), user.Login).Return(&model.User{UUID: user.UUID, Login: user.Login}, nil)
+
+		_, err := s.accountService.Recover(context.Background(), user.Login, verifier, "n3w-Pa$$word")
+		s.ErrorIs(err, ErrIncorrectCredentials)
+	})
+}
+
 func TestAccountTestSuite(t *testing.T) {
 	suite.Run(t, new(AccountTestSuite))
 }
@@ -36,16 +36,16 @@ func (m *MockJWT) EXPECT() *MockJWTMockRecorder {
 }
 
 // Create mocks base method.
-func (m *MockJWT) Create(payload jwtoken.Payload, ttl time.Duration, secret []byte) (string, error) {
+func (m *MockJWT) Create(payload jwtoken.Payload, ttl time.Duration) (string, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Create", payload, ttl, secret)
+	ret := m.ctrl.Call(m, "Create", payload, ttl)
 	ret0, _ := ret[0].(string)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // Create indicates an expected call of Create.
-func (mr *MockJWTMockRecorder) Create(payload, ttl, secret interface{}) *gomock.Call {
+func (mr *MockJWTMockRecorder) Create(payload, ttl interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockJWT)(nil).Create), payload, ttl, secret)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockJWT)(nil).Create), payload, ttl)
 }
@@ -7,6 +7,7 @@ import (
 	"time"
 
 	"github.com/casnerano/seckeep/internal/pkg/model"
+	smodel "github.com/casnerano/seckeep/internal/server/model"
 	"github.com/casnerano/seckeep/internal/server/repository"
 )
 
@@ -33,9 +34,9 @@ func (d Data) Create(ctx context.Context, data model.Data) (*model.Data, error)
 	return d.repo.Add(ctx, data)
 }
 
-// FindByUUID метод поиска по UUID.
-func (d Data) FindByUUID(ctx context.Context, userUUID, uuid string) (*model.Data, error) {
-	data, err := d.repo.FindByUUID(ctx, userUUID, uuid)
+// FindByUUID метод поиска по UUID в области видимости scope.
+func (d Data) FindByUUID(ctx context.Context, scope smodel.DataScope, uuid string) (*model.Data, error) {
+	data, err := d.repo.FindByUUID(ctx, scope, uuid)
 	if err != nil {
 		if errors.Is(err, repository.ErrNotFound) {
 			return nil, ErrNotFound
@@ -45,14 +46,14 @@ func (d Data) FindByUUID(ctx context.Context, userUUID, uuid string) (*model.Dat
 	return data, nil
 }
 
-// FindByUserUUID метод по UUID пользователя.
-func (d Data) FindByUserUUID(ctx context.Context, userUUID string) ([]*model.Data, error) {
-	return d.repo.FindByUserUUID(ctx, userUUID)
+// FindByScope метод поиска всех записей области видимости scope.
+func (d Data) FindByScope(ctx context.Context, scope smodel.DataScope) ([]*model.Data, error) {
+	return d.repo.FindByScope(ctx, scope)
 }
 
 // Update метод обновления.
-func (d Data) Update(ctx context.Context, userUUID, uuid string, value []byte, version time.Time) (*model.Data, error) {
-	data, err := d.repo.Update(ctx, userUUID, uuid, value, version)
+func (d Data) Update(ctx context.Context, scope smodel.DataScope, uuid string, value []byte, version time.Time) (*model.Data, error) {
+	data, err := d.repo.Update(ctx, scope, uuid, value, version)
 	if err != nil {
 		if errors.Is(err, repository.ErrNotFound) {
 			return nil, ErrNotFound
@@ -62,9 +63,21 @@ func (d Data) Update(ctx context.Context, userUUID, uuid string, value []byte, v
 	return data, nil
 }
 
-// Delete метод удаления.
-func (d Data) Delete(ctx context.Context, userUUID, uuid string) error {
-	err := d.repo.Delete(ctx, userUUID, uuid)
+// History метод получения ревизий записи в области видимости scope, начиная с последней.
+func (d Data) History(ctx context.Context, scope smodel.DataScope, uuid string) ([]*model.DataRevision, error) {
+	revisions, err := d.repo.History(ctx, scope, uuid)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return nil, ErrNotFound
+		}
+		return nil, err
+	}
+	return revisions, nil
+}
+
+// Delete метод перемещения записи в корзину.
+func (d Data) Delete(ctx context.Context, scope smodel.DataScope, uuid string) error {
+	err := d.repo.Delete(ctx, scope, uuid)
 	if err != nil {
 		if errors.Is(err, repository.ErrNotFound) {
 			return ErrNotFound
@@ -73,3 +86,30 @@ func (d Data) Delete(ctx context.Context, userUUID, uuid string) error {
 	}
 	return nil
 }
+
+// Trash метод поиска записей области видимости scope в корзине.
+func (d Data) Trash(ctx context.Context, scope smodel.DataScope) ([]*model.Data, error) {
+	return d.repo.FindTrash(ctx, scope)
+}
+
+// Restore метод восстановления записи из корзины.
+func (d Data) Restore(ctx context.Context, scope smodel.DataScope, uuid string) (*model.Data, error) {
+	data, err := d.repo.Restore(ctx, scope, uuid)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return nil, ErrNotFound
+		}
+		return nil, err
+	}
+	return data, nil
+}
+
+// EmptyTrash метод очистки корзины области видимости scope.
+func (d Data) EmptyTrash(ctx context.Context, scope smodel.DataScope) (int64, error) {
+	return d.repo.EmptyTrash(ctx, scope)
+}
+
+// PurgeTrash метод окончательного удаления записей, перемещенных в корзину до момента before.
+func (d Data) PurgeTrash(ctx context.Context, before time.Time) (int64, error) {
+	return d.repo.PurgeTrash(ctx, before)
+}
@@ -7,6 +7,7 @@ import (
 	"time"
 
 	"github.com/casnerano/seckeep/internal/pkg/model"
+	smodel "github.com/casnerano/seckeep/internal/server/model"
 	"github.com/casnerano/seckeep/internal/server/repository"
 	mock_repository "github.com/casnerano/seckeep/internal/server/repository/mock"
 	"github.com/golang/mock/gomock"
@@ -59,31 +60,31 @@ func (s *DataTestSuite) TestFindByUUID() {
 	}
 
 	s.Run("Data is exist", func() {
-		s.dataRepo.EXPECT().FindByUUID(gomock.Any(), wantData.UserUUID, wantData.UUID).Return(&wantData, nil)
-		gotData, err := s.dataService.FindByUUID(context.Background(), wantData.UserUUID, wantData.UUID)
+		s.dataRepo.EXPECT().FindByUUID(gomock.Any(), smodel.DataScope{UserUUID: wantData.UserUUID}, wantData.UUID).Return(&wantData, nil)
+		gotData, err := s.dataService.FindByUUID(context.Background(), smodel.DataScope{UserUUID: wantData.UserUUID}, wantData.UUID)
 
 		s.NoError(err)
 		s.Equal(wantData, *gotData)
 	})
 
 	s.Run("Data is not exist", func() {
-		s.dataRepo.EXPECT().FindByUUID(gomock.Any(), wantData.UserUUID, wantData.UUID).Return(nil, repository.ErrNotFound)
-		gotData, err := s.dataService.FindByUUID(context.Background(), wantData.UserUUID, wantData.UUID)
+		s.dataRepo.EXPECT().FindByUUID(gomock.Any(), smodel.DataScope{UserUUID: wantData.UserUUID}, wantData.UUID).Return(nil, repository.ErrNotFound)
+		gotData, err := s.dataService.FindByUUID(context.Background(), smodel.DataScope{UserUUID: wantData.UserUUID}, wantData.UUID)
 
 		s.Nil(gotData)
 		s.ErrorIs(err, ErrNotFound)
 	})
 
 	s.Run("Unknown error", func() {
-		s.dataRepo.EXPECT().FindByUUID(gomock.Any(), wantData.UserUUID, wantData.UUID).Return(nil, errUnknown)
-		gotData, err := s.dataService.FindByUUID(context.Background(), wantData.UserUUID, wantData.UUID)
+		s.dataRepo.EXPECT().FindByUUID(gomock.Any(), smodel.DataScope{UserUUID: wantData.UserUUID}, wantData.UUID).Return(nil, errUnknown)
+		gotData, err := s.dataService.FindByUUID(context.Background(), smodel.DataScope{UserUUID: wantData.UserUUID}, wantData.UUID)
 
 		s.Nil(gotData)
 		s.ErrorIs(err, errUnknown)
 	})
 }
 
-func (s *DataTestSuite) TestFindByUserUUID() {
+func (s *DataTestSuite) TestFindByScope() {
 	userUUID := "f9bd9622-f730-11ed-b67e-0242ac000000"
 	wantDataList := []*model.Data{
 		{
@@ -104,8 +105,8 @@ func (s *DataTestSuite) TestFindByUserUUID() {
 		},
 	}
 
-	s.dataRepo.EXPECT().FindByUserUUID(gomock.Any(), userUUID).Return(wantDataList, nil)
-	gotDataList, err := s.dataService.FindByUserUUID(context.Background(), userUUID)
+	s.dataRepo.EXPECT().FindByScope(gomock.Any(), smodel.DataScope{UserUUID: userUUID}).Return(wantDataList, nil)
+	gotDataList, err := s.dataService.FindByScope(context.Background(), smodel.DataScope{UserUUID: userUUID})
 
 	s.NoError(err)
 	s.Equal(wantDataList, gotDataList)
@@ -122,56 +123,119 @@ func (s *DataTestSuite) TestUpdate() {
 	}
 
 	s.Run("Data is exist", func() {
-		s.dataRepo.EXPECT().Update(gomock.Any(), wantData.UserUUID, wantData.UUID, wantData.Value, wantData.Version).Return(&wantData, nil)
-		gotData, err := s.dataService.Update(context.Background(), wantData.UserUUID, wantData.UUID, wantData.Value, wantData.Version)
+		s.dataRepo.EXPECT().Update(gomock.Any(), smodel.DataScope{UserUUID: wantData.UserUUID}, wantData.UUID, wantData.Value, wantData.Version).Return(&wantData, nil)
+		gotData, err := s.dataService.Update(context.Background(), smodel.DataScope{UserUUID: wantData.UserUUID}, wantData.UUID, wantData.Value, wantData.Version)
 
 		s.NoError(err)
 		s.Equal(wantData, *gotData)
 	})
 
 	s.Run("Data is not exist", func() {
-		s.dataRepo.EXPECT().Update(gomock.Any(), wantData.UserUUID, wantData.UUID, wantData.Value, wantData.Version).Return(nil, repository.ErrNotFound)
-		gotData, err := s.dataService.Update(context.Background(), wantData.UserUUID, wantData.UUID, wantData.Value, wantData.Version)
+		s.dataRepo.EXPECT().Update(gomock.Any(), smodel.DataScope{UserUUID: wantData.UserUUID}, wantData.UUID, wantData.Value, wantData.Version).Return(nil, repository.ErrNotFound)
+		gotData, err := s.dataService.Update(context.Background(), smodel.DataScope{UserUUID: wantData.UserUUID}, wantData.UUID, wantData.Value, wantData.Version)
 
 		s.Nil(gotData)
 		s.ErrorIs(err, ErrNotFound)
 	})
 
 	s.Run("Unknown error", func() {
-		s.dataRepo.EXPECT().Update(gomock.Any(), wantData.UserUUID, wantData.UUID, wantData.Value, wantData.Version).Return(nil, errUnknown)
-		gotData, err := s.dataService.Update(context.Background(), wantData.UserUUID, wantData.UUID, wantData.Value, wantData.Version)
+		s.dataRepo.EXPECT().Update(gomock.Any(), smodel.DataScope{UserUUID: wantData.UserUUID}, wantData.UUID, wantData.Value, wantData.Version).Return(nil, errUnknown)
+		gotData, err := s.dataService.Update(context.Background(), smodel.DataScope{UserUUID: wantData.UserUUID}, wantData.UUID, wantData.Value, wantData.Version)
 
 		s.Nil(gotData)
 		s.ErrorIs(err, errUnknown)
 	})
 }
 
+func (s *DataTestSuite) TestHistory() {
+	uuid := "f9bd9622-f730-11ed-b67e-0242ac120000"
+	scope := smodel.DataScope{UserUUID: "f9bd9622-f730-11ed-b67e-0242ac120002"}
+	wantRevisions := []*model.DataRevision{
+		{Revision: 1, Value: []byte("1"), Version: time.Now(), CreatedAt: time.Now()},
+	}
+
+	s.Run("Data is exist", func() {
+		s.dataRepo.EXPECT().History(gomock.Any(), scope, uuid).Return(wantRevisions, nil)
+		gotRevisions, err := s.dataService.History(context.Background(), scope, uuid)
+
+		s.NoError(err)
+		s.Equal(wantRevisions, gotRevisions)
+	})
+
+	s.Run("Data is not exist", func() {
+		s.dataRepo.EXPECT().History(gomock.Any(), scope, uuid).Return(nil, repository.ErrNotFound)
+		gotRevisions, err := s.dataService.History(context.Background(), scope, uuid)
+
+		s.Nil(gotRevisions)
+		s.ErrorIs(err, ErrNotFound)
+	})
+}
+
 func (s *DataTestSuite) TestDelete() {
 	uuid := "f9bd9622-f730-11ed-b67e-0242ac120000"
 	userUUID := "f9bd9622-f730-11ed-b67e-0242ac120002"
 
 	s.Run("Data is exist", func() {
-		s.dataRepo.EXPECT().Delete(gomock.Any(), userUUID, uuid).Return(nil)
-		err := s.dataService.Delete(context.Background(), userUUID, uuid)
+		s.dataRepo.EXPECT().Delete(gomock.Any(), smodel.DataScope{UserUUID: userUUID}, uuid).Return(nil)
+		err := s.dataService.Delete(context.Background(), smodel.DataScope{UserUUID: userUUID}, uuid)
 
 		s.NoError(err)
 	})
 
 	s.Run("Data is not exist", func() {
-		s.dataRepo.EXPECT().Delete(gomock.Any(), userUUID, uuid).Return(repository.ErrNotFound)
-		err := s.dataService.Delete(context.Background(), userUUID, uuid)
+		s.dataRepo.EXPECT().Delete(gomock.Any(), smodel.DataScope{UserUUID: userUUID}, uuid).Return(repository.ErrNotFound)
+		err := s.dataService.Delete(context.Background(), smodel.DataScope{UserUUID: userUUID}, uuid)
 
 		s.ErrorIs(err, ErrNotFound)
 	})
 
 	s.Run("Unknown error", func() {
-		s.dataRepo.EXPECT().Delete(gomock.Any(), userUUID, uuid).Return(errUnknown)
-		err := s.dataService.Delete(context.Background(), userUUID, uuid)
+		s.dataRepo.EXPECT().Delete(gomock.Any(), smodel.DataScope{UserUUID: userUUID}, uuid).Return(errUnknown)
+		err := s.dataService.Delete(context.Background(), smodel.DataScope{UserUUID: userUUID}, uuid)
 
 		s.ErrorIs(err, errUnknown)
 	})
 }
 
+func (s *DataTestSuite) TestTrash() {
+	uuid := "f9bd9622-f730-11ed-b67e-0242ac120000"
+	scope := smodel.DataScope{UserUUID: "f9bd9622-f730-11ed-b67e-0242ac120002"}
+	deletedAt := time.Now()
+	wantData := &model.Data{UUID: uuid, UserUUID: scope.UserUUID, Type: model.DataTypeText, DeletedAt: &deletedAt}
+
+	s.Run("Trash list", func() {
+		s.dataRepo.EXPECT().FindTrash(gomock.Any(), scope).Return([]*model.Data{wantData}, nil)
+		gotData, err := s.dataService.Trash(context.Background(), scope)
+
+		s.NoError(err)
+		s.Equal([]*model.Data{wantData}, gotData)
+	})
+
+	s.Run("Restore", func() {
+		s.dataRepo.EXPECT().Restore(gomock.Any(), scope, uuid).Return(wantData, nil)
+		gotData, err := s.dataService.Restore(context.Background(), scope, uuid)
+
+		s.NoError(err)
+		s.Equal(wantData, gotData)
+	})
+
+	s.Run("Restore non-trashed data", func() {
+		s.dataRepo.EXPECT().Restore(gomock.Any(), scope, uuid).Return(nil, repository.ErrNotFound)
+		gotData, err := s.dataService.Restore(context.Background(), scope, uuid)
+
+		s.Nil(gotData)
+		s.ErrorIs(err, ErrNotFound)
+	})
+
+	s.Run("Empty trash", func() {
+		s.dataRepo.EXPECT().EmptyTrash(gomock.Any(), scope).Return(int64(1), nil)
+		deleted, err := s.dataService.EmptyTrash(context.Background(), scope)
+
+		s.NoError(err)
+		s.Equal(int64(1), deleted)
+	})
+}
+
 func TestDataTestSuite(t *testing.T) {
 	suite.Run(t, new(DataTestSuite))
 }
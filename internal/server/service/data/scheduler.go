@@ -0,0 +1,82 @@
+package data
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/casnerano/seckeep/pkg/log"
+)
+
+// Значения по умолчанию для очистки корзины.
+const (
+	// DefaultCheckInterval интервал очистки корзины по умолчанию.
+	DefaultCheckInterval = time.Hour
+
+	// DefaultTrashRetention срок хранения записей в корзине по умолчанию.
+	DefaultTrashRetention = 30 * 24 * time.Hour
+)
+
+// Purger интерфейс окончательного удаления записей из корзины.
+type Purger interface {
+	PurgeTrash(ctx context.Context, before time.Time) (int64, error)
+}
+
+// Scheduler структура планировщика, который периодически удаляет из корзины записи с истекшим сроком хранения.
+type Scheduler struct {
+	purger    Purger
+	interval  time.Duration
+	retention time.Duration
+	logger    log.Loggable
+}
+
+// NewScheduler конструктор.
+// Если интервал проверки interval или срок хранения retention не заданы,
+// используются DefaultCheckInterval и DefaultTrashRetention.
+func NewScheduler(purger Purger, interval, retention time.Duration, logger log.Loggable) *Scheduler {
+	if interval <= 0 {
+		interval = DefaultCheckInterval
+	}
+
+	if retention <= 0 {
+		retention = DefaultTrashRetention
+	}
+
+	return &Scheduler{
+		purger:    purger,
+		interval:  interval,
+		retention: retention,
+		logger:    logger,
+	}
+}
+
+// Run метод запускает планировщик, работает до отмены контекста.
+func (s *Scheduler) Run(ctx context.Context) {
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+
+	for {
+		s.purge(ctx)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// purge метод удаляет из корзины записи с истекшим сроком хранения.
+func (s *Scheduler) purge(ctx context.Context) {
+	purged, err := s.purger.PurgeTrash(ctx, time.Now().Add(-s.retention))
+	if err != nil {
+		if ctx.Err() == nil {
+			s.logger.Error("Ошибка очистки корзины.", err)
+		}
+		return
+	}
+
+	if purged > 0 {
+		s.logger.Info(fmt.Sprintf("Удалено записей из корзины: %d", purged))
+	}
+}
@@ -0,0 +1,239 @@
+// Package emergency содержит методы работы с экстренным доступом доверенных лиц к данным владельца.
+// Владелец заранее передает серверу ключ своих данных, зашифрованный открытым ключом доверенного лица.
+// Доверенное лицо запрашивает доступ, и если владелец не отклонил запрос в течение периода ожидания,
+// сервер выдает доверенному лицу зашифрованный ключ вместе с данными владельца.
+package emergency
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/casnerano/seckeep/internal/server/model"
+	"github.com/casnerano/seckeep/internal/server/repository"
+)
+
+// Основные ошибки при работе с экстренным доступом.
+var (
+	// ErrNotFound доступ не найден.
+	ErrNotFound = errors.New("emergency access not found")
+
+	// ErrUserNotFound пользователь не найден.
+	ErrUserNotFound = errors.New("user not found")
+
+	// ErrSelfGrant попытка выдать доступ самому себе.
+	ErrSelfGrant = errors.New("cannot grant emergency access to yourself")
+
+	// ErrNotRequested доступ не запрошен, отклонять нечего.
+	ErrNotRequested = errors.New("emergency access is not requested")
+
+	// ErrNotReleased доступ еще не открыт.
+	ErrNotReleased = errors.New("emergency access is not released")
+)
+
+// Emergency структура для работы с экстренным доступом.
+type Emergency struct {
+	repo     repository.Emergency
+	userRepo repository.User
+	dataRepo repository.Data
+}
+
+// New конструктор.
+func New(repo repository.Emergency, userRepo repository.User, dataRepo repository.Data) *Emergency {
+	return &Emergency{
+		repo:     repo,
+		userRepo: userRepo,
+		dataRepo: dataRepo,
+	}
+}
+
+// Grant метод выдает доверенному лицу login экстренный доступ с периодом ожидания waitPeriod.
+// wrappedKey — ключ данных владельца, зашифрованный открытым ключом доверенного лица.
+// Повторная выдача обновляет ключ и период ожидания, и сбрасывает текущий запрос.
+func (e Emergency) Grant(
+	ctx context.Context,
+	ownerUUID, login string,
+	wrappedKey []byte,
+	waitPeriod time.Duration,
+) (*model.EmergencyAccess, error) {
+	contact, err := e.findUser(ctx, login)
+	if err != nil {
+		return nil, err
+	}
+
+	if contact.UUID == ownerUUID {
+		return nil, ErrSelfGrant
+	}
+
+	access, err := e.repo.Save(ctx, model.EmergencyAccess{
+		OwnerUUID:    ownerUUID,
+		ContactUUID:  contact.UUID,
+		ContactLogin: contact.Login,
+		WrappedKey:   wrappedKey,
+		WaitPeriod:   int64(waitPeriod.Seconds()),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	access.WrappedKey = nil
+	return access, nil
+}
+
+// Contacts метод возвращает доступы, выданные владельцем.
+func (e Emergency) Contacts(ctx context.Context, ownerUUID string) ([]*model.EmergencyAccess, error) {
+	accesses, err := e.repo.FindByOwner(ctx, ownerUUID)
+	if err != nil {
+		return nil, err
+	}
+	return withoutKeys(accesses), nil
+}
+
+// Revoke метод отзывает доступ доверенного лица login.
+func (e Emergency) Revoke(ctx context.Context, ownerUUID, login string) error {
+	contact, err := e.findUser(ctx, login)
+	if err != nil {
+		return err
+	}
+
+	if err = e.repo.Delete(ctx, ownerUUID, contact.UUID); err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return ErrNotFound
+		}
+		return err
+	}
+
+	return nil
+}
+
+// Deny метод отклоняет запрос доступа доверенного лица login, доступ возвращается в исходное состояние.
+// Открытый доступ отклонить нельзя, его можно только отозвать.
+func (e Emergency) Deny(ctx context.Context, ownerUUID, login string) (*model.EmergencyAccess, error) {
+	contact, err := e.findUser(ctx, login)
+	if err != nil {
+		return nil, err
+	}
+
+	access, err := e.find(ctx, ownerUUID, contact.UUID)
+	if err != nil {
+		return nil, err
+	}
+
+	if access.Status != model.EmergencyStatusRequested {
+		return nil, ErrNotRequested
+	}
+
+	access.Status = model.EmergencyStatusGranted
+	access.RequestedAt, access.ReleaseAt = nil, nil
+
+	if err = e.repo.UpdateStatus(ctx, *access); err != nil {
+		return nil, err
+	}
+
+	access.WrappedKey = nil
+	return access, nil
+}
+
+// Grants метод возвращает доступы, выданные доверенному лицу.
+func (e Emergency) Grants(ctx context.Context, contactUUID string) ([]*model.EmergencyAccess, error) {
+	accesses, err := e.repo.FindByContact(ctx, contactUUID)
+	if err != nil {
+		return nil, err
+	}
+	return withoutKeys(accesses), nil
+}
+
+// Request метод запрашивает доступ к данным владельца login, запуская период ожидания.
+// Повторный запрос не продлевает период ожидания.
+func (e Emergency) Request(ctx context.Context, contactUUID, login string) (*model.EmergencyAccess, error) {
+	owner, err := e.findUser(ctx, login)
+	if err != nil {
+		return nil, err
+	}
+
+	access, err := e.find(ctx, owner.UUID, contactUUID)
+	if err != nil {
+		return nil, err
+	}
+
+	if access.Status == model.EmergencyStatusGranted {
+		requestedAt := time.Now()
+		releaseAt := requestedAt.Add(time.Duration(access.WaitPeriod) * time.Second)
+
+		access.Status = model.EmergencyStatusRequested
+		access.RequestedAt, access.ReleaseAt = &requestedAt, &releaseAt
+
+		if err = e.repo.UpdateStatus(ctx, *access); err != nil {
+			return nil, err
+		}
+	}
+
+	access.WrappedKey = nil
+	return access, nil
+}
+
+// Data метод возвращает доверенному лицу личные данные владельца login и зашифрованный ключ к ним.
+// Данные доступны только после открытия доступа.
+func (e Emergency) Data(ctx context.Context, contactUUID, login string) (*model.EmergencyData, error) {
+	owner, err := e.findUser(ctx, login)
+	if err != nil {
+		return nil, err
+	}
+
+	access, err := e.find(ctx, owner.UUID, contactUUID)
+	if err != nil {
+		return nil, err
+	}
+
+	if access.Status != model.EmergencyStatusReleased {
+		return nil, ErrNotReleased
+	}
+
+	data, err := e.dataRepo.FindByScope(ctx, model.DataScope{UserUUID: owner.UUID})
+	if err != nil {
+		return nil, err
+	}
+
+	return &model.EmergencyData{
+		OwnerLogin: owner.Login,
+		WrappedKey: access.WrappedKey,
+		Data:       data,
+	}, nil
+}
+
+// ReleaseExpired метод открывает запрошенные доступы, период ожидания которых истек.
+func (e Emergency) ReleaseExpired(ctx context.Context) (int64, error) {
+	return e.repo.ReleaseExpired(ctx, time.Now())
+}
+
+// findUser метод ищет пользователя по логину.
+func (e Emergency) findUser(ctx context.Context, login string) (*model.User, error) {
+	user, err := e.userRepo.FindByLogin(ctx, login)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return nil, ErrUserNotFound
+		}
+		return nil, err
+	}
+	return user, nil
+}
+
+// find метод ищет доступ доверенного лица к данным владельца.
+func (e Emergency) find(ctx context.Context, ownerUUID, contactUUID string) (*model.EmergencyAccess, error) {
+	access, err := e.repo.Find(ctx, ownerUUID, contactUUID)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return nil, ErrNotFound
+		}
+		return nil, err
+	}
+	return access, nil
+}
+
+// withoutKeys убирает зашифрованные ключи из списка доступов.
+func withoutKeys(accesses []*model.EmergencyAccess) []*model.EmergencyAccess {
+	for _, access := range accesses {
+		access.WrappedKey = nil
+	}
+	return accesses
+}
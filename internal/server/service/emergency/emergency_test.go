@@ -0,0 +1,184 @@
+package emergency
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	smodel "github.com/casnerano/seckeep/internal/pkg/model"
+	"github.com/casnerano/seckeep/internal/server/model"
+	"github.com/casnerano/seckeep/internal/server/repository"
+	mock_repository "github.com/casnerano/seckeep/internal/server/repository/mock"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/suite"
+)
+
+var errUnknown = errors.New("unknown error")
+
+type EmergencyTestSuite struct {
+	suite.Suite
+	emergencyService *Emergency
+	emergencyRepo    *mock_repository.MockEmergency
+	userRepo         *mock_repository.MockUser
+	dataRepo         *mock_repository.MockData
+	owner            *model.User
+	contact          *model.User
+}
+
+func (s *EmergencyTestSuite) SetupSuite() {
+	ctrl := gomock.NewController(s.T())
+	defer ctrl.Finish()
+
+	s.emergencyRepo = mock_repository.NewMockEmergency(ctrl)
+	s.userRepo = mock_repository.NewMockUser(ctrl)
+	s.dataRepo = mock_repository.NewMockData(ctrl)
+	s.emergencyService = New(s.emergencyRepo, s.userRepo, s.dataRepo)
+
+	s.owner = &model.User{UUID: "f9bd9622-f730-11ed-b67e-0242ac120002", Login: "ivan"}
+	s.contact = &model.User{UUID: "ba3cfc2c-f7fd-11ed-b67e-0242ac120002", Login: "petr"}
+}
+
+func (s *EmergencyTestSuite) access(status model.EmergencyStatus) *model.EmergencyAccess {
+	return &model.EmergencyAccess{
+		OwnerUUID:    s.owner.UUID,
+		OwnerLogin:   s.owner.Login,
+		ContactUUID:  s.contact.UUID,
+		ContactLogin: s.contact.Login,
+		WrappedKey:   []byte("wrapped key"),
+		WaitPeriod:   int64((72 * time.Hour).Seconds()),
+		Status:       status,
+	}
+}
+
+func (s *EmergencyTestSuite) TestGrant() {
+	s.Run("Success", func() {
+		s.userRepo.EXPECT().FindByLogin(gomock.Any(), s.contact.Login).Return(s.contact, nil)
+		s.emergencyRepo.EXPECT().Save(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(_ context.Context, access model.EmergencyAccess) (*model.EmergencyAccess, error) {
+				s.Equal(s.contact.UUID, access.ContactUUID)
+				s.Equal(int64(259200), access.WaitPeriod)
+				return &access, nil
+			},
+		)
+
+		access, err := s.emergencyService.Grant(context.Background(), s.owner.UUID, s.contact.Login, []byte("wrapped key"), 72*time.Hour)
+		s.Require().NoError(err)
+		s.Nil(access.WrappedKey)
+	})
+
+	s.Run("Self grant", func() {
+		s.userRepo.EXPECT().FindByLogin(gomock.Any(), s.owner.Login).Return(s.owner, nil)
+
+		_, err := s.emergencyService.Grant(context.Background(), s.owner.UUID, s.owner.Login, []byte("wrapped key"), time.Hour)
+		s.ErrorIs(err, ErrSelfGrant)
+	})
+
+	s.Run("Unknown user", func() {
+		s.userRepo.EXPECT().FindByLogin(gomock.Any(), "nobody").Return(nil, repository.ErrNotFound)
+
+		_, err := s.emergencyService.Grant(context.Background(), s.owner.UUID, "nobody", []byte("wrapped key"), time.Hour)
+		s.ErrorIs(err, ErrUserNotFound)
+	})
+}
+
+func (s *EmergencyTestSuite) TestRequest() {
+	s.Run("Starts waiting period", func() {
+		s.userRepo.EXPECT().FindByLogin(gomock.Any(), s.owner.Login).Return(s.owner, nil)
+		s.emergencyRepo.EXPECT().Find(gomock.Any(), s.owner.UUID, s.contact.UUID).
+			Return(s.access(model.EmergencyStatusGranted), nil)
+		s.emergencyRepo.EXPECT().UpdateStatus(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(_ context.Context, access model.EmergencyAccess) error {
+				s.Equal(model.EmergencyStatusRequested, access.Status)
+				s.WithinDuration(time.Now().Add(72*time.Hour), *access.ReleaseAt, time.Minute)
+				return nil
+			},
+		)
+
+		access, err := s.emergencyService.Request(context.Background(), s.contact.UUID, s.owner.Login)
+		s.Require().NoError(err)
+		s.Equal(model.EmergencyStatusRequested, access.Status)
+		s.Nil(access.WrappedKey)
+	})
+
+	s.Run("Already requested", func() {
+		s.userRepo.EXPECT().FindByLogin(gomock.Any(), s.owner.Login).Return(s.owner, nil)
+		s.emergencyRepo.EXPECT().Find(gomock.Any(), s.owner.UUID, s.contact.UUID).
+			Return(s.access(model.EmergencyStatusRequested), nil)
+
+		access, err := s.emergencyService.Request(context.Background(), s.contact.UUID, s.owner.Login)
+		s.Require().NoError(err)
+		s.Equal(model.EmergencyStatusRequested, access.Status)
+	})
+
+	s.Run("Not granted", func() {
+		s.userRepo.EXPECT().FindByLogin(gomock.Any(), s.owner.Login).Return(s.owner, nil)
+		s.emergencyRepo.EXPECT().Find(gomock.Any(), s.owner.UUID, s.contact.UUID).Return(nil, repository.ErrNotFound)
+
+		_, err := s.emergencyService.Request(context.Background(), s.contact.UUID, s.owner.Login)
+		s.ErrorIs(err, ErrNotFound)
+	})
+}
+
+func (s *EmergencyTestSuite) TestDeny() {
+	s.Run("Requested access", func() {
+		s.userRepo.EXPECT().FindByLogin(gomock.Any(), s.contact.Login).Return(s.contact, nil)
+		s.emergencyRepo.EXPECT().Find(gomock.Any(), s.owner.UUID, s.contact.UUID).
+			Return(s.access(model.EmergencyStatusRequested), nil)
+		s.emergencyRepo.EXPECT().UpdateStatus(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(_ context.Context, access model.EmergencyAccess) error {
+				s.Equal(model.EmergencyStatusGranted, access.Status)
+				s.Nil(access.ReleaseAt)
+				return nil
+			},
+		)
+
+		_, err := s.emergencyService.Deny(context.Background(), s.owner.UUID, s.contact.Login)
+		s.NoError(err)
+	})
+
+	s.Run("Released access", func() {
+		s.userRepo.EXPECT().FindByLogin(gomock.Any(), s.contact.Login).Return(s.contact, nil)
+		s.emergencyRepo.EXPECT().Find(gomock.Any(), s.owner.UUID, s.contact.UUID).
+			Return(s.access(model.EmergencyStatusReleased), nil)
+
+		_, err := s.emergencyService.Deny(context.Background(), s.owner.UUID, s.contact.Login)
+		s.ErrorIs(err, ErrNotRequested)
+	})
+}
+
+func (s *EmergencyTestSuite) TestData() {
+	s.Run("Released access", func() {
+		data := []*smodel.Data{{UUID: "0b8e5c1a", Value: []byte("ciphertext")}}
+
+		s.userRepo.EXPECT().FindByLogin(gomock.Any(), s.owner.Login).Return(s.owner, nil)
+		s.emergencyRepo.EXPECT().Find(gomock.Any(), s.owner.UUID, s.contact.UUID).
+			Return(s.access(model.EmergencyStatusReleased), nil)
+		s.dataRepo.EXPECT().FindByScope(gomock.Any(), model.DataScope{UserUUID: s.owner.UUID}).Return(data, nil)
+
+		result, err := s.emergencyService.Data(context.Background(), s.contact.UUID, s.owner.Login)
+		s.Require().NoError(err)
+		s.Equal([]byte("wrapped key"), result.WrappedKey)
+		s.Equal(data, result.Data)
+	})
+
+	s.Run("Waiting period", func() {
+		s.userRepo.EXPECT().FindByLogin(gomock.Any(), s.owner.Login).Return(s.owner, nil)
+		s.emergencyRepo.EXPECT().Find(gomock.Any(), s.owner.UUID, s.contact.UUID).
+			Return(s.access(model.EmergencyStatusRequested), nil)
+
+		_, err := s.emergencyService.Data(context.Background(), s.contact.UUID, s.owner.Login)
+		s.ErrorIs(err, ErrNotReleased)
+	})
+
+	s.Run("Unknown error", func() {
+		s.userRepo.EXPECT().FindByLogin(gomock.Any(), s.owner.Login).Return(nil, errUnknown)
+
+		_, err := s.emergencyService.Data(context.Background(), s.contact.UUID, s.owner.Login)
+		s.ErrorIs(err, errUnknown)
+	})
+}
+
+func TestEmergencyTestSuite(t *testing.T) {
+	suite.Run(t, new(EmergencyTestSuite))
+}
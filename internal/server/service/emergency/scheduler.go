@@ -0,0 +1,69 @@
+package emergency
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/casnerano/seckeep/pkg/log"
+)
+
+// DefaultCheckInterval интервал проверки периодов ожидания по умолчанию.
+const DefaultCheckInterval = time.Minute
+
+// Releaser интерфейс открытия доступов с истекшим периодом ожидания.
+type Releaser interface {
+	ReleaseExpired(ctx context.Context) (int64, error)
+}
+
+// Scheduler структура планировщика, который периодически открывает доступы с истекшим периодом ожидания.
+type Scheduler struct {
+	releaser Releaser
+	interval time.Duration
+	logger   log.Loggable
+}
+
+// NewScheduler конструктор.
+// Если интервал проверки interval не задан, используется DefaultCheckInterval.
+func NewScheduler(releaser Releaser, interval time.Duration, logger log.Loggable) *Scheduler {
+	if interval <= 0 {
+		interval = DefaultCheckInterval
+	}
+
+	return &Scheduler{
+		releaser: releaser,
+		interval: interval,
+		logger:   logger,
+	}
+}
+
+// Run метод запускает планировщик, работает до отмены контекста.
+func (s *Scheduler) Run(ctx context.Context) {
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+
+	for {
+		s.release(ctx)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// release метод открывает доступы с истекшим периодом ожидания.
+func (s *Scheduler) release(ctx context.Context) {
+	released, err := s.releaser.ReleaseExpired(ctx)
+	if err != nil {
+		if ctx.Err() == nil {
+			s.logger.Error("Ошибка открытия экстренных доступов.", err)
+		}
+		return
+	}
+
+	if released > 0 {
+		s.logger.Info(fmt.Sprintf("Открыто экстренных доступов: %d", released))
+	}
+}
@@ -0,0 +1,51 @@
+package emergency
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/casnerano/seckeep/pkg/log"
+)
+
+type releaserFunc func(ctx context.Context) (int64, error)
+
+func (f releaserFunc) ReleaseExpired(ctx context.Context) (int64, error) {
+	return f(ctx)
+}
+
+func TestScheduler_Run(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+
+	calls := 0
+	releaser := releaserFunc(func(ctx context.Context) (int64, error) {
+		calls++
+		if calls == 3 {
+			cancel()
+		}
+		return 1, nil
+	})
+
+	done := make(chan struct{})
+	go func() {
+		NewScheduler(releaser, time.Millisecond, log.NewStub()).Run(ctx)
+		close(done)
+	}()
+
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("Scheduler did not stop after context cancellation.")
+	}
+
+	if calls != 3 {
+		t.Errorf("ReleaseExpired() calls = %d, want 3", calls)
+	}
+}
+
+func TestNewScheduler(t *testing.T) {
+	s := NewScheduler(releaserFunc(nil), 0, log.NewStub())
+	if s.interval != DefaultCheckInterval {
+		t.Errorf("interval = %s, want %s", s.interval, DefaultCheckInterval)
+	}
+}
@@ -0,0 +1,69 @@
+package share
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/casnerano/seckeep/pkg/log"
+)
+
+// DefaultCheckInterval интервал удаления истекших ссылок по умолчанию.
+const DefaultCheckInterval = 10 * time.Minute
+
+// Purger интерфейс удаления истекших ссылок.
+type Purger interface {
+	PurgeExpired(ctx context.Context) (int64, error)
+}
+
+// Scheduler структура планировщика, который периодически удаляет ссылки с истекшим сроком действия.
+type Scheduler struct {
+	purger   Purger
+	interval time.Duration
+	logger   log.Loggable
+}
+
+// NewScheduler конструктор.
+// Если интервал проверки interval не задан, используется DefaultCheckInterval.
+func NewScheduler(purger Purger, interval time.Duration, logger log.Loggable) *Scheduler {
+	if interval <= 0 {
+		interval = DefaultCheckInterval
+	}
+
+	return &Scheduler{
+		purger:   purger,
+		interval: interval,
+		logger:   logger,
+	}
+}
+
+// Run метод запускает планировщик, работает до отмены контекста.
+func (s *Scheduler) Run(ctx context.Context) {
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+
+	for {
+		s.purge(ctx)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// purge метод удаляет ссылки с истекшим сроком действия.
+func (s *Scheduler) purge(ctx context.Context) {
+	purged, err := s.purger.PurgeExpired(ctx)
+	if err != nil {
+		if ctx.Err() == nil {
+			s.logger.Error("Ошибка удаления истекших ссылок на секреты.", err)
+		}
+		return
+	}
+
+	if purged > 0 {
+		s.logger.Info(fmt.Sprintf("Удалено истекших ссылок на секреты: %d", purged))
+	}
+}
@@ -0,0 +1,56 @@
+// Package share содержит методы работы со ссылками на секреты.
+// Сервер хранит только шифротекст: ключ передается в ссылке (во фрагменте URL) и на сервер не попадает.
+package share
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/casnerano/seckeep/internal/server/model"
+	"github.com/casnerano/seckeep/internal/server/repository"
+)
+
+// Основные ошибки при работе со ссылками на секреты.
+var (
+	// ErrNotFound ссылка не найдена, истекла или исчерпала число просмотров.
+	ErrNotFound = errors.New("share not found")
+)
+
+// Share структура для работы со ссылками на секреты.
+type Share struct {
+	repo repository.Share
+}
+
+// New конструктор.
+func New(repo repository.Share) *Share {
+	return &Share{
+		repo: repo,
+	}
+}
+
+// Create метод создает ссылку со сроком действия ttl и числом просмотров views.
+func (s Share) Create(ctx context.Context, userUUID string, value []byte, ttl time.Duration, views int) (*model.Share, error) {
+	return s.repo.Add(ctx, userUUID, model.Share{
+		Value:     value,
+		ViewsLeft: views,
+		ExpiresAt: time.Now().Add(ttl),
+	})
+}
+
+// Open метод возвращает шифротекст по ссылке, засчитывая просмотр.
+func (s Share) Open(ctx context.Context, uuid string) (*model.Share, error) {
+	share, err := s.repo.Take(ctx, uuid)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return nil, ErrNotFound
+		}
+		return nil, err
+	}
+	return share, nil
+}
+
+// PurgeExpired метод удаляет ссылки с истекшим сроком действия.
+func (s Share) PurgeExpired(ctx context.Context) (int64, error) {
+	return s.repo.DeleteExpired(ctx, time.Now())
+}
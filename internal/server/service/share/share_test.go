@@ -0,0 +1,90 @@
+package share
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/casnerano/seckeep/internal/server/model"
+	"github.com/casnerano/seckeep/internal/server/repository"
+	mock_repository "github.com/casnerano/seckeep/internal/server/repository/mock"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/suite"
+)
+
+var errUnknown = errors.New("unknown error")
+
+type ShareTestSuite struct {
+	suite.Suite
+	shareService *Share
+	shareRepo    *mock_repository.MockShare
+}
+
+func (s *ShareTestSuite) SetupSuite() {
+	ctrl := gomock.NewController(s.T())
+	defer ctrl.Finish()
+
+	s.shareRepo = mock_repository.NewMockShare(ctrl)
+	s.shareService = New(s.shareRepo)
+}
+
+func (s *ShareTestSuite) TestCreate() {
+	userUUID := "f9bd9622-f730-11ed-b67e-0242ac120002"
+
+	s.shareRepo.EXPECT().Add(gomock.Any(), userUUID, gomock.Any()).DoAndReturn(
+		func(_ context.Context, _ string, share model.Share) (*model.Share, error) {
+			s.Equal(1, share.ViewsLeft)
+			s.WithinDuration(time.Now().Add(time.Hour), share.ExpiresAt, time.Minute)
+			share.UUID = "0b8e5c1a-7f3d-4e2b-9a6c-5d4e3f2a1b0c"
+			return &share, nil
+		},
+	)
+
+	share, err := s.shareService.Create(context.Background(), userUUID, []byte("ciphertext"), time.Hour, 1)
+	s.Require().NoError(err)
+	s.NotEmpty(share.UUID)
+}
+
+func (s *ShareTestSuite) TestOpen() {
+	uuid := "0b8e5c1a-7f3d-4e2b-9a6c-5d4e3f2a1b0c"
+
+	s.Run("Available share", func() {
+		s.shareRepo.EXPECT().Take(gomock.Any(), uuid).Return(&model.Share{UUID: uuid, Value: []byte("ciphertext")}, nil)
+
+		share, err := s.shareService.Open(context.Background(), uuid)
+		s.Require().NoError(err)
+		s.Equal([]byte("ciphertext"), share.Value)
+	})
+
+	s.Run("Expired or viewed share", func() {
+		s.shareRepo.EXPECT().Take(gomock.Any(), uuid).Return(nil, repository.ErrNotFound)
+
+		_, err := s.shareService.Open(context.Background(), uuid)
+		s.ErrorIs(err, ErrNotFound)
+	})
+
+	s.Run("Unknown error", func() {
+		s.shareRepo.EXPECT().Take(gomock.Any(), uuid).Return(nil, errUnknown)
+
+		_, err := s.shareService.Open(context.Background(), uuid)
+		s.ErrorIs(err, errUnknown)
+	})
+}
+
+func (s *ShareTestSuite) TestPurgeExpired() {
+	s.shareRepo.EXPECT().DeleteExpired(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, before time.Time) (int64, error) {
+			s.WithinDuration(time.Now(), before, time.Minute)
+			return 2, nil
+		},
+	)
+
+	purged, err := s.shareService.PurgeExpired(context.Background())
+	s.Require().NoError(err)
+	s.Equal(int64(2), purged)
+}
+
+func TestShareTestSuite(t *testing.T) {
+	suite.Run(t, new(ShareTestSuite))
+}
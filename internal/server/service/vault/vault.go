@@ -0,0 +1,207 @@
+// Package vault содержит методы работы с общими хранилищами и их участниками.
+// Сервер не имеет доступа к ключам хранилищ: каждый участник хранит ключ хранилища,
+// зашифрованный своим открытым ключом, а записи хранилища шифруются на клиенте.
+package vault
+
+import (
+	"context"
+	"errors"
+
+	"github.com/casnerano/seckeep/internal/server/model"
+	"github.com/casnerano/seckeep/internal/server/repository"
+)
+
+// Основные ошибки при работе с общими хранилищами.
+var (
+	// ErrNotFound хранилище не найдено, или пользователь не является его участником.
+	ErrNotFound = errors.New("vault not found")
+
+	// ErrForbidden недостаточно прав для операции.
+	ErrForbidden = errors.New("forbidden")
+
+	// ErrUserNotFound пользователь не найден.
+	ErrUserNotFound = errors.New("user not found")
+
+	// ErrMemberNotFound участник не найден.
+	ErrMemberNotFound = errors.New("member not found")
+
+	// ErrLastOwner операция оставила бы хранилище без владельца.
+	ErrLastOwner = errors.New("vault must have at least one owner")
+)
+
+// Vault структура для работы с общими хранилищами.
+type Vault struct {
+	repo     repository.Vault
+	userRepo repository.User
+}
+
+// New конструктор.
+func New(repo repository.Vault, userRepo repository.User) *Vault {
+	return &Vault{
+		repo:     repo,
+		userRepo: userRepo,
+	}
+}
+
+// Create метод создает хранилище, создатель становится его владельцем.
+func (v Vault) Create(ctx context.Context, userUUID, name string, wrappedKey []byte) (*model.Vault, error) {
+	return v.repo.Add(ctx, name, userUUID, wrappedKey)
+}
+
+// FindByUserUUID метод возвращает хранилища, участником которых является пользователь.
+func (v Vault) FindByUserUUID(ctx context.Context, userUUID string) ([]*model.Vault, error) {
+	return v.repo.FindByUserUUID(ctx, userUUID)
+}
+
+// Role метод возвращает роль пользователя в хранилище.
+func (v Vault) Role(ctx context.Context, vaultUUID, userUUID string) (model.VaultRole, error) {
+	member, err := v.findMember(ctx, vaultUUID, userUUID)
+	if err != nil {
+		return "", err
+	}
+	return member.Role, nil
+}
+
+// Members метод возвращает участников хранилища, доступен любому участнику.
+func (v Vault) Members(ctx context.Context, userUUID, vaultUUID string) ([]*model.VaultMember, error) {
+	if _, err := v.findMember(ctx, vaultUUID, userUUID); err != nil {
+		return nil, err
+	}
+	return v.repo.FindMembers(ctx, vaultUUID)
+}
+
+// SaveMember метод добавляет участника или изменяет его роль, доступен только владельцу.
+// wrappedKey — ключ хранилища, зашифрованный открытым ключом участника.
+func (v Vault) SaveMember(
+	ctx context.Context,
+	userUUID, vaultUUID, login string,
+	role model.VaultRole,
+	wrappedKey []byte,
+) (*model.VaultMember, error) {
+	if err := v.checkOwner(ctx, vaultUUID, userUUID); err != nil {
+		return nil, err
+	}
+
+	user, err := v.userRepo.FindByLogin(ctx, login)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return nil, ErrUserNotFound
+		}
+		return nil, err
+	}
+
+	if role != model.VaultRoleOwner {
+		if err = v.checkNotLastOwner(ctx, vaultUUID, user.UUID); err != nil {
+			return nil, err
+		}
+	}
+
+	member := model.VaultMember{
+		VaultUUID:  vaultUUID,
+		UserUUID:   user.UUID,
+		Login:      user.Login,
+		FullName:   user.FullName,
+		Role:       role,
+		WrappedKey: wrappedKey,
+	}
+
+	if err = v.repo.SaveMember(ctx, member); err != nil {
+		return nil, err
+	}
+
+	return &member, nil
+}
+
+// SetWrappedKey метод заменяет ключ хранилища, зашифрованный для самого участника, доступен любому участнику.
+// Роль участника не меняется. Используется для перешифрования ключа после смены ключевой пары участника.
+func (v Vault) SetWrappedKey(ctx context.Context, userUUID, vaultUUID string, wrappedKey []byte) error {
+	member, err := v.findMember(ctx, vaultUUID, userUUID)
+	if err != nil {
+		return err
+	}
+
+	member.WrappedKey = wrappedKey
+	return v.repo.SaveMember(ctx, *member)
+}
+
+// RemoveMember метод удаляет участника из хранилища.
+// Владелец может удалить любого участника, остальные — только себя (выйти из хранилища).
+func (v Vault) RemoveMember(ctx context.Context, userUUID, vaultUUID, login string) error {
+	user, err := v.userRepo.FindByLogin(ctx, login)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return ErrMemberNotFound
+		}
+		return err
+	}
+
+	if user.UUID != userUUID {
+		if err = v.checkOwner(ctx, vaultUUID, userUUID); err != nil {
+			return err
+		}
+	} else if _, err = v.findMember(ctx, vaultUUID, userUUID); err != nil {
+		return err
+	}
+
+	if err = v.checkNotLastOwner(ctx, vaultUUID, user.UUID); err != nil {
+		return err
+	}
+
+	if err = v.repo.DeleteMember(ctx, vaultUUID, user.UUID); err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return ErrMemberNotFound
+		}
+		return err
+	}
+
+	return nil
+}
+
+// findMember метод ищет участника хранилища.
+func (v Vault) findMember(ctx context.Context, vaultUUID, userUUID string) (*model.VaultMember, error) {
+	member, err := v.repo.FindMember(ctx, vaultUUID, userUUID)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return nil, ErrNotFound
+		}
+		return nil, err
+	}
+	return member, nil
+}
+
+// checkOwner метод проверяет, что пользователь является владельцем хранилища.
+func (v Vault) checkOwner(ctx context.Context, vaultUUID, userUUID string) error {
+	member, err := v.findMember(ctx, vaultUUID, userUUID)
+	if err != nil {
+		return err
+	}
+
+	if member.Role != model.VaultRoleOwner {
+		return ErrForbidden
+	}
+
+	return nil
+}
+
+// checkNotLastOwner метод проверяет, что у хранилища останется владелец
+// после удаления пользователя или лишения его роли владельца.
+func (v Vault) checkNotLastOwner(ctx context.Context, vaultUUID, userUUID string) error {
+	members, err := v.repo.FindMembers(ctx, vaultUUID)
+	if err != nil {
+		return err
+	}
+
+	for _, member := range members {
+		if member.Role == model.VaultRoleOwner && member.UserUUID != userUUID {
+			return nil
+		}
+	}
+
+	for _, member := range members {
+		if member.UserUUID == userUUID && member.Role == model.VaultRoleOwner {
+			return ErrLastOwner
+		}
+	}
+
+	return nil
+}
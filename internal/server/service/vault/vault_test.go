@@ -0,0 +1,194 @@
+package vault
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/casnerano/seckeep/internal/server/model"
+	"github.com/casnerano/seckeep/internal/server/repository"
+	mock_repository "github.com/casnerano/seckeep/internal/server/repository/mock"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/suite"
+)
+
+var errUnknown = errors.New("unknown error")
+
+type VaultTestSuite struct {
+	suite.Suite
+	vaultService *Vault
+	vaultRepo    *mock_repository.MockVault
+	userRepo     *mock_repository.MockUser
+
+	vaultUUID string
+	owner     model.VaultMember
+	viewer    model.VaultMember
+}
+
+func (s *VaultTestSuite) SetupSuite() {
+	ctrl := gomock.NewController(s.T())
+	defer ctrl.Finish()
+
+	s.vaultRepo = mock_repository.NewMockVault(ctrl)
+	s.userRepo = mock_repository.NewMockUser(ctrl)
+	s.vaultService = New(s.vaultRepo, s.userRepo)
+
+	s.vaultUUID = "4c9f2a1e-0a6b-4d3c-8e2f-1a2b3c4d5e6f"
+	s.owner = model.VaultMember{
+		VaultUUID: s.vaultUUID,
+		UserUUID:  "f9bd9622-f730-11ed-b67e-0242ac120002",
+		Login:     "ivan",
+		Role:      model.VaultRoleOwner,
+	}
+	s.viewer = model.VaultMember{
+		VaultUUID: s.vaultUUID,
+		UserUUID:  "f9bd9622-f730-11ed-b67e-0242ac130002",
+		Login:     "petr",
+		Role:      model.VaultRoleViewer,
+	}
+}
+
+func (s *VaultTestSuite) TestRole() {
+	s.Run("Member", func() {
+		s.vaultRepo.EXPECT().FindMember(gomock.Any(), s.vaultUUID, s.viewer.UserUUID).Return(&s.viewer, nil)
+
+		role, err := s.vaultService.Role(context.Background(), s.vaultUUID, s.viewer.UserUUID)
+		s.NoError(err)
+		s.Equal(model.VaultRoleViewer, role)
+	})
+
+	s.Run("Not a member", func() {
+		s.vaultRepo.EXPECT().FindMember(gomock.Any(), s.vaultUUID, "unknown").Return(nil, repository.ErrNotFound)
+
+		_, err := s.vaultService.Role(context.Background(), s.vaultUUID, "unknown")
+		s.ErrorIs(err, ErrNotFound)
+	})
+}
+
+func (s *VaultTestSuite) TestSaveMember() {
+	wrappedKey := []byte("wrapped key")
+
+	s.Run("Owner adds member", func() {
+		s.vaultRepo.EXPECT().FindMember(gomock.Any(), s.vaultUUID, s.owner.UserUUID).Return(&s.owner, nil)
+		s.userRepo.EXPECT().FindByLogin(gomock.Any(), s.viewer.Login).
+			Return(&model.User{UUID: s.viewer.UserUUID, Login: s.viewer.Login}, nil)
+		s.vaultRepo.EXPECT().FindMembers(gomock.Any(), s.vaultUUID).Return([]*model.VaultMember{&s.owner}, nil)
+		s.vaultRepo.EXPECT().SaveMember(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(_ context.Context, member model.VaultMember) error {
+				s.Equal(s.viewer.UserUUID, member.UserUUID)
+				s.Equal(model.VaultRoleEditor, member.Role)
+				s.Equal(wrappedKey, member.WrappedKey)
+				return nil
+			},
+		)
+
+		member, err := s.vaultService.SaveMember(
+			context.Background(), s.owner.UserUUID, s.vaultUUID, s.viewer.Login, model.VaultRoleEditor, wrappedKey,
+		)
+		s.Require().NoError(err)
+		s.Equal(s.viewer.Login, member.Login)
+	})
+
+	s.Run("Viewer is not allowed", func() {
+		s.vaultRepo.EXPECT().FindMember(gomock.Any(), s.vaultUUID, s.viewer.UserUUID).Return(&s.viewer, nil)
+
+		_, err := s.vaultService.SaveMember(
+			context.Background(), s.viewer.UserUUID, s.vaultUUID, "semen", model.VaultRoleViewer, wrappedKey,
+		)
+		s.ErrorIs(err, ErrForbidden)
+	})
+
+	s.Run("Unknown user", func() {
+		s.vaultRepo.EXPECT().FindMember(gomock.Any(), s.vaultUUID, s.owner.UserUUID).Return(&s.owner, nil)
+		s.userRepo.EXPECT().FindByLogin(gomock.Any(), "semen").Return(nil, repository.ErrNotFound)
+
+		_, err := s.vaultService.SaveMember(
+			context.Background(), s.owner.UserUUID, s.vaultUUID, "semen", model.VaultRoleViewer, wrappedKey,
+		)
+		s.ErrorIs(err, ErrUserNotFound)
+	})
+
+	s.Run("Last owner demotes himself", func() {
+		s.vaultRepo.EXPECT().FindMember(gomock.Any(), s.vaultUUID, s.owner.UserUUID).Return(&s.owner, nil)
+		s.userRepo.EXPECT().FindByLogin(gomock.Any(), s.owner.Login).
+			Return(&model.User{UUID: s.owner.UserUUID, Login: s.owner.Login}, nil)
+		s.vaultRepo.EXPECT().FindMembers(gomock.Any(), s.vaultUUID).Return([]*model.VaultMember{&s.owner, &s.viewer}, nil)
+
+		_, err := s.vaultService.SaveMember(
+			context.Background(), s.owner.UserUUID, s.vaultUUID, s.owner.Login, model.VaultRoleViewer, wrappedKey,
+		)
+		s.ErrorIs(err, ErrLastOwner)
+	})
+}
+
+func (s *VaultTestSuite) TestRemoveMember() {
+	s.Run("Member leaves vault", func() {
+		s.userRepo.EXPECT().FindByLogin(gomock.Any(), s.viewer.Login).
+			Return(&model.User{UUID: s.viewer.UserUUID, Login: s.viewer.Login}, nil)
+		s.vaultRepo.EXPECT().FindMember(gomock.Any(), s.vaultUUID, s.viewer.UserUUID).Return(&s.viewer, nil)
+		s.vaultRepo.EXPECT().FindMembers(gomock.Any(), s.vaultUUID).Return([]*model.VaultMember{&s.owner, &s.viewer}, nil)
+		s.vaultRepo.EXPECT().DeleteMember(gomock.Any(), s.vaultUUID, s.viewer.UserUUID).Return(nil)
+
+		s.NoError(s.vaultService.RemoveMember(context.Background(), s.viewer.UserUUID, s.vaultUUID, s.viewer.Login))
+	})
+
+	s.Run("Viewer removes owner", func() {
+		s.userRepo.EXPECT().FindByLogin(gomock.Any(), s.owner.Login).
+			Return(&model.User{UUID: s.owner.UserUUID, Login: s.owner.Login}, nil)
+		s.vaultRepo.EXPECT().FindMember(gomock.Any(), s.vaultUUID, s.viewer.UserUUID).Return(&s.viewer, nil)
+
+		err := s.vaultService.RemoveMember(context.Background(), s.viewer.UserUUID, s.vaultUUID, s.owner.Login)
+		s.ErrorIs(err, ErrForbidden)
+	})
+
+	s.Run("Last owner leaves vault", func() {
+		s.userRepo.EXPECT().FindByLogin(gomock.Any(), s.owner.Login).
+			Return(&model.User{UUID: s.owner.UserUUID, Login: s.owner.Login}, nil)
+		s.vaultRepo.EXPECT().FindMember(gomock.Any(), s.vaultUUID, s.owner.UserUUID).Return(&s.owner, nil)
+		s.vaultRepo.EXPECT().FindMembers(gomock.Any(), s.vaultUUID).Return([]*model.VaultMember{&s.owner, &s.viewer}, nil)
+
+		err := s.vaultService.RemoveMember(context.Background(), s.owner.UserUUID, s.vaultUUID, s.owner.Login)
+		s.ErrorIs(err, ErrLastOwner)
+	})
+
+	s.Run("Repository error", func() {
+		s.userRepo.EXPECT().FindByLogin(gomock.Any(), s.viewer.Login).Return(nil, errUnknown)
+
+		err := s.vaultService.RemoveMember(context.Background(), s.owner.UserUUID, s.vaultUUID, s.viewer.Login)
+		s.ErrorIs(err, errUnknown)
+	})
+}
+
+func (s *VaultTestSuite) TestSetWrappedKey() {
+	wrappedKey := []byte("rewrapped key")
+
+	s.Run("Member", func() {
+		viewer := s.viewer
+		s.vaultRepo.EXPECT().FindMember(gomock.Any(), s.vaultUUID, viewer.UserUUID).Return(&viewer, nil)
+
+		saved := s.viewer
+		saved.WrappedKey = wrappedKey
+		s.vaultRepo.EXPECT().SaveMember(gomock.Any(), saved).Return(nil)
+
+		s.NoError(s.vaultService.SetWrappedKey(context.Background(), viewer.UserUUID, s.vaultUUID, wrappedKey))
+	})
+
+	s.Run("Not a member", func() {
+		s.vaultRepo.EXPECT().FindMember(gomock.Any(), s.vaultUUID, "unknown").Return(nil, repository.ErrNotFound)
+
+		s.ErrorIs(s.vaultService.SetWrappedKey(context.Background(), "unknown", s.vaultUUID, wrappedKey), ErrNotFound)
+	})
+}
+
+func (s *VaultTestSuite) TestMembers() {
+	s.Run("Not a member", func() {
+		s.vaultRepo.EXPECT().FindMember(gomock.Any(), s.vaultUUID, "unknown").Return(nil, repository.ErrNotFound)
+
+		_, err := s.vaultService.Members(context.Background(), "unknown", s.vaultUUID)
+		s.ErrorIs(err, ErrNotFound)
+	})
+}
+
+func TestVaultTestSuite(t *testing.T) {
+	suite.Run(t, new(VaultTestSuite))
+}
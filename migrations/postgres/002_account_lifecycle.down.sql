@@ -0,0 +1,4 @@
+alter table data drop constraint if exists data_fk_user;
+alter table data add constraint data_fk_user foreign key (user_uuid) references users (uuid);
+
+alter table users drop column if exists token_version;
@@ -0,0 +1,4 @@
+alter table users add column if not exists token_version integer default 0 not null;
+
+alter table data drop constraint if exists data_fk_user;
+alter table data add constraint data_fk_user foreign key (user_uuid) references users (uuid) on delete cascade;
@@ -0,0 +1,8 @@
+create table if not exists revoked_sessions (
+    session_id character varying(64) primary key not null,
+    user_uuid uuid not null,
+    expires_at timestamp not null,
+    constraint revoked_sessions_fk_user foreign key (user_uuid) references users (uuid) on delete cascade
+);
+
+create index if not exists revoked_sessions_expires_at on revoked_sessions (expires_at);
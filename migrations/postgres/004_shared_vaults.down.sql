@@ -0,0 +1,16 @@
+delete from data where vault_uuid is not null or user_uuid is null;
+
+alter table data drop constraint if exists data_fk_user;
+alter table data add constraint data_fk_user foreign key (user_uuid) references users (uuid) on delete cascade;
+alter table data alter column user_uuid set not null;
+
+drop index if exists data_vault_uuid;
+alter table data drop constraint if exists data_fk_vault;
+alter table data drop column if exists vault_uuid;
+
+drop table if exists vault_members;
+drop table if exists vaults;
+
+alter table users drop column if exists public_key;
+
+drop type if exists vault_role;
@@ -0,0 +1,31 @@
+create type vault_role as enum ('OWNER', 'EDITOR', 'VIEWER');
+
+alter table users add column if not exists public_key bytea;
+
+create table if not exists vaults (
+    uuid uuid primary key default uuid_generate_v4() not null,
+    name character varying(100) not null,
+    created_at timestamp default now() not null
+);
+
+create table if not exists vault_members (
+    vault_uuid uuid not null,
+    user_uuid uuid not null,
+    role vault_role not null,
+    wrapped_key bytea not null,
+    created_at timestamp default now() not null,
+    primary key (vault_uuid, user_uuid),
+    constraint vault_members_fk_vault foreign key (vault_uuid) references vaults (uuid) on delete cascade,
+    constraint vault_members_fk_user foreign key (user_uuid) references users (uuid) on delete cascade
+);
+
+create index if not exists vault_members_user_uuid on vault_members (user_uuid);
+
+alter table data add column if not exists vault_uuid uuid;
+alter table data add constraint data_fk_vault foreign key (vault_uuid) references vaults (uuid) on delete cascade;
+create index if not exists data_vault_uuid on data (vault_uuid);
+
+-- Записи общего хранилища переживают удаление аккаунта автора.
+alter table data alter column user_uuid drop not null;
+alter table data drop constraint if exists data_fk_user;
+alter table data add constraint data_fk_user foreign key (user_uuid) references users (uuid) on delete set null;
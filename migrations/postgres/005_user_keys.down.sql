@@ -0,0 +1,3 @@
+alter table users drop column if exists private_keys;
+alter table users drop column if exists signing_key;
+alter table users rename column encryption_key to public_key;
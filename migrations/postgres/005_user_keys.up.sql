@@ -0,0 +1,3 @@
+alter table users rename column public_key to encryption_key;
+alter table users add column if not exists signing_key bytea;
+alter table users add column if not exists private_keys bytea;
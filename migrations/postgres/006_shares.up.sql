@@ -0,0 +1,11 @@
+create table if not exists shares (
+    uuid uuid primary key default uuid_generate_v4() not null,
+    user_uuid uuid not null,
+    value bytea not null,
+    views_left integer not null,
+    expires_at timestamp not null,
+    created_at timestamp default now() not null,
+    constraint shares_fk_user foreign key (user_uuid) references users (uuid) on delete cascade
+);
+
+create index if not exists shares_expires_at on shares (expires_at);
@@ -0,0 +1,2 @@
+drop table if exists emergency_access;
+drop type if exists emergency_status;
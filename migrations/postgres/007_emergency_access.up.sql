@@ -0,0 +1,18 @@
+create type emergency_status as enum ('GRANTED', 'REQUESTED', 'RELEASED');
+
+create table if not exists emergency_access (
+    owner_uuid uuid not null,
+    contact_uuid uuid not null,
+    wrapped_key bytea not null,
+    wait_period integer not null,
+    status emergency_status default 'GRANTED' not null,
+    requested_at timestamp,
+    release_at timestamp,
+    created_at timestamp default now() not null,
+    primary key (owner_uuid, contact_uuid),
+    constraint emergency_access_fk_owner foreign key (owner_uuid) references users (uuid) on delete cascade,
+    constraint emergency_access_fk_contact foreign key (contact_uuid) references users (uuid) on delete cascade
+);
+
+create index if not exists emergency_access_contact_uuid on emergency_access (contact_uuid);
+create index if not exists emergency_access_release_at on emergency_access (release_at) where status = 'REQUESTED';
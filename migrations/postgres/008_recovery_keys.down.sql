@@ -0,0 +1,2 @@
+alter table users drop column if exists recovery_key;
+alter table users drop column if exists recovery_verifier;
@@ -0,0 +1,2 @@
+alter table users add column if not exists recovery_verifier bytea;
+alter table users add column if not exists recovery_key bytea;
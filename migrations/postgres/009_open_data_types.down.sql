@@ -0,0 +1,9 @@
+create type data_type as enum ('CREDENTIAL','TEXT', 'CARD', 'DOCUMENT');
+
+-- Записи новых типов в прежнее перечисление не приводятся и удаляются без возможности восстановления.
+-- Их ревизии в data_history (если таблица еще существует) удаляются каскадно.
+delete from data where type not in ('CREDENTIAL', 'TEXT', 'CARD', 'DOCUMENT');
+
+alter table data drop constraint if exists data_type_format;
+
+alter table data alter column type type data_type using type::data_type;
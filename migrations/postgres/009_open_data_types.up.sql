@@ -0,0 +1,5 @@
+alter table data alter column type type character varying(32) using type::text;
+
+alter table data add constraint data_type_format check (type ~ '^[A-Z][A-Z0-9_]{0,31}$');
+
+drop type if exists data_type;
@@ -0,0 +1,9 @@
+create table if not exists data_history (
+    data_uuid uuid not null,
+    revision integer not null,
+    value bytea not null,
+    version timestamp not null,
+    created_at timestamp default now() not null,
+    constraint data_history_pk primary key (data_uuid, revision),
+    constraint data_history_fk_data foreign key (data_uuid) references data (uuid) on delete cascade
+);
@@ -0,0 +1,5 @@
+delete from data where deleted_at is not null;
+
+drop index if exists data_deleted_at;
+
+alter table data drop column if exists deleted_at;
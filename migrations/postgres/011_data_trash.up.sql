@@ -0,0 +1,3 @@
+alter table data add column if not exists deleted_at timestamp;
+
+create index if not exists data_deleted_at on data (deleted_at) where deleted_at is not null;
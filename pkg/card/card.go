@@ -0,0 +1,280 @@
+// Package card для работы с номерами и сроками действия банковских карт:
+// определение платежной системы, проверка по алгоритму Луна, форматирование и маскирование номера,
+// разбор срока действия.
+package card
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+)
+
+// Основные ошибки при работе с картами.
+var (
+	// ErrInvalidNumber номер содержит недопустимые символы или имеет недопустимую длину.
+	ErrInvalidNumber = errors.New("invalid card number")
+	// ErrChecksum номер не проходит проверку по алгоритму Луна.
+	ErrChecksum = errors.New("invalid card number checksum")
+	// ErrInvalidExpiry некорректный срок действия.
+	ErrInvalidExpiry = errors.New("invalid card expiry, expected MM/YY")
+)
+
+// Допустимая длина номера карты.
+const (
+	minNumberLength = 12
+	maxNumberLength = 19
+)
+
+// Brand платежная система.
+type Brand string
+
+// Платежные системы.
+const (
+	BrandUnknown    Brand = ""
+	BrandVisa       Brand = "Visa"
+	BrandMastercard Brand = "Mastercard"
+	BrandMir        Brand = "Mir"
+	BrandAmex       Brand = "American Express"
+	BrandUnionPay   Brand = "UnionPay"
+	BrandJCB        Brand = "JCB"
+	BrandDiscover   Brand = "Discover"
+	BrandDiners     Brand = "Diners Club"
+	BrandMaestro    Brand = "Maestro"
+)
+
+// brandRule правило определения платежной системы: диапазоны префиксов и допустимые длины номера.
+type brandRule struct {
+	brand    Brand
+	prefixes [][2]int
+	lengths  []int
+}
+
+// brandRules правила определения платежных систем.
+// Порядок важен: более узкие диапазоны проверяются раньше широких (Mir 2200–2204 раньше Mastercard 2221–2720,
+// Maestro — последним).
+var brandRules = []brandRule{
+	{BrandMir, [][2]int{{2200, 2204}}, []int{16, 17, 18, 19}},
+	{BrandAmex, [][2]int{{34, 34}, {37, 37}}, []int{15}},
+	{BrandVisa, [][2]int{{4, 4}}, []int{13, 16, 19}},
+	{BrandMastercard, [][2]int{{51, 55}, {2221, 2720}}, []int{16}},
+	{BrandDiscover, [][2]int{{6011, 6011}, {644, 649}, {65, 65}}, []int{16, 17, 18, 19}},
+	{BrandUnionPay, [][2]int{{62, 62}}, []int{16, 17, 18, 19}},
+	{BrandJCB, [][2]int{{3528, 3589}}, []int{16, 17, 18, 19}},
+	{BrandDiners, [][2]int{{300, 305}, {36, 36}, {38, 39}}, []int{14, 15, 16, 17, 18, 19}},
+	{BrandMaestro, [][2]int{{50, 50}, {56, 58}, {6304, 6304}, {6759, 6759}, {6761, 6763}}, []int{12, 13, 14, 15, 16, 17, 18, 19}},
+}
+
+// Normalize возвращает номер карты без пробелов и дефисов.
+func Normalize(number string) string {
+	return strings.Map(func(r rune) rune {
+		if r == ' ' || r == '-' {
+			return -1
+		}
+		return r
+	}, number)
+}
+
+// Validate проверяет номер карты: только цифры, допустимая длина (в том числе для платежной системы)
+// и контрольная сумма по алгоритму Луна. Пробелы и дефисы допускаются.
+func Validate(number string) error {
+	number = Normalize(number)
+
+	if len(number) < minNumberLength || len(number) > maxNumberLength {
+		return ErrInvalidNumber
+	}
+
+	for _, r := range number {
+		if !unicode.IsDigit(r) || r > unicode.MaxASCII {
+			return ErrInvalidNumber
+		}
+	}
+
+	if rule, ok := detect(number); ok && !contains(rule.lengths, len(number)) {
+		return ErrInvalidNumber
+	}
+
+	if !Luhn(number) {
+		return ErrChecksum
+	}
+
+	return nil
+}
+
+// Luhn проверяет контрольную сумму номера по алгоритму Луна.
+func Luhn(number string) bool {
+	number = Normalize(number)
+	if number == "" {
+		return false
+	}
+
+	sum := 0
+	double := false
+	for i := len(number) - 1; i >= 0; i-- {
+		digit := int(number[i] - '0')
+		if digit < 0 || digit > 9 {
+			return false
+		}
+
+		if double {
+			digit *= 2
+			if digit > 9 {
+				digit -= 9
+			}
+		}
+
+		sum += digit
+		double = !double
+	}
+
+	return sum%10 == 0
+}
+
+// DetectBrand определяет платежную систему по префиксу номера.
+func DetectBrand(number string) Brand {
+	if rule, ok := detect(Normalize(number)); ok {
+		return rule.brand
+	}
+	return BrandUnknown
+}
+
+// Format возвращает номер, разбитый на группы цифр: 4-6-5 для American Express,
+// 4-6-4 для 14-значных Diners Club, по 4 цифры для остальных.
+func Format(number string) string {
+	number = Normalize(number)
+
+	groups := []int{4, 4, 4, 4, 4}
+	switch brand := DetectBrand(number); {
+	case brand == BrandAmex:
+		groups = []int{4, 6, 5}
+	case brand == BrandDiners && len(number) == 14:
+		groups = []int{4, 6, 4}
+	}
+
+	parts := make([]string, 0, len(groups))
+	for _, size := range groups {
+		if number == "" {
+			break
+		}
+		if size > len(number) {
+			size = len(number)
+		}
+		parts = append(parts, number[:size])
+		number = number[size:]
+	}
+
+	if number != "" {
+		parts = append(parts, number)
+	}
+
+	return strings.Join(parts, " ")
+}
+
+// Mask возвращает маскированный номер, в котором видны только последние четыре цифры.
+func Mask(number string) string {
+	number = Normalize(number)
+	if len(number) <= 4 {
+		return "****"
+	}
+	return "**** " + number[len(number)-4:]
+}
+
+// Expiry срок действия карты: карта действует до конца месяца Month года Year.
+type Expiry struct {
+	Month time.Month
+	Year  int
+}
+
+// ParseExpiry разбирает срок действия в формате MM/YY или MM/YYYY.
+// В качестве разделителя допускаются «/», «.», «-» и пробел, а также формат MMYY без разделителя.
+func ParseExpiry(s string) (Expiry, error) {
+	s = strings.TrimSpace(s)
+
+	month, year, ok := "", "", false
+	for _, sep := range []string{"/", ".", "-", " "} {
+		if month, year, ok = strings.Cut(s, sep); ok {
+			break
+		}
+	}
+	if !ok && len(s) == 4 {
+		month, year, ok = s[:2], s[2:], true
+	}
+	if !ok {
+		return Expiry{}, ErrInvalidExpiry
+	}
+
+	m, err := strconv.Atoi(strings.TrimSpace(month))
+	if err != nil || m < 1 || m > 12 {
+		return Expiry{}, ErrInvalidExpiry
+	}
+
+	year = strings.TrimSpace(year)
+	y, err := strconv.Atoi(year)
+	if err != nil || y < 0 {
+		return Expiry{}, ErrInvalidExpiry
+	}
+
+	switch len(year) {
+	case 2:
+		y += 2000
+	case 4:
+	default:
+		return Expiry{}, ErrInvalidExpiry
+	}
+
+	return Expiry{Month: time.Month(m), Year: y}, nil
+}
+
+// End возвращает момент окончания срока действия — начало следующего месяца по местному времени.
+func (e Expiry) End() time.Time {
+	return time.Date(e.Year, e.Month+1, 1, 0, 0, 0, 0, time.Local)
+}
+
+// Expired проверяет, истек ли срок действия на момент now.
+func (e Expiry) Expired(now time.Time) bool {
+	return !now.Before(e.End())
+}
+
+// DaysLeft возвращает кол-во полных дней до окончания срока действия, для истекших карт — отрицательное.
+func (e Expiry) DaysLeft(now time.Time) int {
+	return int(e.End().Sub(now).Hours() / 24)
+}
+
+// String возвращает срок действия в формате MM/YY.
+func (e Expiry) String() string {
+	return fmt.Sprintf("%02d/%02d", int(e.Month), e.Year%100)
+}
+
+// detect возвращает правило платежной системы, префикс которой совпадает с номером.
+func detect(number string) (brandRule, bool) {
+	for _, rule := range brandRules {
+		for _, prefix := range rule.prefixes {
+			digits := len(strconv.Itoa(prefix[0]))
+			if len(number) < digits {
+				continue
+			}
+
+			value, err := strconv.Atoi(number[:digits])
+			if err != nil {
+				return brandRule{}, false
+			}
+
+			if value >= prefix[0] && value <= prefix[1] {
+				return rule, true
+			}
+		}
+	}
+	return brandRule{}, false
+}
+
+// contains проверяет, есть ли значение в слайсе.
+func contains(values []int, value int) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
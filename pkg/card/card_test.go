@@ -0,0 +1,124 @@
+package card
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestDetectBrand(t *testing.T) {
+	tests := []struct {
+		number string
+		brand  Brand
+	}{
+		{"4111 1111 1111 1111", BrandVisa},
+		{"5555555555554444", BrandMastercard},
+		{"2223003122003222", BrandMastercard},
+		{"2200000000000004", BrandMir},
+		{"378282246310005", BrandAmex},
+		{"6011111111111117", BrandDiscover},
+		{"3530111333300000", BrandJCB},
+		{"30569309025904", BrandDiners},
+		{"6200000000000005", BrandUnionPay},
+		{"6759649826438453", BrandMaestro},
+		{"9999999999999995", BrandUnknown},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.number, func(t *testing.T) {
+			if got := DetectBrand(tt.number); got != tt.brand {
+				t.Errorf("DetectBrand() = %q, want %q", got, tt.brand)
+			}
+		})
+	}
+}
+
+func TestValidate(t *testing.T) {
+	tests := []struct {
+		name   string
+		number string
+		err    error
+	}{
+		{"Valid", "4111-1111-1111-1111", nil},
+		{"Valid Mir", "2200 0000 0000 0004", nil},
+		{"Checksum", "4111111111111112", ErrChecksum},
+		{"Letters", "4111a11111111111", ErrInvalidNumber},
+		{"Short", "41111111", ErrInvalidNumber},
+		{"Brand length", "37828224631000", ErrInvalidNumber},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := Validate(tt.number); !errors.Is(err, tt.err) {
+				t.Errorf("Validate() error = %v, want %v", err, tt.err)
+			}
+		})
+	}
+}
+
+func TestFormatMask(t *testing.T) {
+	tests := []struct {
+		number, formatted, masked string
+	}{
+		{"4111111111111111", "4111 1111 1111 1111", "**** 1111"},
+		{"378282246310005", "3782 822463 10005", "**** 0005"},
+		{"30569309025904", "3056 930902 5904", "**** 5904"},
+		{"4111 1111 1111 1111 111", "4111 1111 1111 1111 111", "**** 1111"},
+		{"123", "123", "****"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.number, func(t *testing.T) {
+			if got := Format(tt.number); got != tt.formatted {
+				t.Errorf("Format() = %q, want %q", got, tt.formatted)
+			}
+			if got := Mask(tt.number); got != tt.masked {
+				t.Errorf("Mask() = %q, want %q", got, tt.masked)
+			}
+		})
+	}
+}
+
+func TestParseExpiry(t *testing.T) {
+	for _, value := range []string{"06/28", "06.28", "6-2028", "0628", " 06 / 28 "} {
+		t.Run(value, func(t *testing.T) {
+			expiry, err := ParseExpiry(value)
+			if err != nil {
+				t.Fatalf("ParseExpiry() error = %v", err)
+			}
+			if expiry != (Expiry{Month: time.June, Year: 2028}) {
+				t.Errorf("ParseExpiry() = %+v, want 06/2028", expiry)
+			}
+			if expiry.String() != "06/28" {
+				t.Errorf("String() = %q, want 06/28", expiry.String())
+			}
+		})
+	}
+
+	for _, value := range []string{"", "13/28", "00/28", "06/2", "june/28", "062"} {
+		if _, err := ParseExpiry(value); !errors.Is(err, ErrInvalidExpiry) {
+			t.Errorf("ParseExpiry(%q) error = %v, want %v", value, err, ErrInvalidExpiry)
+		}
+	}
+}
+
+func TestExpiry(t *testing.T) {
+	expiry := Expiry{Month: time.December, Year: 2024}
+	now := time.Date(2024, time.December, 1, 12, 0, 0, 0, time.Local)
+
+	if !expiry.End().Equal(time.Date(2025, time.January, 1, 0, 0, 0, 0, time.Local)) {
+		t.Errorf("End() = %v, want 2025-01-01", expiry.End())
+	}
+
+	if expiry.Expired(now) {
+		t.Errorf("Expired() = true, want false")
+	}
+
+	if got := expiry.DaysLeft(now); got != 30 {
+		t.Errorf("DaysLeft() = %d, want 30", got)
+	}
+
+	if !expiry.Expired(expiry.End()) {
+		t.Errorf("Expired() at the end = false, want true")
+	}
+}
@@ -6,9 +6,13 @@ import (
 	"crypto/cipher"
 	"crypto/rand"
 	"crypto/sha256"
+	"errors"
 	"io"
 )
 
+// ErrShortCiphertext шифротекст короче nonce.
+var ErrShortCiphertext = errors.New("ciphertext too short")
+
 // Cipher структура шифрователя.
 type Cipher struct {
 	key []byte
@@ -54,6 +58,10 @@ func (c *Cipher) Decrypt(dst []byte) ([]byte, error) {
 	}
 
 	nonceSize := aesgcm.NonceSize()
+	if len(dst) < nonceSize {
+		return nil, ErrShortCiphertext
+	}
+
 	nonce, dst := dst[:nonceSize], dst[nonceSize:]
 
 	return aesgcm.Open(nil, nonce, dst, nil)
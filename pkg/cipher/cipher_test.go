@@ -2,6 +2,7 @@ package cipher
 
 import (
 	"bytes"
+	"errors"
 	"testing"
 )
 
@@ -36,3 +37,10 @@ func TestCipher(t *testing.T) {
 		})
 	}
 }
+
+func TestCipher_DecryptShort(t *testing.T) {
+	c := New([]byte("example key"))
+	if _, err := c.Decrypt([]byte("short")); !errors.Is(err, ErrShortCiphertext) {
+		t.Errorf("Decrypt() error = %v, want %v", err, ErrShortCiphertext)
+	}
+}
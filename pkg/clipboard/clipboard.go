@@ -0,0 +1,158 @@
+// Package clipboard для записи значений в буфер обмена и их очистки.
+// Поддерживаются буфер Wayland (wl-copy/wl-paste), X11 (xclip) и escape-последовательность OSC 52,
+// которой терминал (в том числе по SSH) копирует значение в буфер обмена локальной машины.
+package clipboard
+
+import (
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"strings"
+
+	"golang.org/x/term"
+)
+
+// ttyPath путь к управляющему терминалу процесса.
+const ttyPath = "/dev/tty"
+
+// ErrUnavailable буфер обмена недоступен: нет графического окружения и управляющего терминала.
+var ErrUnavailable = errors.New("clipboard is unavailable: no wl-copy, xclip or controlling terminal")
+
+// Clipboard интерфейс буфера обмена.
+type Clipboard interface {
+	// Write записывает значение в буфер обмена.
+	Write(text string) error
+	// Clear очищает буфер обмена, если в нем все еще находится значение text.
+	// Буферы, из которых нельзя прочитать значение, очищаются без проверки.
+	Clear(text string) error
+}
+
+// Command структура буфера обмена, управляемого внешними командами (wl-copy, xclip).
+type Command struct {
+	copyCmd  []string
+	pasteCmd []string
+}
+
+// NewCommand конструктор.
+// copyCmd записывает в буфер обмена стандартный ввод, pasteCmd выводит содержимое буфера.
+func NewCommand(copyCmd, pasteCmd []string) *Command {
+	return &Command{copyCmd: copyCmd, pasteCmd: pasteCmd}
+}
+
+// NewWayland конструктор буфера обмена Wayland.
+func NewWayland() *Command {
+	return NewCommand([]string{"wl-copy"}, []string{"wl-paste", "--no-newline"})
+}
+
+// NewX11 конструктор буфера обмена X11.
+func NewX11() *Command {
+	return NewCommand(
+		[]string{"xclip", "-selection", "clipboard", "-in"},
+		[]string{"xclip", "-selection", "clipboard", "-out"},
+	)
+}
+
+// Write метод записывает значение в буфер обмена.
+// Вывод команды не перехватывается: wl-copy и xclip остаются владельцами буфера в фоне
+// и держали бы открытым перехваченный вывод.
+func (c *Command) Write(text string) error {
+	cmd := exec.Command(c.copyCmd[0], c.copyCmd[1:]...)
+	cmd.Stdin = strings.NewReader(text)
+
+	if err := cmd.Run(); err != nil {
+		return fmt.Errorf("%s: %w", c.copyCmd[0], err)
+	}
+
+	return nil
+}
+
+// Clear метод очищает буфер обмена, если в нем все еще находится значение text.
+func (c *Command) Clear(text string) error {
+	current, err := exec.Command(c.pasteCmd[0], c.pasteCmd[1:]...).Output()
+	if err == nil && string(current) != text {
+		return nil
+	}
+	return c.Write("")
+}
+
+// OSC52 структура буфера обмена терминала: значение передается терминалу escape-последовательностью OSC 52.
+type OSC52 struct {
+	out io.Writer
+}
+
+// NewOSC52 конструктор, out — вывод терминала.
+func NewOSC52(out io.Writer) *OSC52 {
+	return &OSC52{out: out}
+}
+
+// Write метод записывает значение в буфер обмена.
+func (o *OSC52) Write(text string) error {
+	_, err := fmt.Fprintf(o.out, "\x1b]52;c;%s\x07", base64.StdEncoding.EncodeToString([]byte(text)))
+	return err
+}
+
+// Clear метод очищает буфер обмена. Прочитать буфер через OSC 52 нельзя, поэтому он очищается без проверки.
+func (o *OSC52) Clear(string) error {
+	return o.Write("")
+}
+
+// TTY структура буфера обмена управляющего терминала процесса: escape-последовательность OSC 52
+// пишется напрямую в терминал, поэтому значение не попадает в перенаправленный в файл или канал вывод.
+type TTY struct {
+	path string
+}
+
+// NewTTY конструктор.
+func NewTTY() *TTY {
+	return &TTY{path: ttyPath}
+}
+
+// Write метод записывает значение в буфер обмена.
+// Если управляющего терминала нет, возвращает ErrUnavailable.
+func (t *TTY) Write(text string) error {
+	tty, err := os.OpenFile(t.path, os.O_WRONLY, 0)
+	if err != nil {
+		return fmt.Errorf("%w: %v", ErrUnavailable, err)
+	}
+	defer func() { _ = tty.Close() }()
+
+	return NewOSC52(tty).Write(text)
+}
+
+// Clear метод очищает буфер обмена без проверки, как и OSC52.
+func (t *TTY) Clear(string) error {
+	return t.Write("")
+}
+
+// Detect возвращает буфер обмена окружения: Wayland, если задан WAYLAND_DISPLAY и установлен wl-copy,
+// X11, если задан DISPLAY и установлен xclip, иначе — OSC 52 с выводом в out, если out — терминал.
+// Если вывод перенаправлен в файл или канал, OSC 52 пишется в управляющий терминал (TTY),
+// чтобы значение не попало в перенаправленный вывод.
+func Detect(out *os.File) Clipboard {
+	if os.Getenv("WAYLAND_DISPLAY") != "" && installed("wl-copy", "wl-paste") {
+		return NewWayland()
+	}
+
+	if os.Getenv("DISPLAY") != "" && installed("xclip") {
+		return NewX11()
+	}
+
+	if term.IsTerminal(int(out.Fd())) {
+		return NewOSC52(out)
+	}
+
+	return NewTTY()
+}
+
+// installed проверяет, что все команды есть в PATH.
+func installed(names ...string) bool {
+	for _, name := range names {
+		if _, err := exec.LookPath(name); err != nil {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,171 @@
+package clipboard
+
+import (
+	"bytes"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+// writeScript создает исполняемый скрипт name в каталоге dir.
+func writeScript(t *testing.T, dir, name, script string) {
+	t.Helper()
+
+	if err := os.WriteFile(filepath.Join(dir, name), []byte("#!/bin/sh\n"+script+"\n"), 0700); err != nil {
+		t.Fatalf("WriteFile() error = %v", err)
+	}
+}
+
+func TestCommand(t *testing.T) {
+	dir := t.TempDir()
+	buffer := filepath.Join(dir, "buffer")
+	writeScript(t, dir, "copy", "cat > "+buffer)
+	writeScript(t, dir, "paste", "cat "+buffer)
+
+	c := NewCommand([]string{filepath.Join(dir, "copy")}, []string{filepath.Join(dir, "paste")})
+
+	read := func() string {
+		content, err := os.ReadFile(buffer)
+		if err != nil {
+			t.Fatalf("ReadFile() error = %v", err)
+		}
+		return string(content)
+	}
+
+	if err := c.Write("secret value"); err != nil {
+		t.Fatalf("Write() error = %v", err)
+	}
+	if got := read(); got != "secret value" {
+		t.Errorf("buffer = %q, want %q", got, "secret value")
+	}
+
+	if err := c.Clear("other value"); err != nil {
+		t.Fatalf("Clear() error = %v", err)
+	}
+	if got := read(); got != "secret value" {
+		t.Errorf("Clear() of another value changed the buffer to %q", got)
+	}
+
+	if err := c.Clear("secret value"); err != nil {
+		t.Fatalf("Clear() error = %v", err)
+	}
+	if got := read(); got != "" {
+		t.Errorf("buffer after Clear() = %q, want empty", got)
+	}
+
+	if err := NewCommand([]string{filepath.Join(dir, "missing")}, nil).Write("value"); err == nil {
+		t.Errorf("Write() with a missing command should fail")
+	}
+}
+
+func TestOSC52(t *testing.T) {
+	out := bytes.NewBufferString("")
+	c := NewOSC52(out)
+
+	if err := c.Write("secret"); err != nil {
+		t.Fatalf("Write() error = %v", err)
+	}
+	if err := c.Clear("secret"); err != nil {
+		t.Fatalf("Clear() error = %v", err)
+	}
+
+	if want := "\x1b]52;c;c2VjcmV0\x07\x1b]52;c;\x07"; out.String() != want {
+		t.Errorf("output = %q, want %q", out.String(), want)
+	}
+}
+
+func TestDetect(t *testing.T) {
+	dir := t.TempDir()
+	for _, name := range []string{"wl-copy", "wl-paste", "xclip"} {
+		writeScript(t, dir, name, "true")
+	}
+	t.Setenv("PATH", dir)
+
+	tests := []struct {
+		name    string
+		wayland string
+		display string
+		want    Clipboard
+	}{
+		{"Wayland", "wayland-0", ":0", NewWayland()},
+		{"X11", "", ":0", NewX11()},
+		{"Redirected output", "", "", NewTTY()},
+	}
+
+	// Вывод перенаправлен в файл, поэтому OSC 52 в него не пишется.
+	out, err := os.CreateTemp(t.TempDir(), "out")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() { _ = out.Close() }()
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv("WAYLAND_DISPLAY", tt.wayland)
+			t.Setenv("DISPLAY", tt.display)
+
+			got := Detect(out)
+			switch want := tt.want.(type) {
+			case *Command:
+				if c, ok := got.(*Command); !ok || c.copyCmd[0] != want.copyCmd[0] {
+					t.Errorf("Detect() = %#v, want %#v", got, want)
+				}
+			case *TTY:
+				if _, ok := got.(*TTY); !ok {
+					t.Errorf("Detect() = %#v, want TTY", got)
+				}
+			}
+		})
+	}
+}
+
+func TestTTY(t *testing.T) {
+	t.Run("Terminal", func(t *testing.T) {
+		// Обычный файл вместо /dev/tty.
+		path := filepath.Join(t.TempDir(), "tty")
+		if err := os.WriteFile(path, nil, 0600); err != nil {
+			t.Fatal(err)
+		}
+
+		if err := (&TTY{path: path}).Write("secret"); err != nil {
+			t.Fatalf("Write() error = %v", err)
+		}
+
+		content, err := os.ReadFile(path)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if want := "\x1b]52;c;c2VjcmV0\x07"; string(content) != want {
+			t.Errorf("output = %q, want %q", content, want)
+		}
+	})
+
+	t.Run("Without terminal", func(t *testing.T) {
+		err := (&TTY{path: filepath.Join(t.TempDir(), "missing")}).Write("secret")
+		if !errors.Is(err, ErrUnavailable) {
+			t.Errorf("Write() error = %v, want %v", err, ErrUnavailable)
+		}
+	})
+}
+
+func TestFake(t *testing.T) {
+	f := NewFake(nil)
+
+	if err := f.Write("secret"); err != nil {
+		t.Fatalf("Write() error = %v", err)
+	}
+	_ = f.Clear("other")
+	if f.Text() != "secret" {
+		t.Errorf("Text() = %q, want %q", f.Text(), "secret")
+	}
+	_ = f.Clear("secret")
+	if f.Text() != "" {
+		t.Errorf("Text() after Clear() = %q, want empty", f.Text())
+	}
+
+	errClipboard := errors.New("no clipboard")
+	if err := NewFake(errClipboard).Write("secret"); !errors.Is(err, errClipboard) {
+		t.Errorf("Write() error = %v, want %v", err, errClipboard)
+	}
+}
@@ -0,0 +1,51 @@
+package clipboard
+
+import "sync"
+
+// Fake структура буфера обмена в памяти для тестов.
+type Fake struct {
+	mu   sync.Mutex
+	text string
+	err  error
+}
+
+// NewFake конструктор, err — ошибка, которую возвращают все методы (nil — буфер работает).
+func NewFake(err error) *Fake {
+	return &Fake{err: err}
+}
+
+// Write метод записывает значение в буфер обмена.
+func (f *Fake) Write(text string) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if f.err != nil {
+		return f.err
+	}
+
+	f.text = text
+	return nil
+}
+
+// Clear метод очищает буфер обмена, если в нем все еще находится значение text.
+func (f *Fake) Clear(text string) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if f.err != nil {
+		return f.err
+	}
+
+	if f.text == text {
+		f.text = ""
+	}
+	return nil
+}
+
+// Text метод возвращает содержимое буфера обмена.
+func (f *Fake) Text() string {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	return f.text
+}
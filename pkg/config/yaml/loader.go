@@ -2,6 +2,7 @@
 package yaml
 
 import (
+	"io/fs"
 	"os"
 
 	"gopkg.in/yaml.v3"
@@ -21,3 +22,13 @@ func LoadFromFile(fName string, config any) error {
 
 	return nil
 }
+
+// SaveToFile сохранение конфигурации в файл с заданными правами доступа.
+func SaveToFile(fName string, config any, perm fs.FileMode) error {
+	content, err := yaml.Marshal(config)
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(fName, content, perm)
+}
@@ -2,6 +2,7 @@ package yaml
 
 import (
 	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -61,3 +62,20 @@ server:
 		require.Error(t, err)
 	})
 }
+
+func TestSaveToFile(t *testing.T) {
+	want := testConfig{}
+	want.App.Encryptor.Secret = "c4ca4238a0b923820dcc509a6f75849b"
+	want.Server.URL = "http://127.0.0.1:8081"
+
+	fName := filepath.Join(t.TempDir(), "config.yaml")
+	require.NoError(t, SaveToFile(fName, want, 0600))
+
+	info, err := os.Stat(fName)
+	require.NoError(t, err)
+	assert.Equal(t, os.FileMode(0600), info.Mode().Perm())
+
+	got := testConfig{}
+	require.NoError(t, LoadFromFile(fName, &got))
+	assert.Equal(t, want, got)
+}
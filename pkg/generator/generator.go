@@ -0,0 +1,230 @@
+// Package generator для генерации паролей и парольных фраз (diceware) с помощью crypto/rand.
+package generator
+
+import (
+	"crypto/rand"
+	_ "embed"
+	"errors"
+	"math"
+	"math/big"
+	"strings"
+)
+
+// Основные ошибки генератора.
+var (
+	// ErrNoCharClasses не выбран ни один класс символов.
+	ErrNoCharClasses = errors.New("no character classes selected")
+	// ErrInvalidLength длина пароля не положительная или меньше числа обязательных классов символов.
+	ErrInvalidLength = errors.New("invalid password length")
+	// ErrInvalidWords кол-во слов парольной фразы не положительное.
+	ErrInvalidWords = errors.New("invalid number of words")
+)
+
+// Классы символов пароля.
+const (
+	charsLower   = "abcdefghijklmnopqrstuvwxyz"
+	charsUpper   = "ABCDEFGHIJKLMNOPQRSTUVWXYZ"
+	charsDigits  = "0123456789"
+	charsSymbols = "!#$%&*+-=?@^_~.,:;()[]{}<>"
+
+	// charsAmbiguous символы, которые легко перепутать при чтении.
+	charsAmbiguous = "Il1O0o"
+)
+
+// Параметры по умолчанию.
+const (
+	DefaultPasswordLength      = 20
+	DefaultPassphraseWords     = 6
+	DefaultPassphraseSeparator = "-"
+)
+
+//go:embed wordlist.txt
+var wordlistRaw string
+
+// wordlist список слов для парольных фраз.
+var wordlist = strings.Fields(wordlistRaw)
+
+// PasswordOptions параметры генерации пароля.
+type PasswordOptions struct {
+	Length           int
+	Lower            bool
+	Upper            bool
+	Digits           bool
+	Symbols          bool
+	ExcludeAmbiguous bool
+	// Required в пароле есть хотя бы по одному символу каждого выбранного класса.
+	Required bool
+}
+
+// DefaultPasswordOptions возвращает параметры пароля по умолчанию:
+// 20 символов всех классов, по одному символу каждого класса обязательно.
+func DefaultPasswordOptions() PasswordOptions {
+	return PasswordOptions{
+		Length:   DefaultPasswordLength,
+		Lower:    true,
+		Upper:    true,
+		Digits:   true,
+		Symbols:  true,
+		Required: true,
+	}
+}
+
+// PassphraseOptions параметры генерации парольной фразы.
+type PassphraseOptions struct {
+	Words      int
+	Separator  string
+	Capitalize bool
+}
+
+// DefaultPassphraseOptions возвращает параметры парольной фразы по умолчанию: 6 слов через дефис.
+func DefaultPassphraseOptions() PassphraseOptions {
+	return PassphraseOptions{
+		Words:     DefaultPassphraseWords,
+		Separator: DefaultPassphraseSeparator,
+	}
+}
+
+// Password генерирует пароль по заданным параметрам.
+func Password(opts PasswordOptions) (string, error) {
+	classes := opts.classes()
+	if len(classes) == 0 {
+		return "", ErrNoCharClasses
+	}
+
+	if opts.Length <= 0 || (opts.Required && opts.Length < len(classes)) {
+		return "", ErrInvalidLength
+	}
+
+	password := make([]byte, 0, opts.Length)
+	if opts.Required {
+		for _, class := range classes {
+			c, err := pick(class)
+			if err != nil {
+				return "", err
+			}
+			password = append(password, c)
+		}
+	}
+
+	alphabet := strings.Join(classes, "")
+	for len(password) < opts.Length {
+		c, err := pick(alphabet)
+		if err != nil {
+			return "", err
+		}
+		password = append(password, c)
+	}
+
+	if err := shuffle(password); err != nil {
+		return "", err
+	}
+
+	return string(password), nil
+}
+
+// Passphrase генерирует парольную фразу из слов встроенного списка.
+func Passphrase(opts PassphraseOptions) (string, error) {
+	if opts.Words <= 0 {
+		return "", ErrInvalidWords
+	}
+
+	words := make([]string, 0, opts.Words)
+	for i := 0; i < opts.Words; i++ {
+		n, err := random(len(wordlist))
+		if err != nil {
+			return "", err
+		}
+
+		word := wordlist[n]
+		if opts.Capitalize {
+			word = strings.ToUpper(word[:1]) + word[1:]
+		}
+		words = append(words, word)
+	}
+
+	return strings.Join(words, opts.Separator), nil
+}
+
+// WordlistSize возвращает кол-во слов во встроенном списке.
+// Каждое слово парольной фразы дает log2(WordlistSize()) бит энтропии.
+func WordlistSize() int {
+	return len(wordlist)
+}
+
+// PasswordEntropy возвращает энтропию пароля в битах, сгенерированного по заданным параметрам.
+// Обязательные классы символов незначительно уменьшают энтропию, оценка это не учитывает.
+func PasswordEntropy(opts PasswordOptions) float64 {
+	alphabet := strings.Join(opts.classes(), "")
+	if alphabet == "" || opts.Length <= 0 {
+		return 0
+	}
+	return float64(opts.Length) * math.Log2(float64(len(alphabet)))
+}
+
+// PassphraseEntropy возвращает энтропию парольной фразы в битах, сгенерированной по заданным параметрам.
+func PassphraseEntropy(opts PassphraseOptions) float64 {
+	if opts.Words <= 0 {
+		return 0
+	}
+	return float64(opts.Words) * math.Log2(float64(len(wordlist)))
+}
+
+// classes возвращает выбранные классы символов.
+func (o PasswordOptions) classes() []string {
+	var classes []string
+	for _, class := range []struct {
+		enabled bool
+		chars   string
+	}{
+		{o.Lower, charsLower},
+		{o.Upper, charsUpper},
+		{o.Digits, charsDigits},
+		{o.Symbols, charsSymbols},
+	} {
+		if !class.enabled {
+			continue
+		}
+
+		chars := class.chars
+		if o.ExcludeAmbiguous {
+			chars = strings.Map(func(r rune) rune {
+				if strings.ContainsRune(charsAmbiguous, r) {
+					return -1
+				}
+				return r
+			}, chars)
+		}
+		classes = append(classes, chars)
+	}
+	return classes
+}
+
+// pick возвращает случайный символ строки.
+func pick(chars string) (byte, error) {
+	n, err := random(len(chars))
+	if err != nil {
+		return 0, err
+	}
+	return chars[n], nil
+}
+
+// shuffle перемешивает слайс байт (тасование Фишера — Йетса).
+func shuffle(b []byte) error {
+	for i := len(b) - 1; i > 0; i-- {
+		j, err := random(i + 1)
+		if err != nil {
+			return err
+		}
+		b[i], b[j] = b[j], b[i]
+	}
+	return nil
+}
+
+// random возвращает равномерно распределенное случайное число в диапазоне [0, max).
+func random(max int) (int, error) {
+	n, err := rand.Int(rand.Reader, big.NewInt(int64(max)))
+	if err != nil {
+		return 0, err
+	}
+	return int(n.Int64()), nil
+}
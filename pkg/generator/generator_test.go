@@ -0,0 +1,122 @@
+package generator
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"unicode"
+)
+
+func TestPassword(t *testing.T) {
+	tests := []struct {
+		name string
+		opts PasswordOptions
+	}{
+		{"Default", DefaultPasswordOptions()},
+		{"Digits only", PasswordOptions{Length: 6, Digits: true}},
+		{"Required classes", PasswordOptions{Length: 4, Lower: true, Upper: true, Digits: true, Symbols: true, Required: true}},
+		{"Exclude ambiguous", PasswordOptions{Length: 64, Lower: true, Upper: true, Digits: true, ExcludeAmbiguous: true}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			password, err := Password(tt.opts)
+			if err != nil {
+				t.Fatalf("Password() error = %v", err)
+			}
+
+			if len(password) != tt.opts.Length {
+				t.Errorf("Password() length = %d, want %d", len(password), tt.opts.Length)
+			}
+
+			alphabet := strings.Join(tt.opts.classes(), "")
+			for _, r := range password {
+				if !strings.ContainsRune(alphabet, r) {
+					t.Errorf("Password() contains unexpected character %q", r)
+				}
+			}
+
+			if tt.opts.ExcludeAmbiguous && strings.ContainsAny(password, charsAmbiguous) {
+				t.Errorf("Password() contains ambiguous characters: %s", password)
+			}
+
+			if tt.opts.Required {
+				for _, class := range tt.opts.classes() {
+					if !strings.ContainsAny(password, class) {
+						t.Errorf("Password() has no characters of class %q: %s", class, password)
+					}
+				}
+			}
+		})
+	}
+}
+
+func TestPasswordErrors(t *testing.T) {
+	tests := []struct {
+		name string
+		opts PasswordOptions
+		err  error
+	}{
+		{"No classes", PasswordOptions{Length: 10}, ErrNoCharClasses},
+		{"Zero length", PasswordOptions{Lower: true}, ErrInvalidLength},
+		{"Shorter than required classes", PasswordOptions{Length: 2, Lower: true, Upper: true, Digits: true, Required: true}, ErrInvalidLength},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := Password(tt.opts); !errors.Is(err, tt.err) {
+				t.Errorf("Password() error = %v, want %v", err, tt.err)
+			}
+		})
+	}
+}
+
+func TestPassphrase(t *testing.T) {
+	phrase, err := Passphrase(PassphraseOptions{Words: 5, Separator: ".", Capitalize: true})
+	if err != nil {
+		t.Fatalf("Passphrase() error = %v", err)
+	}
+
+	words := strings.Split(phrase, ".")
+	if len(words) != 5 {
+		t.Fatalf("Passphrase() words = %d, want 5", len(words))
+	}
+
+	for _, word := range words {
+		if !unicode.IsUpper(rune(word[0])) {
+			t.Errorf("Passphrase() word %q is not capitalized", word)
+		}
+	}
+
+	if _, err = Passphrase(PassphraseOptions{}); !errors.Is(err, ErrInvalidWords) {
+		t.Errorf("Passphrase() error = %v, want %v", err, ErrInvalidWords)
+	}
+}
+
+func TestWordlist(t *testing.T) {
+	if WordlistSize() != 2048 {
+		t.Errorf("WordlistSize() = %d, want 2048", WordlistSize())
+	}
+
+	seen := make(map[string]struct{}, len(wordlist))
+	for _, word := range wordlist {
+		if _, ok := seen[word]; ok {
+			t.Errorf("Duplicate word %q", word)
+		}
+		seen[word] = struct{}{}
+	}
+}
+
+func TestEntropy(t *testing.T) {
+	if got := PassphraseEntropy(PassphraseOptions{Words: 6}); got != 66 {
+		t.Errorf("PassphraseEntropy() = %v, want 66", got)
+	}
+
+	if got := PasswordEntropy(PasswordOptions{Length: 10, Digits: true}); int(got) != 33 {
+		t.Errorf("PasswordEntropy() = %v, want ~33.2", got)
+	}
+
+	if got := PasswordEntropy(PasswordOptions{Length: 10}); got != 0 {
+		t.Errorf("PasswordEntropy() = %v, want 0", got)
+	}
+}
@@ -1,4 +1,6 @@
 // Package jwtoken содердит методы работы с JWT токеном.
+// Токены подписываются асимметричными ключами (EdDSA, RS256) из набора ключей,
+// идентификатор ключа подписи передается в заголовке "kid".
 package jwtoken
 
 import (
@@ -12,12 +14,17 @@ import (
 var (
 	// ErrInvalidToken некорректный токен.
 	ErrInvalidToken = errors.New("invalid token")
+
+	// ErrMissingKeyID в заголовке токена отсутствует идентификатор ключа.
+	ErrMissingKeyID = errors.New("missing kid header")
 )
 
 // Payload структура полезных данных.
 type Payload struct {
 	UUID     string
 	FullName string
+	Session  string
+	Version  int
 }
 
 // Claims структура содержимого токена.
@@ -27,17 +34,24 @@ type Claims struct {
 }
 
 // JWToken структура токена.
-type JWToken struct{}
+type JWToken struct {
+	keys *KeySet
+}
 
 // New конструктор.
-func New() *JWToken {
-	return &JWToken{}
+func New(keys *KeySet) *JWToken {
+	return &JWToken{keys: keys}
 }
 
-// Create метод создания токена.
-func (j JWToken) Create(payload Payload, ttl time.Duration, secret []byte) (string, error) {
+// Create метод создания токена, подписывает токен текущим ключом подписи.
+func (j JWToken) Create(payload Payload, ttl time.Duration) (string, error) {
+	key, err := j.keys.SigningKey()
+	if err != nil {
+		return "", err
+	}
+
 	token := jwt.NewWithClaims(
-		jwt.SigningMethodHS256,
+		key.Method,
 		Claims{
 			payload,
 			jwt.RegisteredClaims{
@@ -46,19 +60,36 @@ func (j JWToken) Create(payload Payload, ttl time.Duration, secret []byte) (stri
 			},
 		},
 	)
-	ss, err := token.SignedString(secret)
+	token.Header["kid"] = key.ID
+
+	ss, err := token.SignedString(key.Private)
 	if err != nil {
-		return "", nil
+		return "", err
 	}
 	return ss, nil
 }
 
 // Verify метод верификации токена.
-func (j JWToken) Verify(token string, secret []byte) (*Payload, error) {
+// Ключ проверки выбирается по заголовку "kid", алгоритм токена должен совпадать с алгоритмом ключа.
+func (j JWToken) Verify(token string) (*Payload, error) {
 	claims := Claims{}
 	jwtToken, err := jwt.ParseWithClaims(token, &claims, func(token *jwt.Token) (interface{}, error) {
-		return secret, nil
-	})
+		kid, ok := token.Header["kid"].(string)
+		if !ok || kid == "" {
+			return nil, ErrMissingKeyID
+		}
+
+		key, err := j.keys.Lookup(kid)
+		if err != nil {
+			return nil, err
+		}
+
+		if token.Method.Alg() != key.Method.Alg() {
+			return nil, ErrInvalidToken
+		}
+
+		return key.Public, nil
+	}, jwt.WithValidMethods([]string{jwt.SigningMethodEdDSA.Alg(), jwt.SigningMethodRS256.Alg()}))
 
 	if err != nil {
 		return nil, err
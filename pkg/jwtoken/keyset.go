@@ -0,0 +1,273 @@
+package jwtoken
+
+import (
+	"crypto"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"math/big"
+	"sync"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// Основные ошибки при работе с набором ключей.
+var (
+	// ErrKeyNotFound ключ с заданным идентификатором не найден.
+	ErrKeyNotFound = errors.New("key not found")
+
+	// ErrKeyExists ключ с заданным идентификатором уже добавлен.
+	ErrKeyExists = errors.New("key already exists")
+
+	// ErrNoSigningKey не назначен ключ подписи.
+	ErrNoSigningKey = errors.New("signing key is not set")
+
+	// ErrVerifyOnlyKey ключ не содержит закрытой части и не может подписывать токены.
+	ErrVerifyOnlyKey = errors.New("key is verify only")
+
+	// ErrUnsupportedKey неподдерживаемый тип ключа.
+	ErrUnsupportedKey = errors.New("unsupported key type")
+
+	// ErrInvalidPEM некорректный PEM-блок.
+	ErrInvalidPEM = errors.New("invalid pem block")
+)
+
+// Key ключ подписи (или только проверки) токенов.
+type Key struct {
+	// ID идентификатор ключа, передается в заголовке "kid".
+	ID string
+
+	// Method алгоритм подписи (EdDSA или RS256).
+	Method jwt.SigningMethod
+
+	// Private закрытая часть, nil для ключей только проверки.
+	Private crypto.Signer
+
+	// Public открытая часть.
+	Public crypto.PublicKey
+}
+
+// NewKey конструктор ключа по закрытой части.
+// Алгоритм подписи определяется по типу ключа.
+func NewKey(id string, private crypto.Signer) (*Key, error) {
+	key, err := NewVerifyKey(id, private.Public())
+	if err != nil {
+		return nil, err
+	}
+	key.Private = private
+	return key, nil
+}
+
+// NewVerifyKey конструктор ключа только для проверки подписи.
+func NewVerifyKey(id string, public crypto.PublicKey) (*Key, error) {
+	var method jwt.SigningMethod
+	switch public.(type) {
+	case ed25519.PublicKey:
+		method = jwt.SigningMethodEdDSA
+	case *rsa.PublicKey:
+		method = jwt.SigningMethodRS256
+	default:
+		return nil, ErrUnsupportedKey
+	}
+
+	return &Key{ID: id, Method: method, Public: public}, nil
+}
+
+// GenerateEd25519Key генерирует новый Ed25519 ключ.
+func GenerateEd25519Key(id string) (*Key, error) {
+	_, private, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		return nil, err
+	}
+	return NewKey(id, private)
+}
+
+// ParsePEM разбирает ключ из PEM-блока.
+// Поддерживаются закрытые ключи PKCS#8 и PKCS#1 (RSA), а так же открытые ключи PKIX.
+func ParsePEM(id string, data []byte) (*Key, error) {
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, ErrInvalidPEM
+	}
+
+	switch block.Type {
+	case "PRIVATE KEY":
+		private, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+		if err != nil {
+			return nil, err
+		}
+		signer, ok := private.(crypto.Signer)
+		if !ok {
+			return nil, ErrUnsupportedKey
+		}
+		return NewKey(id, signer)
+	case "RSA PRIVATE KEY":
+		private, err := x509.ParsePKCS1PrivateKey(block.Bytes)
+		if err != nil {
+			return nil, err
+		}
+		return NewKey(id, private)
+	case "PUBLIC KEY":
+		public, err := x509.ParsePKIXPublicKey(block.Bytes)
+		if err != nil {
+			return nil, err
+		}
+		return NewVerifyKey(id, public)
+	}
+
+	return nil, fmt.Errorf("%w: %s", ErrInvalidPEM, block.Type)
+}
+
+// JWK открытый ключ в формате JSON Web Key (RFC 7517).
+type JWK struct {
+	KeyType   string `json:"kty"`
+	KeyID     string `json:"kid"`
+	Use       string `json:"use"`
+	Algorithm string `json:"alg"`
+	Curve     string `json:"crv,omitempty"`
+	X         string `json:"x,omitempty"`
+	N         string `json:"n,omitempty"`
+	E         string `json:"e,omitempty"`
+}
+
+// JWKS набор открытых ключей в формате JSON Web Key Set.
+type JWKS struct {
+	Keys []JWK `json:"keys"`
+}
+
+// JWK метод возвращает открытую часть ключа в формате JWK.
+func (k *Key) JWK() JWK {
+	jwk := JWK{
+		KeyID:     k.ID,
+		Use:       "sig",
+		Algorithm: k.Method.Alg(),
+	}
+
+	switch public := k.Public.(type) {
+	case ed25519.PublicKey:
+		jwk.KeyType = "OKP"
+		jwk.Curve = "Ed25519"
+		jwk.X = base64.RawURLEncoding.EncodeToString(public)
+	case *rsa.PublicKey:
+		jwk.KeyType = "RSA"
+		jwk.N = base64.RawURLEncoding.EncodeToString(public.N.Bytes())
+		jwk.E = base64.RawURLEncoding.EncodeToString(big.NewInt(int64(public.E)).Bytes())
+	}
+
+	return jwk
+}
+
+// KeySet набор ключей.
+// Один из ключей назначается ключом подписи, остальные используются только для проверки,
+// что позволяет выполнять ротацию без инвалидации ранее выданных токенов.
+type KeySet struct {
+	mu         sync.RWMutex
+	keys       map[string]*Key
+	order      []string
+	signingKID string
+}
+
+// NewKeySet конструктор.
+func NewKeySet(keys ...*Key) (*KeySet, error) {
+	ks := &KeySet{keys: make(map[string]*Key)}
+	for _, key := range keys {
+		if err := ks.Add(key); err != nil {
+			return nil, err
+		}
+	}
+	return ks, nil
+}
+
+// Add метод добавляет ключ в набор.
+func (ks *KeySet) Add(key *Key) error {
+	ks.mu.Lock()
+	defer ks.mu.Unlock()
+
+	if _, ok := ks.keys[key.ID]; ok {
+		return fmt.Errorf("%w: %s", ErrKeyExists, key.ID)
+	}
+
+	ks.keys[key.ID] = key
+	ks.order = append(ks.order, key.ID)
+	return nil
+}
+
+// Remove метод удаляет ключ из набора.
+// Токены, подписанные этим ключом, перестают проходить проверку.
+func (ks *KeySet) Remove(kid string) {
+	ks.mu.Lock()
+	defer ks.mu.Unlock()
+
+	delete(ks.keys, kid)
+	for i, id := range ks.order {
+		if id == kid {
+			ks.order = append(ks.order[:i], ks.order[i+1:]...)
+			break
+		}
+	}
+
+	if ks.signingKID == kid {
+		ks.signingKID = ""
+	}
+}
+
+// SetSigningKey метод назначает ключ подписи.
+func (ks *KeySet) SetSigningKey(kid string) error {
+	ks.mu.Lock()
+	defer ks.mu.Unlock()
+
+	key, ok := ks.keys[kid]
+	if !ok {
+		return fmt.Errorf("%w: %s", ErrKeyNotFound, kid)
+	}
+
+	if key.Private == nil {
+		return fmt.Errorf("%w: %s", ErrVerifyOnlyKey, kid)
+	}
+
+	ks.signingKID = kid
+	return nil
+}
+
+// SigningKey метод возвращает текущий ключ подписи.
+func (ks *KeySet) SigningKey() (*Key, error) {
+	ks.mu.RLock()
+	defer ks.mu.RUnlock()
+
+	if ks.signingKID == "" {
+		return nil, ErrNoSigningKey
+	}
+
+	return ks.keys[ks.signingKID], nil
+}
+
+// Lookup метод ищет ключ по идентификатору.
+func (ks *KeySet) Lookup(kid string) (*Key, error) {
+	ks.mu.RLock()
+	defer ks.mu.RUnlock()
+
+	key, ok := ks.keys[kid]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrKeyNotFound, kid)
+	}
+
+	return key, nil
+}
+
+// JWKS метод возвращает открытые части всех ключей набора.
+func (ks *KeySet) JWKS() JWKS {
+	ks.mu.RLock()
+	defer ks.mu.RUnlock()
+
+	jwks := JWKS{Keys: make([]JWK, 0, len(ks.order))}
+	for _, kid := range ks.order {
+		jwks.Keys = append(jwks.Keys, ks.keys[kid].JWK())
+	}
+
+	return jwks
+}
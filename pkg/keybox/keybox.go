@@ -0,0 +1,122 @@
+// Package keybox содержит методы асимметричного шифрования ключей (X25519, NaCl box).
+// Используется для передачи симметричных ключей общих хранилищ между пользователями:
+// ключ шифруется открытым ключом получателя и расшифровывается только его закрытым ключом.
+package keybox
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"strings"
+
+	"golang.org/x/crypto/curve25519"
+	"golang.org/x/crypto/nacl/box"
+)
+
+const (
+	// KeySize размер (в байтах) ключей X25519 и симметричных ключей.
+	KeySize = 32
+
+	// legacyDeriveLabel метка контекста, с которой закрытый ключ получался из секрета до появления случайных ключей.
+	legacyDeriveLabel = "seckeep x25519 key"
+)
+
+// Основные ошибки при работе с ключами.
+var (
+	// ErrInvalidKey ключ некорректного размера.
+	ErrInvalidKey = errors.New("invalid key size")
+
+	// ErrDecrypt не удалось расшифровать ключ (поврежден или зашифрован для другого получателя).
+	ErrDecrypt = errors.New("unable to open sealed key")
+)
+
+// KeyPair пара ключей X25519.
+type KeyPair struct {
+	Public  [KeySize]byte
+	Private [KeySize]byte
+}
+
+// GenerateKeyPair генерирует случайную пару ключей X25519.
+func GenerateKeyPair() (*KeyPair, error) {
+	public, private, err := box.GenerateKey(rand.Reader)
+	if err != nil {
+		return nil, err
+	}
+	return &KeyPair{Public: *public, Private: *private}, nil
+}
+
+// NewKeyPair восстанавливает пару ключей X25519 по закрытому ключу.
+func NewKeyPair(private []byte) (*KeyPair, error) {
+	if len(private) != KeySize {
+		return nil, ErrInvalidKey
+	}
+
+	kp := &KeyPair{}
+	copy(kp.Private[:], private)
+
+	public, err := curve25519.X25519(kp.Private[:], curve25519.Basepoint)
+	if err != nil {
+		return nil, err
+	}
+	copy(kp.Public[:], public)
+
+	return kp, nil
+}
+
+// DeriveLegacyKeyPair получает из секрета пару ключей, которой ранее шифровались ключи общих хранилищ.
+// Используется только для расшифровки таких ключей и их перешифрования новым открытым ключом.
+func DeriveLegacyKeyPair(secret []byte) (*KeyPair, error) {
+	private := sha256.Sum256(append([]byte(legacyDeriveLabel), secret...))
+	return NewKeyPair(private[:])
+}
+
+// GenerateSigningKey генерирует случайный ключ подписи Ed25519.
+func GenerateSigningKey() (ed25519.PrivateKey, error) {
+	_, private, err := ed25519.GenerateKey(rand.Reader)
+	return private, err
+}
+
+// Fingerprint возвращает отпечаток открытых ключей для сверки по независимому каналу:
+// SHA-256 от ключей шифрования и подписи в виде групп по 4 шестнадцатеричных символа.
+func Fingerprint(encryptionKey, signingKey []byte) string {
+	sum := sha256.Sum256(append(append([]byte{}, encryptionKey...), signingKey...))
+	digest := strings.ToUpper(hex.EncodeToString(sum[:]))
+
+	groups := make([]string, 0, len(digest)/4)
+	for i := 0; i < len(digest); i += 4 {
+		groups = append(groups, digest[i:i+4])
+	}
+	return strings.Join(groups, " ")
+}
+
+// NewKey генерирует случайный симметричный ключ.
+func NewKey() ([]byte, error) {
+	key := make([]byte, KeySize)
+	if _, err := rand.Read(key); err != nil {
+		return nil, err
+	}
+	return key, nil
+}
+
+// Seal шифрует ключ key открытым ключом получателя recipient.
+func Seal(key, recipient []byte) ([]byte, error) {
+	if len(recipient) != KeySize {
+		return nil, ErrInvalidKey
+	}
+
+	var pub [KeySize]byte
+	copy(pub[:], recipient)
+
+	return box.SealAnonymous(nil, key, &pub, rand.Reader)
+}
+
+// Open расшифровывает ключ, зашифрованный открытым ключом пары kp.
+func (kp *KeyPair) Open(sealed []byte) ([]byte, error) {
+	key, ok := box.OpenAnonymous(nil, sealed, &kp.Public, &kp.Private)
+	if !ok {
+		return nil, ErrDecrypt
+	}
+	return key, nil
+}
@@ -0,0 +1,92 @@
+package keybox
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+)
+
+func TestNewKeyPair(t *testing.T) {
+	kp, err := GenerateKeyPair()
+	if err != nil {
+		t.Fatalf("GenerateKeyPair() error = %v", err)
+	}
+
+	restored, err := NewKeyPair(kp.Private[:])
+	if err != nil {
+		t.Fatalf("NewKeyPair() error = %v", err)
+	}
+
+	if kp.Public != restored.Public {
+		t.Errorf("The restored public key does not match the generated.")
+	}
+
+	if _, err = NewKeyPair([]byte("short")); !errors.Is(err, ErrInvalidKey) {
+		t.Errorf("NewKeyPair() error = %v, want %v", err, ErrInvalidKey)
+	}
+}
+
+func TestDeriveLegacyKeyPair(t *testing.T) {
+	kp1, err := DeriveLegacyKeyPair([]byte("secret"))
+	if err != nil {
+		t.Fatalf("DeriveLegacyKeyPair() error = %v", err)
+	}
+
+	kp2, _ := DeriveLegacyKeyPair([]byte("secret"))
+	if kp1.Public != kp2.Public {
+		t.Errorf("The same secret gives different key pairs.")
+	}
+
+	kp3, _ := DeriveLegacyKeyPair([]byte("other secret"))
+	if kp1.Public == kp3.Public {
+		t.Errorf("Different secrets give the same key pair.")
+	}
+}
+
+func TestFingerprint(t *testing.T) {
+	fp := Fingerprint([]byte("encryption"), []byte("signing"))
+
+	if len(fp) != 79 {
+		t.Errorf("Fingerprint() length = %d, want 79", len(fp))
+	}
+
+	if fp != Fingerprint([]byte("encryption"), []byte("signing")) {
+		t.Errorf("The same keys give different fingerprints.")
+	}
+
+	if fp == Fingerprint([]byte("signing"), []byte("encryption")) {
+		t.Errorf("Different keys give the same fingerprint.")
+	}
+}
+
+func TestSealOpen(t *testing.T) {
+	recipient, _ := GenerateKeyPair()
+	stranger, _ := GenerateKeyPair()
+
+	key, err := NewKey()
+	if err != nil {
+		t.Fatalf("NewKey() error = %v", err)
+	}
+
+	sealed, err := Seal(key, recipient.Public[:])
+	if err != nil {
+		t.Fatalf("Seal() error = %v", err)
+	}
+
+	opened, err := recipient.Open(sealed)
+	if err != nil {
+		t.Fatalf("Open() error = %v", err)
+	}
+
+	if !bytes.Equal(key, opened) {
+		t.Errorf("The opened key does not match the sealed.")
+	}
+
+	if _, err = stranger.Open(sealed); !errors.Is(err, ErrDecrypt) {
+		t.Errorf("Open() by stranger error = %v, want %v", err, ErrDecrypt)
+	}
+
+	if _, err = Seal(key, []byte("short")); !errors.Is(err, ErrInvalidKey) {
+		t.Errorf("Seal() error = %v, want %v", err, ErrInvalidKey)
+	}
+}
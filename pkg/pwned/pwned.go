@@ -0,0 +1,161 @@
+// Package pwned для проверки паролей по локальной базе утечек в формате Have I Been Pwned.
+// База — текстовый файл строк «SHA1:кол-во», отсортированный по хешу (формат загрузчика
+// PwnedPasswordsDownloader). Поиск выполняется двоичным поиском по файлу, без загрузки в память
+// и без обращений к сети.
+package pwned
+
+import (
+	"bytes"
+	"crypto/sha1"
+	"encoding/hex"
+	"errors"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+)
+
+// Основные ошибки при работе с базой утечек.
+var (
+	// ErrInvalidHash некорректный SHA-1 хеш.
+	ErrInvalidHash = errors.New("invalid sha1 hash")
+	// ErrInvalidDataset строка базы не соответствует формату «SHA1:кол-во».
+	ErrInvalidDataset = errors.New("invalid dataset line")
+)
+
+// hashLength длина SHA-1 хеша в шестнадцатеричном виде.
+const hashLength = sha1.Size * 2
+
+// chunkSize размер блока, читаемого из файла за один раз. Строка базы занимает около 50 байт.
+const chunkSize = 256
+
+// Dataset структура локальной базы утечек.
+type Dataset struct {
+	file *os.File
+	size int64
+}
+
+// Open открывает файл базы утечек.
+func Open(fName string) (*Dataset, error) {
+	file, err := os.Open(fName)
+	if err != nil {
+		return nil, err
+	}
+
+	info, err := file.Stat()
+	if err != nil {
+		_ = file.Close()
+		return nil, err
+	}
+
+	return &Dataset{file: file, size: info.Size()}, nil
+}
+
+// Count метод возвращает, сколько раз пароль встречался в утечках. 0 — пароль в базе не найден.
+func (d *Dataset) Count(password string) (int, error) {
+	sum := sha1.Sum([]byte(password))
+	return d.CountHash(hex.EncodeToString(sum[:]))
+}
+
+// CountHash метод возвращает, сколько раз пароль с SHA-1 хешем hash встречался в утечках.
+func (d *Dataset) CountHash(hash string) (int, error) {
+	hash = strings.ToUpper(hash)
+	if _, err := hex.DecodeString(hash); err != nil || len(hash) != hashLength {
+		return 0, ErrInvalidHash
+	}
+
+	// Начало искомой строки всегда находится в диапазоне [lo, hi).
+	lo, hi := int64(0), d.size
+	for lo < hi {
+		mid := lo + (hi-lo)/2
+
+		start, line, err := d.lineAfter(mid)
+		if err != nil {
+			return 0, err
+		}
+
+		if start >= hi || line == nil {
+			hi = mid
+			continue
+		}
+
+		lineHash, count, err := parse(line)
+		if err != nil {
+			return 0, err
+		}
+
+		switch strings.Compare(lineHash, hash) {
+		case 0:
+			return count, nil
+		case -1:
+			lo = start + int64(len(line)) + 1
+		default:
+			hi = mid
+		}
+	}
+
+	return 0, nil
+}
+
+// Close метод закрывает файл базы.
+func (d *Dataset) Close() error {
+	return d.file.Close()
+}
+
+// lineAfter возвращает первую строку, начинающуюся не раньше offset, и ее смещение.
+// Если такой строки нет, возвращает nil.
+func (d *Dataset) lineAfter(offset int64) (int64, []byte, error) {
+	start := offset
+	if offset > 0 {
+		chunk, err := d.readAt(offset - 1)
+		if err != nil {
+			return 0, nil, err
+		}
+
+		newline := bytes.IndexByte(chunk, '\n')
+		if newline < 0 {
+			return d.size, nil, nil
+		}
+		start = offset + int64(newline)
+	}
+
+	if start >= d.size {
+		return start, nil, nil
+	}
+
+	chunk, err := d.readAt(start)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	if newline := bytes.IndexByte(chunk, '\n'); newline >= 0 {
+		chunk = chunk[:newline]
+	}
+
+	return start, chunk, nil
+}
+
+// readAt читает блок файла, начиная со смещения offset.
+func (d *Dataset) readAt(offset int64) ([]byte, error) {
+	chunk := make([]byte, chunkSize)
+	n, err := d.file.ReadAt(chunk, offset)
+	if err != nil && !errors.Is(err, io.EOF) {
+		return nil, err
+	}
+	return chunk[:n], nil
+}
+
+// parse разбирает строку базы «SHA1:кол-во».
+func parse(line []byte) (string, int, error) {
+	hash, count, ok := strings.Cut(strings.TrimSpace(string(line)), ":")
+	if !ok || len(hash) != hashLength {
+		return "", 0, ErrInvalidDataset
+	}
+
+	n, err := strconv.Atoi(count)
+	if err != nil {
+		return "", 0, ErrInvalidDataset
+	}
+
+	return strings.ToUpper(hash), n, nil
+}
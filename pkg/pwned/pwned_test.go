@@ -0,0 +1,111 @@
+package pwned
+
+import (
+	"crypto/sha1"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"testing"
+)
+
+// writeDataset создает файл базы из паролей и кол-ва утечек, дополняя его хешами случайных строк.
+func writeDataset(t *testing.T, passwords map[string]int, newline string) string {
+	t.Helper()
+
+	lines := make([]string, 0, len(passwords)+1000)
+	for password, count := range passwords {
+		lines = append(lines, fmt.Sprintf("%s:%d", hash(password), count))
+	}
+	for i := 0; i < 1000; i++ {
+		lines = append(lines, fmt.Sprintf("%s:%d", hash(fmt.Sprintf("filler-%d", i)), i+1))
+	}
+	sort.Strings(lines)
+
+	fName := filepath.Join(t.TempDir(), "pwned.txt")
+	if err := os.WriteFile(fName, []byte(strings.Join(lines, newline)), 0600); err != nil {
+		t.Fatalf("WriteFile() error = %v", err)
+	}
+	return fName
+}
+
+func hash(password string) string {
+	sum := sha1.Sum([]byte(password))
+	return strings.ToUpper(hex.EncodeToString(sum[:]))
+}
+
+func TestCount(t *testing.T) {
+	passwords := map[string]int{
+		"password": 9545824,
+		"123456":   37359195,
+		"qwerty":   3946737,
+	}
+
+	for name, newline := range map[string]string{"LF": "\n", "CRLF": "\r\n"} {
+		t.Run(name, func(t *testing.T) {
+			dataset, err := Open(writeDataset(t, passwords, newline))
+			if err != nil {
+				t.Fatalf("Open() error = %v", err)
+			}
+			defer dataset.Close()
+
+			for password, want := range passwords {
+				if got, err := dataset.Count(password); err != nil || got != want {
+					t.Errorf("Count(%q) = %d, %v, want %d", password, got, err, want)
+				}
+			}
+
+			for i := 0; i < 1000; i += 97 {
+				if got, _ := dataset.Count(fmt.Sprintf("filler-%d", i)); got != i+1 {
+					t.Errorf("Count(filler-%d) = %d, want %d", i, got, i+1)
+				}
+			}
+
+			if got, err := dataset.Count("xK3!vQ9#mP2$wL7@zR5&"); err != nil || got != 0 {
+				t.Errorf("Count() = %d, %v, want 0", got, err)
+			}
+		})
+	}
+}
+
+func TestCountHash(t *testing.T) {
+	dataset, err := Open(writeDataset(t, map[string]int{"password": 3}, "\n"))
+	if err != nil {
+		t.Fatalf("Open() error = %v", err)
+	}
+	defer dataset.Close()
+
+	if got, _ := dataset.CountHash(strings.ToLower(hash("password"))); got != 3 {
+		t.Errorf("CountHash() = %d, want 3", got)
+	}
+
+	for _, invalid := range []string{"", "5BAA6", strings.Repeat("Z", 40)} {
+		if _, err = dataset.CountHash(invalid); !errors.Is(err, ErrInvalidHash) {
+			t.Errorf("CountHash(%q) error = %v, want %v", invalid, err, ErrInvalidHash)
+		}
+	}
+}
+
+func TestInvalidDataset(t *testing.T) {
+	fName := filepath.Join(t.TempDir(), "invalid.txt")
+	if err := os.WriteFile(fName, []byte("not a dataset\n"), 0600); err != nil {
+		t.Fatalf("WriteFile() error = %v", err)
+	}
+
+	dataset, err := Open(fName)
+	if err != nil {
+		t.Fatalf("Open() error = %v", err)
+	}
+	defer dataset.Close()
+
+	if _, err = dataset.Count("password"); !errors.Is(err, ErrInvalidDataset) {
+		t.Errorf("Count() error = %v, want %v", err, ErrInvalidDataset)
+	}
+
+	if _, err = Open(filepath.Join(t.TempDir(), "missing.txt")); err == nil {
+		t.Errorf("Open() of a missing file should fail")
+	}
+}
@@ -0,0 +1,267 @@
+// Package strength для оценки стойкости паролей.
+// Оценка построена по принципу zxcvbn: пароль разбивается на фрагменты (распространенные пароли,
+// повторы, последовательности, ряды клавиатуры, годы и отдельные символы) так,
+// чтобы суммарная энтропия фрагментов была минимальной.
+package strength
+
+import (
+	_ "embed"
+	"math"
+	"strings"
+	"unicode"
+)
+
+// Оценки стойкости пароля.
+const (
+	ScoreVeryWeak = iota
+	ScoreWeak
+	ScoreFair
+	ScoreStrong
+	ScoreVeryStrong
+)
+
+// scoreThresholds минимальная энтропия в битах для оценок от ScoreWeak до ScoreVeryStrong.
+var scoreThresholds = []float64{25, 40, 60, 80}
+
+// minPatternLength минимальная длина фрагмента-шаблона (повтора, последовательности, ряда клавиатуры).
+const minPatternLength = 3
+
+// keyboardRows ряды клавиатуры, фрагменты которых легко подбираются.
+var keyboardRows = []string{"`1234567890-=", "qwertyuiop[]", "asdfghjkl;'", "zxcvbnm,./"}
+
+// leet замены символов, которые часто используют вместо букв.
+var leet = strings.NewReplacer("4", "a", "@", "a", "3", "e", "1", "i", "!", "i", "0", "o", "$", "s", "5", "s", "7", "t")
+
+//go:embed common.txt
+var commonRaw string
+
+// common распространенные пароли и их ранг (место в списке, начиная с 1).
+var common = func() map[string]int {
+	words := strings.Fields(commonRaw)
+	result := make(map[string]int, len(words))
+	for rank, word := range words {
+		result[word] = rank + 1
+	}
+	return result
+}()
+
+// Result результат оценки пароля.
+type Result struct {
+	// Entropy оценка энтропии пароля в битах.
+	Entropy float64
+	// Score оценка стойкости от ScoreVeryWeak до ScoreVeryStrong.
+	Score int
+}
+
+// Estimate оценивает стойкость пароля.
+func Estimate(password string) Result {
+	runes := []rune(password)
+	pool := poolSize(runes)
+
+	// best[i] минимальная энтропия первых i символов пароля.
+	best := make([]float64, len(runes)+1)
+	for i := 1; i <= len(runes); i++ {
+		best[i] = math.Inf(1)
+	}
+
+	for i := 0; i < len(runes); i++ {
+		if math.IsInf(best[i], 1) {
+			continue
+		}
+
+		relax(best, i, i+1, math.Log2(float64(pool)))
+		for j := i + minPatternLength; j <= len(runes); j++ {
+			if bits, ok := pattern(runes[i:j]); ok {
+				relax(best, i, j, bits)
+			}
+		}
+	}
+
+	entropy := best[len(runes)]
+	return Result{Entropy: entropy, Score: score(entropy)}
+}
+
+// relax обновляет минимальную энтропию первых to символов фрагментом [from, to).
+func relax(best []float64, from, to int, bits float64) {
+	if best[from]+bits < best[to] {
+		best[to] = best[from] + bits
+	}
+}
+
+// score возвращает оценку стойкости по энтропии.
+func score(entropy float64) int {
+	result := ScoreVeryWeak
+	for _, threshold := range scoreThresholds {
+		if entropy >= threshold {
+			result++
+		}
+	}
+	return result
+}
+
+// pattern возвращает энтропию фрагмента, если он соответствует одному из шаблонов.
+// Если фрагмент соответствует нескольким шаблонам, берется наименьшая энтропия.
+func pattern(fragment []rune) (float64, bool) {
+	length := math.Log2(float64(len(fragment)))
+	bits, found := math.Inf(1), false
+
+	candidate := func(value float64) {
+		if value < bits {
+			bits, found = value, true
+		}
+	}
+
+	if rank, extra, ok := dictionary(fragment); ok {
+		candidate(math.Log2(float64(rank)) + extra)
+	}
+
+	if repeated(fragment) {
+		candidate(math.Log2(float64(poolSize(fragment[:1]))) + length)
+	}
+
+	if step, ok := sequence(fragment); ok {
+		extra := 0.0
+		if step < 0 {
+			extra = 1
+		}
+		candidate(math.Log2(float64(poolSize(fragment[:1]))) + length + extra)
+	}
+
+	if keyboard(fragment) {
+		candidate(math.Log2(float64(len(strings.Join(keyboardRows, "")))) + length)
+	}
+
+	if year(fragment) {
+		candidate(math.Log2(200))
+	}
+
+	return bits, found
+}
+
+// dictionary ищет фрагмент в списке распространенных паролей с учетом регистра и замен символов.
+// Возвращает ранг пароля и дополнительную энтропию за регистр и замены.
+func dictionary(fragment []rune) (int, float64, bool) {
+	word := strings.ToLower(string(fragment))
+
+	extra := 0.0
+	if word != string(fragment) {
+		extra++
+	}
+
+	if rank, ok := common[word]; ok {
+		return rank, extra, true
+	}
+
+	if unleeted := leet.Replace(word); unleeted != word {
+		if rank, ok := common[unleeted]; ok {
+			return rank, extra + 1, true
+		}
+	}
+
+	return 0, 0, false
+}
+
+// repeated проверяет, что фрагмент состоит из одного повторяющегося символа.
+func repeated(fragment []rune) bool {
+	for _, r := range fragment[1:] {
+		if r != fragment[0] {
+			return false
+		}
+	}
+	return true
+}
+
+// sequence проверяет, что фрагмент — последовательность символов с шагом 1 или -1 (abc, 987).
+func sequence(fragment []rune) (int, bool) {
+	step := int(fragment[1]) - int(fragment[0])
+	if step != 1 && step != -1 {
+		return 0, false
+	}
+
+	for i := 1; i < len(fragment); i++ {
+		if int(fragment[i])-int(fragment[i-1]) != step || class(fragment[i]) != class(fragment[0]) {
+			return 0, false
+		}
+	}
+	return step, true
+}
+
+// keyboard проверяет, что фрагмент — часть ряда клавиатуры в прямом или обратном порядке.
+func keyboard(fragment []rune) bool {
+	word := strings.ToLower(string(fragment))
+	reversed := []rune(word)
+	for i, j := 0, len(reversed)-1; i < j; i, j = i+1, j-1 {
+		reversed[i], reversed[j] = reversed[j], reversed[i]
+	}
+
+	for _, row := range keyboardRows {
+		if strings.Contains(row, word) || strings.Contains(row, string(reversed)) {
+			return true
+		}
+	}
+	return false
+}
+
+// year проверяет, что фрагмент — год с 1900 по 2099.
+func year(fragment []rune) bool {
+	if len(fragment) != 4 {
+		return false
+	}
+
+	prefix := string(fragment[:2])
+	if prefix != "19" && prefix != "20" {
+		return false
+	}
+
+	return unicode.IsDigit(fragment[2]) && unicode.IsDigit(fragment[3])
+}
+
+// Классы символов для оценки перебора.
+const (
+	classLower = iota
+	classUpper
+	classDigit
+	classSymbol
+	classOther
+)
+
+// class возвращает класс символа.
+func class(r rune) int {
+	switch {
+	case r >= 'a' && r <= 'z':
+		return classLower
+	case r >= 'A' && r <= 'Z':
+		return classUpper
+	case r >= '0' && r <= '9':
+		return classDigit
+	case r < unicode.MaxASCII:
+		return classSymbol
+	}
+	return classOther
+}
+
+// poolSize возвращает размер алфавита, из которого составлены символы, для оценки перебора.
+func poolSize(runes []rune) int {
+	sizes := map[int]int{
+		classLower:  26,
+		classUpper:  26,
+		classDigit:  10,
+		classSymbol: 33,
+		classOther:  100,
+	}
+
+	seen := make(map[int]bool)
+	pool := 0
+	for _, r := range runes {
+		c := class(r)
+		if !seen[c] {
+			seen[c] = true
+			pool += sizes[c]
+		}
+	}
+
+	if pool == 0 {
+		return 1
+	}
+	return pool
+}
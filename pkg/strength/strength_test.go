@@ -0,0 +1,41 @@
+package strength
+
+import (
+	"testing"
+)
+
+func TestEstimate(t *testing.T) {
+	tests := []struct {
+		name     string
+		password string
+		score    int
+	}{
+		{"Empty", "", ScoreVeryWeak},
+		{"Common", "password", ScoreVeryWeak},
+		{"Common with leet and case", "P@ssw0rd", ScoreVeryWeak},
+		{"Common with suffix", "Password1!", ScoreVeryWeak},
+		{"Keyboard and year", "qwerty2021", ScoreVeryWeak},
+		{"Repeat", "aaaaaaaaaaaa", ScoreVeryWeak},
+		{"Sequence", "abcdef987654", ScoreVeryWeak},
+		{"Short random", "kX9#mQ2v", ScoreFair},
+		{"Random", "kX9#mQ2vLp7$", ScoreStrong},
+		{"Long random", "xK3!vQ9#mP2$wL7@zR5&", ScoreVeryStrong},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Estimate(tt.password); got.Score != tt.score {
+				t.Errorf("Estimate(%q) score = %d (%.1f bits), want %d", tt.password, got.Score, got.Entropy, tt.score)
+			}
+		})
+	}
+}
+
+func TestEstimatePatternsLowerEntropy(t *testing.T) {
+	random := Estimate("qhzmwe")
+	keyboard := Estimate("qwerty")
+
+	if keyboard.Entropy >= random.Entropy {
+		t.Errorf("Keyboard row entropy = %.1f, want less than random %.1f", keyboard.Entropy, random.Entropy)
+	}
+}
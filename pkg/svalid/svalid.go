@@ -5,6 +5,7 @@ import (
 	"errors"
 	"strings"
 
+	"github.com/casnerano/seckeep/pkg/card"
 	"github.com/go-playground/validator/v10"
 )
 
@@ -14,7 +15,8 @@ type SValid struct {
 }
 
 // New конструктор.
-// Добавляется новый тип валидируемых данных "enum".
+// Добавляются новые типы валидируемых данных: "enum", "card_number" (номер банковской карты
+// с проверкой длины для платежной системы и по алгоритму Луна) и "card_expiry" (срок действия MM/YY).
 func New() *SValid {
 	v := validator.New()
 	_ = v.RegisterValidation("enum", func(fl validator.FieldLevel) bool {
@@ -24,6 +26,13 @@ func New() *SValid {
 		}
 		return vType.IsValid()
 	})
+	_ = v.RegisterValidation("card_number", func(fl validator.FieldLevel) bool {
+		return card.Validate(fl.Field().String()) == nil
+	})
+	_ = v.RegisterValidation("card_expiry", func(fl validator.FieldLevel) bool {
+		_, err := card.ParseExpiry(fl.Field().String())
+		return err == nil
+	})
 	return &SValid{
 		validator: v,
 	}